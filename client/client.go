@@ -0,0 +1,134 @@
+// Package client is a typed Go client for the Maned Scout JSON API. The types and operations of
+// client_gen.go are generated from the OpenAPI document the server publishes at /api/openapi.json.
+//
+//	c := client.New("http://localhost:8080", client.WithToken(os.Getenv("MANED_SCOUT_TOKEN")))
+//	topics, err := c.ListTopics(ctx, "dev", client.ListTopicsParams{})
+//
+// Changes to protected clusters are refused until the context confirms them with ConfirmClusters.
+package client
+
+//go:generate go run ../internal/openapi/clientgen -o client_gen.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// confirmClusterHeader carries the protected clusters a change is confirmed for.
+const confirmClusterHeader = "X-Confirm-Cluster"
+
+// Client calls the API of a Maned Scout server.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	auth       func(*http.Request)
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sends the requests with hc instead of http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithToken authenticates with an API token.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.auth = func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) }
+	}
+}
+
+// WithBasicAuth authenticates as a local or htpasswd user.
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) {
+		c.auth = func(r *http.Request) { r.SetBasicAuth(username, password) }
+	}
+}
+
+// New creates a client for the server at baseURL, such as http://localhost:8080.
+func New(baseURL string, opts ...Option) *Client {
+	c := &Client{baseURL: strings.TrimRight(baseURL, "/"), httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+type confirmKey struct{}
+
+// ConfirmClusters returns a context whose requests confirm changes to the named protected clusters.
+func ConfirmClusters(ctx context.Context, names ...string) context.Context {
+	if prev, ok := ctx.Value(confirmKey{}).([]string); ok {
+		names = append(append([]string(nil), prev...), names...)
+	}
+	return context.WithValue(ctx, confirmKey{}, names)
+}
+
+// Error is an error answered by the API. Code is stable, such as not_found or confirmation_required.
+type Error struct {
+	StatusCode int
+	APIError
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("maned scout: %s (%d %s)", e.Message, e.StatusCode, e.Code)
+}
+
+// do sends a request to the API and decodes the response body into out, unless out is nil.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	u := c.baseURL + basePath + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if names, ok := ctx.Value(confirmKey{}).([]string); ok {
+		req.Header.Set(confirmClusterHeader, strings.Join(names, ","))
+	}
+	if c.auth != nil {
+		c.auth(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errBody ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errBody); err != nil {
+			errBody.Error = APIError{Message: http.StatusText(resp.StatusCode)}
+		}
+		return &Error{StatusCode: resp.StatusCode, APIError: errBody.Error}
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,1158 @@
+// Code generated by clientgen from the OpenAPI document; DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// basePath is where the API is served, relative to the server URL.
+const basePath = "/api/v1"
+
+// ACL mirrors the ACL schema.
+type ACL struct {
+	Host         string `json:"host"`
+	Operation    string `json:"operation"`
+	PatternType  string `json:"pattern_type"`
+	Permission   string `json:"permission"`
+	Principal    string `json:"principal"`
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+}
+
+// ACLFilter mirrors the ACLFilter schema.
+type ACLFilter struct {
+	Host         string `json:"host"`
+	Operation    string `json:"operation"`
+	PatternType  string `json:"pattern_type"`
+	Permission   string `json:"permission"`
+	Principal    string `json:"principal"`
+	ResourceName string `json:"resource_name"`
+	ResourceType string `json:"resource_type"`
+}
+
+// APIError mirrors the APIError schema.
+type APIError struct {
+	Code    string          `json:"code"`
+	Details json.RawMessage `json:"details,omitempty"`
+	Message string          `json:"message"`
+}
+
+// APIToken mirrors the APIToken schema.
+type APIToken struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	ID         string     `json:"id"`
+	Kind       string     `json:"kind"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	Name       string     `json:"name"`
+	Owner      User       `json:"owner"`
+	Scopes     []Action   `json:"scopes"`
+}
+
+// APIVersion mirrors the APIVersion schema.
+type APIVersion struct {
+	Key  int32  `json:"key"`
+	Max  int32  `json:"max"`
+	Min  int32  `json:"min"`
+	Name string `json:"name"`
+}
+
+// AWSConfig mirrors the AWSConfig schema.
+type AWSConfig struct {
+	AccessKeyEnv    string `json:"access_key_env,omitempty"`
+	IAM             bool   `json:"iam,omitempty"`
+	Region          string `json:"region,omitempty"`
+	SecretKeyEnv    string `json:"secret_key_env,omitempty"`
+	SessionTokenEnv string `json:"session_token_env,omitempty"`
+}
+
+// AbortTransactionRequest mirrors the AbortTransactionRequest schema.
+type AbortTransactionRequest struct {
+	Partition  int32 `json:"partition"`
+	ProducerID int64 `json:"producer_id"`
+}
+
+// Action is one of the values of the Action enum.
+type Action string
+
+// Action values.
+const (
+	ActionView         Action = "view"
+	ActionProduce      Action = "produce"
+	ActionConsume      Action = "consume"
+	ActionTopicAdmin   Action = "topic-admin"
+	ActionGroupAdmin   Action = "group-admin"
+	ActionClusterAdmin Action = "cluster-admin"
+)
+
+// ActiveProducer mirrors the ActiveProducer schema.
+type ActiveProducer struct {
+	CoordinatorEpoch      int32  `json:"coordinator_epoch"`
+	CurrentTxnStartOffset int64  `json:"current_txn_start_offset"`
+	LastSequence          int32  `json:"last_sequence"`
+	LastTimestamp         int64  `json:"last_timestamp"`
+	Leader                int32  `json:"leader"`
+	Partition             int32  `json:"partition"`
+	ProducerEpoch         int32  `json:"producer_epoch"`
+	ProducerID            int64  `json:"producer_id"`
+	Topic                 string `json:"topic"`
+}
+
+// AlterClientQuotaRequest mirrors the AlterClientQuotaRequest schema.
+type AlterClientQuotaRequest struct {
+	Entity []QuotaEntityComponent `json:"entity"`
+	Remove []string               `json:"remove,omitempty"`
+	Set    map[string]float64     `json:"set,omitempty"`
+}
+
+// AuditEvent mirrors the AuditEvent schema.
+type AuditEvent struct {
+	Action       string          `json:"action"`
+	After        json.RawMessage `json:"after,omitempty"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	Cluster      string          `json:"cluster"`
+	Error        string          `json:"error,omitempty"`
+	Provider     string          `json:"provider,omitempty"`
+	RequestID    string          `json:"request_id,omitempty"`
+	ResourceName string          `json:"resource_name,omitempty"`
+	ResourceType string          `json:"resource_type"`
+	Result       string          `json:"result"`
+	SourceIP     string          `json:"source_ip,omitempty"`
+	Time         time.Time       `json:"time"`
+	User         string          `json:"user,omitempty"`
+}
+
+// BatchTopicRequest mirrors the BatchTopicRequest schema.
+type BatchTopicRequest struct {
+	Action          TopicChangeAction  `json:"action"`
+	Configs         map[string]*string `json:"configs,omitempty"`
+	Confirmations   []string           `json:"confirmations,omitempty"`
+	Topics          []string           `json:"topics"`
+	TotalPartitions int32              `json:"total_partitions,omitempty"`
+}
+
+// BatchTopicResult mirrors the BatchTopicResult schema.
+type BatchTopicResult struct {
+	Error string `json:"error,omitempty"`
+	Topic string `json:"topic"`
+}
+
+// BrokerAPIVersions mirrors the BrokerAPIVersions schema.
+type BrokerAPIVersions struct {
+	APIs         []APIVersion `json:"apis"`
+	Error        string       `json:"error,omitempty"`
+	NodeID       int32        `json:"node_id"`
+	VersionGuess string       `json:"version_guess"`
+}
+
+// BrokerDetail mirrors the BrokerDetail schema.
+type BrokerDetail struct {
+	Host             string `json:"host"`
+	ID               int32  `json:"id"`
+	IsController     bool   `json:"is_controller"`
+	LeaderPartitions int64  `json:"leader_partitions"`
+	Port             int32  `json:"port"`
+	Rack             string `json:"rack"`
+}
+
+// CertificateInfo mirrors the CertificateInfo schema.
+type CertificateInfo struct {
+	DaysToExpiry int64     `json:"days_to_expiry"`
+	NotAfter     time.Time `json:"not_after"`
+	NotBefore    time.Time `json:"not_before"`
+	Status       string    `json:"status"`
+}
+
+// ClientQuota mirrors the ClientQuota schema.
+type ClientQuota struct {
+	Entity []QuotaEntityComponent `json:"entity"`
+	Values map[string]float64     `json:"values"`
+}
+
+// ClusterConfig mirrors the ClusterConfig schema.
+type ClusterConfig struct {
+	AWS         *AWSConfig        `json:"aws,omitempty"`
+	Brokers     []string          `json:"brokers"`
+	ClientID    string            `json:"client_id,omitempty"`
+	Name        string            `json:"name"`
+	Options     map[string]string `json:"options,omitempty"`
+	Protected   bool              `json:"protected,omitempty"`
+	ReadOnly    bool              `json:"read_only,omitempty"`
+	SASL        *SASLConfig       `json:"sasl,omitempty"`
+	TLS         *TLSConfig        `json:"tls,omitempty"`
+	TopicPolicy *TopicPolicy      `json:"topic_policy,omitempty"`
+}
+
+// ClusterInternals mirrors the ClusterInternals schema.
+type ClusterInternals struct {
+	Brokers                []BrokerAPIVersions `json:"brokers"`
+	Features               []Feature           `json:"features"`
+	FinalizedFeaturesEpoch int64               `json:"finalized_features_epoch"`
+	Mode                   string              `json:"mode"`
+	Quorum                 *MetadataQuorum     `json:"quorum,omitempty"`
+	QuorumError            string              `json:"quorum_error,omitempty"`
+	ZkMigrationReady       bool                `json:"zk_migration_ready"`
+}
+
+// ClusterOverview mirrors the ClusterOverview schema.
+type ClusterOverview struct {
+	AuthType       string                 `json:"auth_type"`
+	BrokerDetails  []BrokerDetail         `json:"broker_details,omitempty"`
+	Brokers        []string               `json:"brokers"`
+	CertInfo       *CertificateInfo       `json:"cert_info,omitempty"`
+	ConsumerGroups []ConsumerGroupSummary `json:"consumer_groups,omitempty"`
+	ID             string                 `json:"id"`
+	IsOnline       bool                   `json:"is_online"`
+	Name           string                 `json:"name"`
+	Protected      bool                   `json:"protected"`
+	ReadOnly       bool                   `json:"read_only"`
+	Stats          *ClusterStats          `json:"stats,omitempty"`
+}
+
+// ClusterStats mirrors the ClusterStats schema.
+type ClusterStats struct {
+	OfflinePartitions         int64 `json:"offline_partitions"`
+	TotalConsumerGroups       int64 `json:"total_consumer_groups"`
+	TotalPartitions           int64 `json:"total_partitions"`
+	TotalTopics               int64 `json:"total_topics"`
+	UnderReplicatedPartitions int64 `json:"under_replicated_partitions"`
+}
+
+// ConfigChange mirrors the ConfigChange schema.
+type ConfigChange struct {
+	Key string `json:"key"`
+	New string `json:"new"`
+	Old string `json:"old"`
+}
+
+// ConfigDifference mirrors the ConfigDifference schema.
+type ConfigDifference struct {
+	Key    string `json:"key"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// ConsumerGroup mirrors the ConsumerGroup schema.
+type ConsumerGroup struct {
+	Coordinator int32                 `json:"coordinator"`
+	GroupID     string                `json:"group_id"`
+	Lag         []PartitionLag        `json:"lag"`
+	Members     []ConsumerGroupMember `json:"members"`
+	Protocol    string                `json:"protocol"`
+	State       string                `json:"state"`
+	TotalLag    int64                 `json:"total_lag"`
+}
+
+// ConsumerGroupMember mirrors the ConsumerGroupMember schema.
+type ConsumerGroupMember struct {
+	ClientHost string `json:"client_host"`
+	ClientID   string `json:"client_id"`
+	InstanceID string `json:"instance_id,omitempty"`
+	MemberID   string `json:"member_id"`
+}
+
+// ConsumerGroupOffsets mirrors the ConsumerGroupOffsets schema.
+type ConsumerGroupOffsets struct {
+	Group   string            `json:"group"`
+	Offsets []PartitionOffset `json:"offsets"`
+}
+
+// ConsumerGroupSummary mirrors the ConsumerGroupSummary schema.
+type ConsumerGroupSummary struct {
+	GroupID string `json:"group_id"`
+	Members int64  `json:"members"`
+	State   string `json:"state"`
+}
+
+// CreateTokenRequest mirrors the CreateTokenRequest schema.
+type CreateTokenRequest struct {
+	ExpiresInDays int64    `json:"expires_in_days"`
+	Kind          string   `json:"kind"`
+	Name          string   `json:"name"`
+	Scopes        []Action `json:"scopes"`
+}
+
+// CreateTopicRequest mirrors the CreateTopicRequest schema.
+type CreateTopicRequest struct {
+	Configs           map[string]*string `json:"configs,omitempty"`
+	Name              string             `json:"name"`
+	NumPartitions     int32              `json:"num_partitions"`
+	ReplicationFactor int32              `json:"replication_factor"`
+	Template          string             `json:"template,omitempty"`
+}
+
+// CreatedToken mirrors the CreatedToken schema.
+type CreatedToken struct {
+	Secret string   `json:"secret"`
+	Token  APIToken `json:"token"`
+}
+
+// DeleteRecordsRequest mirrors the DeleteRecordsRequest schema.
+type DeleteRecordsRequest struct {
+	All             bool             `json:"all,omitempty"`
+	BeforeTimestamp int64            `json:"before_timestamp,omitempty"`
+	Offsets         map[string]int64 `json:"offsets,omitempty"`
+}
+
+// DeleteRecordsResult mirrors the DeleteRecordsResult schema.
+type DeleteRecordsResult struct {
+	EndOffset    int64 `json:"end_offset"`
+	Partition    int32 `json:"partition"`
+	Records      int64 `json:"records"`
+	StartOffset  int64 `json:"start_offset"`
+	TargetOffset int64 `json:"target_offset"`
+}
+
+// DesiredClusterTopics mirrors the DesiredClusterTopics schema.
+type DesiredClusterTopics struct {
+	ACLs           []ACL                  `json:"acls,omitempty"`
+	ConsumerGroups []ConsumerGroupOffsets `json:"consumer_groups,omitempty"`
+	Topics         []DesiredTopic         `json:"topics"`
+}
+
+// DesiredTopic mirrors the DesiredTopic schema.
+type DesiredTopic struct {
+	Configs           map[string]string `json:"configs,omitempty"`
+	Name              string            `json:"name"`
+	Partitions        int32             `json:"partitions"`
+	ReplicationFactor int32             `json:"replication_factor"`
+}
+
+// DesiredTopicState mirrors the DesiredTopicState schema.
+type DesiredTopicState struct {
+	Clusters map[string]DesiredClusterTopics `json:"clusters"`
+}
+
+// ErrorResponse mirrors the ErrorResponse schema.
+type ErrorResponse struct {
+	Error APIError `json:"error"`
+}
+
+// Feature mirrors the Feature schema.
+type Feature struct {
+	Finalized    bool   `json:"finalized"`
+	FinalizedMax int32  `json:"finalized_max"`
+	FinalizedMin int32  `json:"finalized_min"`
+	Name         string `json:"name"`
+	SupportedMax int32  `json:"supported_max"`
+	SupportedMin int32  `json:"supported_min"`
+}
+
+// IncreasePartitionsRequest mirrors the IncreasePartitionsRequest schema.
+type IncreasePartitionsRequest struct {
+	TotalPartitions int32 `json:"total_partitions"`
+}
+
+// Message mirrors the Message schema.
+type Message struct {
+	Key       []byte    `json:"key"`
+	Offset    int64     `json:"offset"`
+	Partition int32     `json:"partition"`
+	Timestamp time.Time `json:"timestamp"`
+	Value     []byte    `json:"value"`
+}
+
+// MessageRequest mirrors the MessageRequest schema.
+type MessageRequest struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// MetadataQuorum mirrors the MetadataQuorum schema.
+type MetadataQuorum struct {
+	HighWatermark int64           `json:"high_watermark"`
+	LeaderEpoch   int32           `json:"leader_epoch"`
+	LeaderID      int32           `json:"leader_id"`
+	Observers     []QuorumReplica `json:"observers"`
+	Voters        []QuorumReplica `json:"voters"`
+}
+
+// OffsetReset mirrors the OffsetReset schema.
+type OffsetReset struct {
+	From      int64  `json:"from"`
+	Partition int32  `json:"partition"`
+	To        int64  `json:"to"`
+	Topic     string `json:"topic"`
+}
+
+// OffsetSpec mirrors the OffsetSpec schema.
+type OffsetSpec struct {
+	Offset   int64     `json:"offset,omitempty"`
+	Position string    `json:"position,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// PartitionDetail mirrors the PartitionDetail schema.
+type PartitionDetail struct {
+	ISR       []int32 `json:"isr"`
+	Leader    int32   `json:"leader"`
+	Offline   bool    `json:"offline"`
+	Partition int32   `json:"partition"`
+	Replicas  []int32 `json:"replicas"`
+}
+
+// PartitionLag mirrors the PartitionLag schema.
+type PartitionLag struct {
+	CommittedOffset int64  `json:"committed_offset"`
+	EndOffset       int64  `json:"end_offset"`
+	Lag             int64  `json:"lag"`
+	MemberID        string `json:"member_id,omitempty"`
+	Partition       int32  `json:"partition"`
+	Topic           string `json:"topic"`
+}
+
+// PartitionOffset mirrors the PartitionOffset schema.
+type PartitionOffset struct {
+	Offset    int64  `json:"offset"`
+	Partition int32  `json:"partition"`
+	Topic     string `json:"topic"`
+}
+
+// QuarantineTopicRequest mirrors the QuarantineTopicRequest schema.
+type QuarantineTopicRequest struct {
+	Days int64 `json:"days"`
+}
+
+// QuarantinedTopic mirrors the QuarantinedTopic schema.
+type QuarantinedTopic struct {
+	Cluster       string    `json:"cluster"`
+	DeleteAfter   time.Time `json:"delete_after"`
+	QuarantinedAt time.Time `json:"quarantined_at"`
+	RetentionMs   string    `json:"retention_ms,omitempty"`
+	Topic         string    `json:"topic"`
+}
+
+// QuorumReplica mirrors the QuorumReplica schema.
+type QuorumReplica struct {
+	Lag                   int64 `json:"lag"`
+	LastCaughtUpTimestamp int64 `json:"last_caught_up_timestamp"`
+	LastFetchTimestamp    int64 `json:"last_fetch_timestamp"`
+	LogEndOffset          int64 `json:"log_end_offset"`
+	ReplicaID             int32 `json:"replica_id"`
+}
+
+// QuotaEntityComponent mirrors the QuotaEntityComponent schema.
+type QuotaEntityComponent struct {
+	Default bool   `json:"default,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type"`
+}
+
+// ResetOffsetsRequest mirrors the ResetOffsetsRequest schema.
+type ResetOffsetsRequest struct {
+	DryRun bool       `json:"dry_run,omitempty"`
+	To     OffsetSpec `json:"to"`
+	Topic  string     `json:"topic,omitempty"`
+}
+
+// SASLConfig mirrors the SASLConfig schema.
+type SASLConfig struct {
+	Mechanism      string `json:"mechanism,omitempty"`
+	Password       string `json:"password,omitempty"`
+	PasswordEnv    string `json:"password_env,omitempty"`
+	ScramAlgorithm string `json:"scram_algorithm,omitempty"`
+	Username       string `json:"username,omitempty"`
+	UsernameEnv    string `json:"username_env,omitempty"`
+}
+
+// SCRAMCredential mirrors the SCRAMCredential schema.
+type SCRAMCredential struct {
+	Iterations int32  `json:"iterations"`
+	Mechanism  string `json:"mechanism"`
+}
+
+// SCRAMUser mirrors the SCRAMUser schema.
+type SCRAMUser struct {
+	Credentials []SCRAMCredential `json:"credentials"`
+	Name        string            `json:"name"`
+}
+
+// TLSConfig mirrors the TLSConfig schema.
+type TLSConfig struct {
+	CAFile             string `json:"ca_file,omitempty"`
+	CertFile           string `json:"cert_file,omitempty"`
+	Enabled            bool   `json:"enabled,omitempty"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify,omitempty"`
+	KeyFile            string `json:"key_file,omitempty"`
+}
+
+// TopicApplyResult mirrors the TopicApplyResult schema.
+type TopicApplyResult struct {
+	Cluster  string              `json:"cluster"`
+	Results  []TopicChangeResult `json:"results"`
+	Warnings []string            `json:"warnings,omitempty"`
+}
+
+// TopicChange mirrors the TopicChange schema.
+type TopicChange struct {
+	Action            TopicChangeAction `json:"action"`
+	Configs           []ConfigChange    `json:"configs,omitempty"`
+	FromPartitions    int32             `json:"from_partitions,omitempty"`
+	ReplicationFactor int32             `json:"replication_factor,omitempty"`
+	ToPartitions      int32             `json:"to_partitions,omitempty"`
+	Topic             string            `json:"topic"`
+}
+
+// TopicChangeAction is one of the values of the TopicChangeAction enum.
+type TopicChangeAction string
+
+// TopicChangeAction values.
+const (
+	TopicChangeActionCreate             TopicChangeAction = "create"
+	TopicChangeActionUpdateConfig       TopicChangeAction = "update_config"
+	TopicChangeActionIncreasePartitions TopicChangeAction = "increase_partitions"
+	TopicChangeActionDelete             TopicChangeAction = "delete"
+)
+
+// TopicChangeResult mirrors the TopicChangeResult schema.
+type TopicChangeResult struct {
+	Change TopicChange `json:"change"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// TopicComparison mirrors the TopicComparison schema.
+type TopicComparison struct {
+	Differences   []TopicDifference `json:"differences,omitempty"`
+	Matching      int64             `json:"matching"`
+	OnlyInSource  []string          `json:"only_in_source,omitempty"`
+	OnlyInTarget  []string          `json:"only_in_target,omitempty"`
+	SourceCluster string            `json:"source_cluster"`
+	TargetCluster string            `json:"target_cluster"`
+}
+
+// TopicConfigEntry mirrors the TopicConfigEntry schema.
+type TopicConfigEntry struct {
+	Default       string `json:"default"`
+	Documentation string `json:"documentation"`
+	Name          string `json:"name"`
+	ReadOnly      bool   `json:"read_only"`
+	Sensitive     bool   `json:"sensitive"`
+	Source        string `json:"source"`
+	Type          string `json:"type"`
+	Value         string `json:"value"`
+}
+
+// TopicDeletionCheck mirrors the TopicDeletionCheck schema.
+type TopicDeletionCheck struct {
+	ACLs           []ACL                  `json:"acls"`
+	ActivitySince  time.Time              `json:"activity_since"`
+	ConsumerGroups []ConsumerGroupSummary `json:"consumer_groups"`
+	DeleteAfter    *time.Time             `json:"delete_after,omitempty"`
+	Quarantined    bool                   `json:"quarantined"`
+	RecentRecords  int64                  `json:"recent_records"`
+	Topic          string                 `json:"topic"`
+}
+
+// TopicDetail mirrors the TopicDetail schema.
+type TopicDetail struct {
+	ConfigEntries     []TopicConfigEntry `json:"config_entries"`
+	Configs           map[string]string  `json:"configs"`
+	Name              string             `json:"name"`
+	PartitionDetails  []PartitionDetail  `json:"partition_details"`
+	Partitions        int64              `json:"partitions"`
+	ReplicationFactor int64              `json:"replication_factor"`
+}
+
+// TopicDifference mirrors the TopicDifference schema.
+type TopicDifference struct {
+	Configs                 []ConfigDifference `json:"configs,omitempty"`
+	SourcePartitions        int64              `json:"source_partitions"`
+	SourceReplicationFactor int64              `json:"source_replication_factor"`
+	SourceTopic             string             `json:"source_topic"`
+	TargetPartitions        int64              `json:"target_partitions"`
+	TargetReplicationFactor int64              `json:"target_replication_factor"`
+	TargetTopic             string             `json:"target_topic"`
+}
+
+// TopicPartition mirrors the TopicPartition schema.
+type TopicPartition struct {
+	Partition int32  `json:"partition"`
+	Topic     string `json:"topic"`
+}
+
+// TopicPlan mirrors the TopicPlan schema.
+type TopicPlan struct {
+	Changes  []TopicChange `json:"changes"`
+	Cluster  string        `json:"cluster"`
+	Warnings []string      `json:"warnings,omitempty"`
+}
+
+// TopicPolicy mirrors the TopicPolicy schema.
+type TopicPolicy struct {
+	ForbiddenConfigs         []string `json:"forbidden_configs,omitempty"`
+	MaxPartitions            int32    `json:"max_partitions,omitempty"`
+	MinPartitions            int32    `json:"min_partitions,omitempty"`
+	MinReplicationFactor     int32    `json:"min_replication_factor,omitempty"`
+	NamePattern              string   `json:"name_pattern,omitempty"`
+	RequireMinInsyncReplicas bool     `json:"require_min_insync_replicas,omitempty"`
+}
+
+// TopicSummary mirrors the TopicSummary schema.
+type TopicSummary struct {
+	Name       string `json:"name"`
+	Partitions int64  `json:"partitions"`
+}
+
+// TopicTemplate mirrors the TopicTemplate schema.
+type TopicTemplate struct {
+	Configs           map[string]string `json:"configs,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Name              string            `json:"name"`
+	Partitions        int32             `json:"partitions,omitempty"`
+	ReplicationFactor int32             `json:"replication_factor,omitempty"`
+}
+
+// Transaction mirrors the Transaction schema.
+type Transaction struct {
+	Coordinator     int32            `json:"coordinator"`
+	Partitions      []TopicPartition `json:"partitions"`
+	ProducerEpoch   int32            `json:"producer_epoch"`
+	ProducerID      int64            `json:"producer_id"`
+	StartTimestamp  int64            `json:"start_timestamp"`
+	State           string           `json:"state"`
+	TimeoutMs       int32            `json:"timeout_ms"`
+	TransactionalID string           `json:"transactional_id"`
+}
+
+// UpdateTopicConfigRequest mirrors the UpdateTopicConfigRequest schema.
+type UpdateTopicConfigRequest struct {
+	Configs map[string]*string `json:"configs"`
+}
+
+// UpsertSCRAMUserRequest mirrors the UpsertSCRAMUserRequest schema.
+type UpsertSCRAMUserRequest struct {
+	Iterations int32  `json:"iterations"`
+	Mechanism  string `json:"mechanism"`
+	Name       string `json:"name,omitempty"`
+	Password   string `json:"password"`
+}
+
+// User mirrors the User schema.
+type User struct {
+	Groups   []string `json:"groups,omitempty"`
+	Name     string   `json:"name"`
+	Provider string   `json:"provider"`
+	Scopes   []Action `json:"scopes,omitempty"`
+}
+
+// ListAuditEventsParams holds the query parameters of ListAuditEvents.
+type ListAuditEventsParams struct {
+	User    string
+	Cluster string
+	// Part of the resource name
+	Resource string
+	// Audited action, such as topic.create
+	Action string
+	// success, failure or denied
+	Result string
+	// RFC 3339 time or date
+	Since string
+	// RFC 3339 time or date, which includes the whole day
+	Until string
+	// Maximum number of events, 200 by default
+	Limit int64
+}
+
+// ListAuditEvents calls GET /audit: list audit events, newest first.
+func (c *Client) ListAuditEvents(ctx context.Context, params ListAuditEventsParams) ([]AuditEvent, error) {
+	q := url.Values{}
+	if params.User != "" {
+		q.Set("user", params.User)
+	}
+	if params.Cluster != "" {
+		q.Set("cluster", params.Cluster)
+	}
+	if params.Resource != "" {
+		q.Set("resource", params.Resource)
+	}
+	if params.Action != "" {
+		q.Set("action", params.Action)
+	}
+	if params.Result != "" {
+		q.Set("result", params.Result)
+	}
+	if params.Since != "" {
+		q.Set("since", params.Since)
+	}
+	if params.Until != "" {
+		q.Set("until", params.Until)
+	}
+	if params.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(int64(params.Limit), 10))
+	}
+	var out []AuditEvent
+	err := c.do(ctx, "GET", "/audit", q, nil, &out)
+	return out, err
+}
+
+// ListClusters calls GET /clusters: list the clusters with their statistics.
+func (c *Client) ListClusters(ctx context.Context) ([]ClusterOverview, error) {
+	q := url.Values{}
+	var out []ClusterOverview
+	err := c.do(ctx, "GET", "/clusters", q, nil, &out)
+	return out, err
+}
+
+// AddCluster calls POST /clusters: add a cluster.
+func (c *Client) AddCluster(ctx context.Context, body ClusterConfig) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters", q, body, nil)
+}
+
+// GetCluster calls GET /clusters/{clusterName}: get a cluster with its brokers and consumer groups.
+func (c *Client) GetCluster(ctx context.Context, clusterName string) (ClusterOverview, error) {
+	q := url.Values{}
+	var out ClusterOverview
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName), q, nil, &out)
+	return out, err
+}
+
+// UpdateCluster calls PUT /clusters/{clusterName}: update the configuration of a cluster.
+func (c *Client) UpdateCluster(ctx context.Context, clusterName string, body ClusterConfig) error {
+	q := url.Values{}
+	return c.do(ctx, "PUT", "/clusters"+"/"+url.PathEscape(clusterName), q, body, nil)
+}
+
+// DeleteCluster calls DELETE /clusters/{clusterName}: remove a cluster from the configuration.
+func (c *Client) DeleteCluster(ctx context.Context, clusterName string) error {
+	q := url.Values{}
+	return c.do(ctx, "DELETE", "/clusters"+"/"+url.PathEscape(clusterName), q, nil, nil)
+}
+
+// ListACLsParams holds the query parameters of ListACLs.
+type ListACLsParams struct {
+	// Principal, such as User:alice
+	Principal    string
+	Host         string
+	ResourceType string
+	ResourceName string
+	// LITERAL, PREFIXED or MATCH
+	PatternType string
+	Operation   string
+	Permission  string
+}
+
+// ListACLs calls GET /clusters/{clusterName}/acls: list the ACLs matching a filter.
+func (c *Client) ListACLs(ctx context.Context, clusterName string, params ListACLsParams) ([]ACL, error) {
+	q := url.Values{}
+	if params.Principal != "" {
+		q.Set("principal", params.Principal)
+	}
+	if params.Host != "" {
+		q.Set("host", params.Host)
+	}
+	if params.ResourceType != "" {
+		q.Set("resource_type", params.ResourceType)
+	}
+	if params.ResourceName != "" {
+		q.Set("resource_name", params.ResourceName)
+	}
+	if params.PatternType != "" {
+		q.Set("pattern_type", params.PatternType)
+	}
+	if params.Operation != "" {
+		q.Set("operation", params.Operation)
+	}
+	if params.Permission != "" {
+		q.Set("permission", params.Permission)
+	}
+	var out []ACL
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/acls", q, nil, &out)
+	return out, err
+}
+
+// CreateACL calls POST /clusters/{clusterName}/acls: create an ACL.
+func (c *Client) CreateACL(ctx context.Context, clusterName string, body ACL) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/acls", q, body, nil)
+}
+
+// DeleteACLs calls DELETE /clusters/{clusterName}/acls: delete the ACLs matching a filter and answer them.
+func (c *Client) DeleteACLs(ctx context.Context, clusterName string, body ACLFilter) ([]ACL, error) {
+	q := url.Values{}
+	var out []ACL
+	err := c.do(ctx, "DELETE", "/clusters"+"/"+url.PathEscape(clusterName)+"/acls", q, body, &out)
+	return out, err
+}
+
+// ListConsumerGroups calls GET /clusters/{clusterName}/consumer-groups: list the consumer groups of a cluster with their lag.
+func (c *Client) ListConsumerGroups(ctx context.Context, clusterName string) ([]ConsumerGroup, error) {
+	q := url.Values{}
+	var out []ConsumerGroup
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/consumer-groups", q, nil, &out)
+	return out, err
+}
+
+// GetConsumerGroup calls GET /clusters/{clusterName}/consumer-groups/{consumerGroupName}: get a consumer group with its members and lag.
+func (c *Client) GetConsumerGroup(ctx context.Context, clusterName string, consumerGroupName string) (ConsumerGroup, error) {
+	q := url.Values{}
+	var out ConsumerGroup
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/consumer-groups"+"/"+url.PathEscape(consumerGroupName), q, nil, &out)
+	return out, err
+}
+
+// ListConsumerGroupACLs calls GET /clusters/{clusterName}/consumer-groups/{consumerGroupName}/acls: list the ACLs applying to a consumer group.
+func (c *Client) ListConsumerGroupACLs(ctx context.Context, clusterName string, consumerGroupName string) ([]ACL, error) {
+	q := url.Values{}
+	var out []ACL
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/consumer-groups"+"/"+url.PathEscape(consumerGroupName)+"/acls", q, nil, &out)
+	return out, err
+}
+
+// ResetConsumerGroupOffsets calls POST /clusters/{clusterName}/consumer-groups/{consumerGroupName}/offsets/reset: reset the committed offsets of a consumer group.
+func (c *Client) ResetConsumerGroupOffsets(ctx context.Context, clusterName string, consumerGroupName string, body ResetOffsetsRequest) ([]OffsetReset, error) {
+	q := url.Values{}
+	var out []OffsetReset
+	err := c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/consumer-groups"+"/"+url.PathEscape(consumerGroupName)+"/offsets"+"/reset", q, body, &out)
+	return out, err
+}
+
+// ExportClusterParams holds the query parameters of ExportCluster.
+type ExportClusterParams struct {
+	// Include the committed offsets of the consumer groups
+	Groups bool
+	// Include the ACLs
+	ACLs bool
+}
+
+// ExportCluster calls GET /clusters/{clusterName}/export: export the topic state of a cluster.
+func (c *Client) ExportCluster(ctx context.Context, clusterName string, params ExportClusterParams) (DesiredTopicState, error) {
+	q := url.Values{}
+	if params.Groups {
+		q.Set("groups", "true")
+	}
+	if params.ACLs {
+		q.Set("acls", "true")
+	}
+	var out DesiredTopicState
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/export", q, nil, &out)
+	return out, err
+}
+
+// GetClusterInternals calls GET /clusters/{clusterName}/internals: get the metadata quorum, features and API versions of a cluster.
+func (c *Client) GetClusterInternals(ctx context.Context, clusterName string) (ClusterInternals, error) {
+	q := url.Values{}
+	var out ClusterInternals
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/internals", q, nil, &out)
+	return out, err
+}
+
+// ListQuarantinedTopics calls GET /clusters/{clusterName}/quarantined-topics: list the quarantined topics of a cluster.
+func (c *Client) ListQuarantinedTopics(ctx context.Context, clusterName string) ([]QuarantinedTopic, error) {
+	q := url.Values{}
+	var out []QuarantinedTopic
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/quarantined-topics", q, nil, &out)
+	return out, err
+}
+
+// ListQuotas calls GET /clusters/{clusterName}/quotas: list the client quotas of a cluster.
+func (c *Client) ListQuotas(ctx context.Context, clusterName string) ([]ClientQuota, error) {
+	q := url.Values{}
+	var out []ClientQuota
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/quotas", q, nil, &out)
+	return out, err
+}
+
+// AlterQuotas calls PUT /clusters/{clusterName}/quotas: set or remove the quotas of an entity.
+func (c *Client) AlterQuotas(ctx context.Context, clusterName string, body AlterClientQuotaRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "PUT", "/clusters"+"/"+url.PathEscape(clusterName)+"/quotas", q, body, nil)
+}
+
+// ListTopicsParams holds the query parameters of ListTopics.
+type ListTopicsParams struct {
+	// Include internal topics
+	Internal bool
+}
+
+// ListTopics calls GET /clusters/{clusterName}/topics: list the topics of a cluster.
+func (c *Client) ListTopics(ctx context.Context, clusterName string, params ListTopicsParams) ([]TopicSummary, error) {
+	q := url.Values{}
+	if params.Internal {
+		q.Set("internal", "true")
+	}
+	var out []TopicSummary
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics", q, nil, &out)
+	return out, err
+}
+
+// CreateTopic calls POST /clusters/{clusterName}/topics: create a topic.
+func (c *Client) CreateTopic(ctx context.Context, clusterName string, body CreateTopicRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics", q, body, nil)
+}
+
+// BatchTopics calls POST /clusters/{clusterName}/topics/batch: apply the same change to several topics.
+func (c *Client) BatchTopics(ctx context.Context, clusterName string, body BatchTopicRequest) ([]BatchTopicResult, error) {
+	q := url.Values{}
+	var out []BatchTopicResult
+	err := c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/batch", q, body, &out)
+	return out, err
+}
+
+// GetTopic calls GET /clusters/{clusterName}/topics/{topicName}: get a topic with its configs and partitions.
+func (c *Client) GetTopic(ctx context.Context, clusterName string, topicName string) (TopicDetail, error) {
+	q := url.Values{}
+	var out TopicDetail
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName), q, nil, &out)
+	return out, err
+}
+
+// DeleteTopicParams holds the query parameters of DeleteTopic.
+type DeleteTopicParams struct {
+	// The topic name, to confirm the deletion of a topic in use
+	Confirmation string
+}
+
+// DeleteTopic calls DELETE /clusters/{clusterName}/topics/{topicName}: delete a topic.
+func (c *Client) DeleteTopic(ctx context.Context, clusterName string, topicName string, params DeleteTopicParams) error {
+	q := url.Values{}
+	if params.Confirmation != "" {
+		q.Set("confirmation", params.Confirmation)
+	}
+	return c.do(ctx, "DELETE", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName), q, nil, nil)
+}
+
+// ListTopicACLs calls GET /clusters/{clusterName}/topics/{topicName}/acls: list the ACLs applying to a topic.
+func (c *Client) ListTopicACLs(ctx context.Context, clusterName string, topicName string) ([]ACL, error) {
+	q := url.Values{}
+	var out []ACL
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/acls", q, nil, &out)
+	return out, err
+}
+
+// UpdateTopicConfig calls PUT /clusters/{clusterName}/topics/{topicName}/config: set or reset topic configs.
+func (c *Client) UpdateTopicConfig(ctx context.Context, clusterName string, topicName string, body UpdateTopicConfigRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "PUT", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/config", q, body, nil)
+}
+
+// ListTopicConsumerGroups calls GET /clusters/{clusterName}/topics/{topicName}/consumer-groups: list the consumer groups consuming a topic.
+func (c *Client) ListTopicConsumerGroups(ctx context.Context, clusterName string, topicName string) ([]ConsumerGroup, error) {
+	q := url.Values{}
+	var out []ConsumerGroup
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/consumer-groups", q, nil, &out)
+	return out, err
+}
+
+// CheckTopicDeletion calls GET /clusters/{clusterName}/topics/{topicName}/deletion-check: check whether a topic is in use before deleting it.
+func (c *Client) CheckTopicDeletion(ctx context.Context, clusterName string, topicName string) (TopicDeletionCheck, error) {
+	q := url.Values{}
+	var out TopicDeletionCheck
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/deletion-check", q, nil, &out)
+	return out, err
+}
+
+// ConsumeMessagesParams holds the query parameters of ConsumeMessages.
+type ConsumeMessagesParams struct {
+	// Maximum number of messages, 100 by default and at most 1000
+	Limit int64
+	// How long to wait, such as 10s; 5s by default and at most 30s
+	Timeout string
+}
+
+// ConsumeMessages calls GET /clusters/{clusterName}/topics/{topicName}/messages: wait for new messages on a topic.
+func (c *Client) ConsumeMessages(ctx context.Context, clusterName string, topicName string, params ConsumeMessagesParams) ([]Message, error) {
+	q := url.Values{}
+	if params.Limit != 0 {
+		q.Set("limit", strconv.FormatInt(int64(params.Limit), 10))
+	}
+	if params.Timeout != "" {
+		q.Set("timeout", params.Timeout)
+	}
+	var out []Message
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/messages", q, nil, &out)
+	return out, err
+}
+
+// ProduceMessage calls POST /clusters/{clusterName}/topics/{topicName}/messages: produce a message to a topic.
+func (c *Client) ProduceMessage(ctx context.Context, clusterName string, topicName string, body MessageRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/messages", q, body, nil)
+}
+
+// IncreasePartitions calls POST /clusters/{clusterName}/topics/{topicName}/partitions: increase the partitions of a topic.
+func (c *Client) IncreasePartitions(ctx context.Context, clusterName string, topicName string, body IncreasePartitionsRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/partitions", q, body, nil)
+}
+
+// ListTopicProducers calls GET /clusters/{clusterName}/topics/{topicName}/producers: list the producers writing to a topic.
+func (c *Client) ListTopicProducers(ctx context.Context, clusterName string, topicName string) ([]ActiveProducer, error) {
+	q := url.Values{}
+	var out []ActiveProducer
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/producers", q, nil, &out)
+	return out, err
+}
+
+// AbortTransaction calls POST /clusters/{clusterName}/topics/{topicName}/producers/abort: abort the open transaction of a producer on a partition.
+func (c *Client) AbortTransaction(ctx context.Context, clusterName string, topicName string, body AbortTransactionRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/producers"+"/abort", q, body, nil)
+}
+
+// QuarantineTopic calls POST /clusters/{clusterName}/topics/{topicName}/quarantine: block a topic and delete it after some days.
+func (c *Client) QuarantineTopic(ctx context.Context, clusterName string, topicName string, body QuarantineTopicRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/quarantine", q, body, nil)
+}
+
+// ReleaseTopicQuarantine calls DELETE /clusters/{clusterName}/topics/{topicName}/quarantine: release a quarantined topic.
+func (c *Client) ReleaseTopicQuarantine(ctx context.Context, clusterName string, topicName string) error {
+	q := url.Values{}
+	return c.do(ctx, "DELETE", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/quarantine", q, nil, nil)
+}
+
+// DeleteRecords calls POST /clusters/{clusterName}/topics/{topicName}/records/delete: delete records of a topic.
+func (c *Client) DeleteRecords(ctx context.Context, clusterName string, topicName string, body DeleteRecordsRequest) ([]DeleteRecordsResult, error) {
+	q := url.Values{}
+	var out []DeleteRecordsResult
+	err := c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/records"+"/delete", q, body, &out)
+	return out, err
+}
+
+// PreviewDeleteRecords calls POST /clusters/{clusterName}/topics/{topicName}/records/preview: preview which records a deletion would remove.
+func (c *Client) PreviewDeleteRecords(ctx context.Context, clusterName string, topicName string, body DeleteRecordsRequest) ([]DeleteRecordsResult, error) {
+	q := url.Values{}
+	var out []DeleteRecordsResult
+	err := c.do(ctx, "POST", "/clusters"+"/"+url.PathEscape(clusterName)+"/topics"+"/"+url.PathEscape(topicName)+"/records"+"/preview", q, body, &out)
+	return out, err
+}
+
+// ListTransactions calls GET /clusters/{clusterName}/transactions: list the transactions of a cluster.
+func (c *Client) ListTransactions(ctx context.Context, clusterName string) ([]Transaction, error) {
+	q := url.Values{}
+	var out []Transaction
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/transactions", q, nil, &out)
+	return out, err
+}
+
+// ListSCRAMUsers calls GET /clusters/{clusterName}/users: list the SCRAM users of a cluster.
+func (c *Client) ListSCRAMUsers(ctx context.Context, clusterName string) ([]SCRAMUser, error) {
+	q := url.Values{}
+	var out []SCRAMUser
+	err := c.do(ctx, "GET", "/clusters"+"/"+url.PathEscape(clusterName)+"/users", q, nil, &out)
+	return out, err
+}
+
+// UpsertSCRAMUser calls PUT /clusters/{clusterName}/users/{userName}: create or update a SCRAM credential.
+func (c *Client) UpsertSCRAMUser(ctx context.Context, clusterName string, userName string, body UpsertSCRAMUserRequest) error {
+	q := url.Values{}
+	return c.do(ctx, "PUT", "/clusters"+"/"+url.PathEscape(clusterName)+"/users"+"/"+url.PathEscape(userName), q, body, nil)
+}
+
+// DeleteSCRAMUserParams holds the query parameters of DeleteSCRAMUser.
+type DeleteSCRAMUserParams struct {
+	// Delete only the SCRAM-SHA-256 or SCRAM-SHA-512 credential; every credential by default
+	Mechanism string
+}
+
+// DeleteSCRAMUser calls DELETE /clusters/{clusterName}/users/{userName}: delete a SCRAM credential.
+func (c *Client) DeleteSCRAMUser(ctx context.Context, clusterName string, userName string, params DeleteSCRAMUserParams) error {
+	q := url.Values{}
+	if params.Mechanism != "" {
+		q.Set("mechanism", params.Mechanism)
+	}
+	return c.do(ctx, "DELETE", "/clusters"+"/"+url.PathEscape(clusterName)+"/users"+"/"+url.PathEscape(userName), q, nil, nil)
+}
+
+// CompareParams holds the query parameters of Compare.
+type CompareParams struct {
+	// Source cluster
+	Source string
+	// Target cluster
+	Target string
+	// Compare this topic only
+	SourceTopic string
+	// Topic of the target cluster to compare source_topic with, the same name by default
+	TargetTopic string
+}
+
+// Compare calls GET /compare: compare the topics of two clusters, or two single topics.
+func (c *Client) Compare(ctx context.Context, params CompareParams) (TopicComparison, error) {
+	q := url.Values{}
+	if params.Source != "" {
+		q.Set("source", params.Source)
+	}
+	if params.Target != "" {
+		q.Set("target", params.Target)
+	}
+	if params.SourceTopic != "" {
+		q.Set("source_topic", params.SourceTopic)
+	}
+	if params.TargetTopic != "" {
+		q.Set("target_topic", params.TargetTopic)
+	}
+	var out TopicComparison
+	err := c.do(ctx, "GET", "/compare", q, nil, &out)
+	return out, err
+}
+
+// ListTokens calls GET /tokens: list your API tokens.
+func (c *Client) ListTokens(ctx context.Context) ([]APIToken, error) {
+	q := url.Values{}
+	var out []APIToken
+	err := c.do(ctx, "GET", "/tokens", q, nil, &out)
+	return out, err
+}
+
+// CreateToken calls POST /tokens: create an API token.
+func (c *Client) CreateToken(ctx context.Context, body CreateTokenRequest) (CreatedToken, error) {
+	q := url.Values{}
+	var out CreatedToken
+	err := c.do(ctx, "POST", "/tokens", q, body, &out)
+	return out, err
+}
+
+// RevokeToken calls DELETE /tokens/{tokenID}: revoke an API token.
+func (c *Client) RevokeToken(ctx context.Context, tokenID string) error {
+	q := url.Values{}
+	return c.do(ctx, "DELETE", "/tokens"+"/"+url.PathEscape(tokenID), q, nil, nil)
+}
+
+// ListTopicTemplates calls GET /topic-templates: list the topic templates.
+func (c *Client) ListTopicTemplates(ctx context.Context) ([]TopicTemplate, error) {
+	q := url.Values{}
+	var out []TopicTemplate
+	err := c.do(ctx, "GET", "/topic-templates", q, nil, &out)
+	return out, err
+}
+
+// ApplyTopicsParams holds the query parameters of ApplyTopics.
+type ApplyTopicsParams struct {
+	// Also delete the topics missing from the state
+	Delete bool
+}
+
+// ApplyTopics calls POST /topics/apply: apply the changes converging the clusters to a topic state.
+func (c *Client) ApplyTopics(ctx context.Context, params ApplyTopicsParams, body DesiredTopicState) ([]TopicApplyResult, error) {
+	q := url.Values{}
+	if params.Delete {
+		q.Set("delete", "true")
+	}
+	var out []TopicApplyResult
+	err := c.do(ctx, "POST", "/topics"+"/apply", q, body, &out)
+	return out, err
+}
+
+// PlanTopicsParams holds the query parameters of PlanTopics.
+type PlanTopicsParams struct {
+	// Also delete the topics missing from the state
+	Delete bool
+}
+
+// PlanTopics calls POST /topics/plan: plan the changes converging the clusters to a topic state.
+func (c *Client) PlanTopics(ctx context.Context, params PlanTopicsParams, body DesiredTopicState) ([]TopicPlan, error) {
+	q := url.Values{}
+	if params.Delete {
+		q.Set("delete", "true")
+	}
+	var out []TopicPlan
+	err := c.do(ctx, "POST", "/topics"+"/plan", q, body, &out)
+	return out, err
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	httpserver "github.com/OliveiraNt/maned-scout/internal/adapters/http"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/openapi"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientGenUpToDate(t *testing.T) {
+	want, err := openapi.GenerateClient(httpserver.OpenAPI(), "client")
+	require.NoError(t, err)
+	got, err := os.ReadFile("client_gen.go")
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got), "client_gen.go is stale; run go generate ./client")
+}
+
+func TestClient(t *testing.T) {
+	utils.InitLogger()
+	config.InitI18n()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "dev", Brokers: []string{"b1"}, Protected: true}}
+	kafka := testutil.NewFakeKafkaClient()
+	kafka.Topics = map[string]int{"orders": 3}
+	repo.Clients["dev"] = kafka
+	clusters := application.NewClusterService(repo)
+	srv := httptest.NewServer(httpserver.New(clusters, application.NewTopicService(clusters), nil,
+		application.NewTokenService(nil, clusters)).Handler())
+	t.Cleanup(srv.Close)
+
+	c := New(srv.URL, WithToken("ignored"))
+	ctx := context.Background()
+
+	topics, err := c.ListTopics(ctx, "dev", ListTopicsParams{})
+	require.NoError(t, err)
+	require.Equal(t, []TopicSummary{{Name: "orders", Partitions: 3}}, topics)
+
+	req := CreateTopicRequest{Name: "payments", NumPartitions: 6, ReplicationFactor: 1}
+	err = c.CreateTopic(ctx, "dev", req)
+	var apiErr *Error
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusPreconditionRequired, apiErr.StatusCode)
+	require.Equal(t, "confirmation_required", apiErr.Code)
+
+	require.NoError(t, c.CreateTopic(ConfirmClusters(ctx, "dev"), "dev", req))
+
+	_, err = c.GetCluster(ctx, "prod")
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	require.Equal(t, "not_found", apiErr.Code)
+}
@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/user"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+const cliUsage = `Usage: maned-scout <command> [flags]
+
+Commands:
+  serve                                 start the web interface and API (the default)
+  tui                                   browse the clusters in a full-screen terminal interface
+  clusters list                         list the configured clusters
+  topics list|describe|create|delete    manage the topics of a cluster
+  topics plan|apply                     converge topics with a desired state file
+  consume                               read messages from a topic
+  produce                               write messages to a topic
+  groups list|describe|reset-offsets    inspect consumer groups and move their offsets
+  config validate                       check the configuration file
+
+Run maned-scout <command> -h for the flags of a command.
+`
+
+// Output formats selected with -o.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatYAML  = "yaml"
+)
+
+// errUsage is returned once a command has printed its usage.
+var errUsage = errors.New("usage")
+
+// errPending is returned by a plan with pending changes, which exits with 2.
+var errPending = errors.New("changes pending")
+
+// CLI runs the command-line subcommands against the clusters of the configuration file,
+// through the same application services as the web interface.
+type CLI struct {
+	Clusters   *application.ClusterService
+	ConfigPath string
+	Stdin      io.Reader
+	Stdout     io.Writer
+	Stderr     io.Writer
+}
+
+// LocalRequest identifies the changes made by commands in the audit log with the operating system user
+// running them.
+func LocalRequest() domain.RequestInfo {
+	name := os.Getenv("USER")
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	return domain.RequestInfo{OSUser: name}
+}
+
+// Run runs the subcommand named by args and returns the process exit code.
+func (c *CLI) Run(args []string) int {
+	if len(args) == 0 {
+		_, _ = fmt.Fprint(c.Stderr, cliUsage)
+		return 1
+	}
+
+	var err error
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		_, _ = fmt.Fprint(c.Stdout, cliUsage)
+		return 0
+	case "topics":
+		err = c.subcommand(args, map[string]func([]string) error{
+			"list": c.topicsList, "describe": c.topicsDescribe, "create": c.topicsCreate, "delete": c.topicsDelete,
+			"plan": c.topicsPlan, "apply": c.topicsApply,
+		})
+	case "tui":
+		err = c.tui(args[1:])
+	case "clusters":
+		err = c.subcommand(args, map[string]func([]string) error{"list": c.clustersList})
+	case "consume":
+		err = c.consume(args[1:])
+	case "produce":
+		err = c.produce(args[1:])
+	case "groups":
+		err = c.subcommand(args, map[string]func([]string) error{
+			"list": c.groupsList, "describe": c.groupsDescribe, "reset-offsets": c.groupsResetOffsets,
+		})
+	case "config":
+		err = c.subcommand(args, map[string]func([]string) error{"validate": c.configValidate})
+	default:
+		_, _ = fmt.Fprintf(c.Stderr, "unknown command %q\n\n%s", args[0], cliUsage)
+		return 1
+	}
+
+	if errors.Is(err, errPending) {
+		return 2
+	}
+	if err != nil {
+		if !errors.Is(err, errUsage) && !errors.Is(err, flag.ErrHelp) {
+			_, _ = fmt.Fprintf(c.Stderr, "error: %v\n", err)
+		}
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+	return 0
+}
+
+// subcommand runs the action named by args[1] of the command named by args[0].
+func (c *CLI) subcommand(args []string, actions map[string]func([]string) error) error {
+	if len(args) > 1 {
+		if run, ok := actions[args[1]]; ok {
+			return run(args[2:])
+		}
+	}
+	names := slices.Sorted(maps.Keys(actions))
+	_, _ = fmt.Fprintf(c.Stderr, "Usage: maned-scout %s <%s> [flags]\n", args[0], strings.Join(names, "|"))
+	return errUsage
+}
+
+// command holds the flags of a subcommand.
+type command struct {
+	cli     *CLI
+	fs      *flag.FlagSet
+	args    string
+	format  *string
+	cluster *string
+}
+
+// newCommand creates the flags of a subcommand; args describes its positional arguments for the usage.
+func (c *CLI) newCommand(name, args, description string) *command {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.Stderr)
+	cmd := &command{cli: c, fs: fs, args: args}
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(c.Stderr, "Usage: maned-scout %s [flags] %s\n\n%s\n\nFlags:\n", name, args, description)
+		fs.PrintDefaults()
+	}
+	return cmd
+}
+
+// withCluster adds the -cluster flag, which may be left out when a single cluster is configured.
+func (cmd *command) withCluster() *command {
+	cmd.cluster = cmd.fs.String("cluster", "", "cluster to run against, needed when several are configured")
+	return cmd
+}
+
+// withOutput adds the -o flag choosing the output format.
+func (cmd *command) withOutput() *command {
+	cmd.format = cmd.fs.String("o", formatTable, "output format: table, json or yaml")
+	return cmd
+}
+
+// parse parses the flags, placed before or after the positional arguments, and checks that
+// exactly n positional arguments were given, or any number when n is negative.
+func (cmd *command) parse(args []string, n int) ([]string, error) {
+	var positional []string
+	for {
+		if err := cmd.fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = cmd.fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+	if n >= 0 && len(positional) != n {
+		cmd.fs.Usage()
+		return nil, errUsage
+	}
+	if cmd.format != nil && *cmd.format != formatTable && *cmd.format != formatJSON && *cmd.format != formatYAML {
+		return nil, fmt.Errorf("unknown output format %q", *cmd.format)
+	}
+	if cmd.cluster != nil && *cmd.cluster == "" {
+		clusters := cmd.cli.Clusters.ListClusters()
+		if len(clusters) != 1 {
+			return nil, fmt.Errorf("-cluster is required when %d clusters are configured", len(clusters))
+		}
+		*cmd.cluster = clusters[0].Name
+	}
+	return positional, nil
+}
+
+// confirmProtected refuses changes to a protected cluster unless its name was repeated with -confirm-cluster,
+// like the web interface and the API ask.
+func (c *CLI) confirmProtected(cluster, confirmed string) error {
+	cfg, ok := c.Clusters.GetCluster(cluster)
+	if !ok {
+		return application.ErrClusterNotFound
+	}
+	if cfg.Protected && confirmed != cluster {
+		return fmt.Errorf("cluster %s is protected: repeat its name with -confirm-cluster %s to change it", cluster, cluster)
+	}
+	return nil
+}
+
+// print writes v in the chosen format. Tables are written by table, aligned on tabs.
+func (cmd *command) print(v any, table func(w io.Writer)) error {
+	w := cmd.cli.Stdout
+	switch *cmd.format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatYAML:
+		out, err := toYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+// toYAML encodes v as YAML under its JSON field names, which the domain types declare.
+func toYAML(v any) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(yamlNumbers(doc))
+}
+
+// yamlNumbers replaces the JSON numbers of a decoded document with integers or floats, which YAML
+// writes unquoted.
+func yamlNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i := range v {
+			v[i] = yamlNumbers(v[i])
+		}
+	case map[string]any:
+		for k := range v {
+			v[k] = yamlNumbers(v[k])
+		}
+	}
+	return v
+}
+
+// row writes the cells of a table row.
+func row(w io.Writer, cells ...any) {
+	for i, cell := range cells {
+		if i > 0 {
+			_, _ = fmt.Fprint(w, "\t")
+		}
+		_, _ = fmt.Fprint(w, cell)
+	}
+	_, _ = fmt.Fprintln(w)
+}
@@ -0,0 +1,185 @@
+//go:build testing
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCLI(t *testing.T, cfgs ...config.ClusterConfig) (*CLI, *testutil.FakeKafkaClient, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = cfgs
+	client := testutil.NewFakeKafkaClient()
+	for _, cfg := range cfgs {
+		repo.Clients[cfg.Name] = client
+	}
+	var stdout, stderr bytes.Buffer
+	cli := &CLI{Clusters: application.NewClusterService(repo), Stdin: strings.NewReader(""), Stdout: &stdout, Stderr: &stderr}
+	return cli, client, &stdout, &stderr
+}
+
+func TestCLI_TopicsListFormats(t *testing.T) {
+	cli, client, stdout, _ := newTestCLI(t, config.ClusterConfig{Name: "c1", Brokers: []string{"b1"}})
+	client.Topics = map[string]int{"orders": 3, "audit": 1}
+
+	require.Equal(t, 0, cli.Run([]string{"topics", "list"}))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"NAME", "PARTITIONS"}, strings.Fields(lines[0]))
+	require.Equal(t, []string{"audit", "1"}, strings.Fields(lines[1]))
+
+	stdout.Reset()
+	require.Equal(t, 0, cli.Run([]string{"topics", "list", "-o", "json"}))
+	require.JSONEq(t, `[{"name":"audit","partitions":1},{"name":"orders","partitions":3}]`, stdout.String())
+
+	stdout.Reset()
+	require.Equal(t, 0, cli.Run([]string{"topics", "list", "-o", "yaml"}))
+	require.Contains(t, stdout.String(), "- name: audit\n  partitions: 1\n")
+
+	require.Equal(t, 1, cli.Run([]string{"topics", "list", "-o", "xml"}))
+}
+
+func TestCLI_ClusterRequiredWithSeveralClusters(t *testing.T) {
+	cli, _, _, stderr := newTestCLI(t,
+		config.ClusterConfig{Name: "c1", Brokers: []string{"b1"}},
+		config.ClusterConfig{Name: "c2", Brokers: []string{"b2"}})
+
+	require.Equal(t, 1, cli.Run([]string{"topics", "list"}))
+	require.Contains(t, stderr.String(), "-cluster is required")
+	require.Equal(t, 0, cli.Run([]string{"topics", "list", "-cluster", "c2"}))
+}
+
+func TestCLI_ProduceNeedsConfirmationOnProtectedCluster(t *testing.T) {
+	cli, client, _, stderr := newTestCLI(t, config.ClusterConfig{Name: "prod", Brokers: []string{"b1"}, Protected: true})
+	cli.Stdin = strings.NewReader("k1=one\nk2=two\n")
+
+	require.Equal(t, 1, cli.Run([]string{"produce", "orders", "-key-separator", "="}))
+	require.Contains(t, stderr.String(), "-confirm-cluster")
+	require.Empty(t, client.Written)
+
+	require.Equal(t, 0, cli.Run([]string{"produce", "orders", "-key-separator", "=", "-confirm-cluster", "prod"}))
+	require.Len(t, client.Written, 2)
+	require.Equal(t, "k2", string(client.Written[1].Key))
+	require.Equal(t, "two", string(client.Written[1].Value))
+}
+
+func TestCLI_ProduceFailsWhenTheBrokersRejectTheMessage(t *testing.T) {
+	cli, client, _, stderr := newTestCLI(t, config.ClusterConfig{Name: "c1", Brokers: []string{"b1"}})
+	client.Err = errors.New("NOT_ENOUGH_REPLICAS")
+
+	require.Equal(t, 1, cli.Run([]string{"produce", "orders", "-value", "v"}))
+	require.Contains(t, stderr.String(), "message 1 not written, 0 written before: NOT_ENOUGH_REPLICAS")
+	require.NotContains(t, stderr.String(), "messages written to")
+}
+
+func TestCLI_ConsumeFiltersAndStopsAtLimit(t *testing.T) {
+	cli, client, stdout, _ := newTestCLI(t, config.ClusterConfig{Name: "c1", Brokers: []string{"b1"}})
+	client.Messages = []domain.Message{
+		{Partition: 0, Offset: 0, Key: []byte("a"), Value: []byte("created")},
+		{Partition: 0, Offset: 1, Key: []byte("b"), Value: []byte("paid")},
+		{Partition: 0, Offset: 2, Key: []byte("a"), Value: []byte("paid")},
+		{Partition: 0, Offset: 3, Key: []byte("a"), Value: []byte("shipped")},
+	}
+
+	require.Equal(t, 0, cli.Run([]string{"consume", "orders", "-from", "earliest", "-key", "a", "-limit", "2", "-o", "json"}))
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"value":"created"`)
+	require.Contains(t, lines[1], `"value":"paid"`)
+
+	// without a limit it stops once the source is exhausted
+	stdout.Reset()
+	require.Equal(t, 0, cli.Run([]string{"consume", "orders", "-from", "earliest", "-contains", "paid", "-o", "json"}))
+	require.Len(t, strings.Split(strings.TrimSpace(stdout.String()), "\n"), 2)
+}
+
+func TestCLI_ResetOffsetsIsADryRunByDefault(t *testing.T) {
+	cli, client, stdout, stderr := newTestCLI(t, config.ClusterConfig{Name: "c1", Brokers: []string{"b1"}})
+	client.OffsetResets = []domain.OffsetReset{{Topic: "orders", Partition: 0, From: 10, To: 0}}
+
+	require.Equal(t, 1, cli.Run([]string{"groups", "reset-offsets", "g1"}))
+	require.Contains(t, stderr.String(), "-to is required")
+
+	require.Equal(t, 0, cli.Run([]string{"groups", "reset-offsets", "g1", "-to", "earliest"}))
+	require.True(t, client.ResetRequest.DryRun)
+	require.Contains(t, stderr.String(), "dry run")
+	require.Equal(t, []string{"orders", "0", "10", "0"}, strings.Fields(strings.Split(stdout.String(), "\n")[1]))
+
+	require.Equal(t, 0, cli.Run([]string{"groups", "reset-offsets", "g1", "-to", "earliest", "-execute"}))
+	require.False(t, client.ResetRequest.DryRun)
+}
+
+func TestCLI_ConfigValidate(t *testing.T) {
+	cli, _, stdout, _ := newTestCLI(t)
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.yml")
+	require.NoError(t, os.WriteFile(valid, []byte("clusters:\n  - name: c1\n    brokers: [localhost:9092]\n"), 0o600))
+	require.Equal(t, 0, cli.Run([]string{"config", "validate", "-f", valid}))
+	require.Contains(t, stdout.String(), "is valid")
+
+	stdout.Reset()
+	invalid := filepath.Join(dir, "invalid.yml")
+	require.NoError(t, os.WriteFile(invalid, []byte("clusters:\n  - name: c1\n    brokerz: [localhost:9092]\n"), 0o600))
+	require.Equal(t, 1, cli.Run([]string{"config", "validate", "-f", invalid}))
+	require.Contains(t, stdout.String(), "brokerz")
+}
+
+func TestCLI_UnknownCommand(t *testing.T) {
+	cli, _, _, stderr := newTestCLI(t)
+
+	require.Equal(t, 1, cli.Run([]string{"nope"}))
+	require.Contains(t, stderr.String(), `unknown command "nope"`)
+	require.Equal(t, 1, cli.Run([]string{"groups", "nope"}))
+	require.Contains(t, stderr.String(), "<describe|list|reset-offsets>")
+}
+
+func TestCLI_TopicsPlanAndApply(t *testing.T) {
+	cli, client, stdout, stderr := newTestCLI(t, config.ClusterConfig{Name: "prod", Brokers: []string{"b1"}, Protected: true})
+	file := filepath.Join(t.TempDir(), "topics.yml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+clusters:
+  prod:
+    topics:
+      - name: orders
+        partitions: 3
+        replication_factor: 1
+`), 0o600))
+
+	require.Equal(t, 2, cli.Run([]string{"topics", "plan", "-f", file}))
+	require.Contains(t, stdout.String(), "+ create orders")
+
+	stdout.Reset()
+	require.Equal(t, 2, cli.Run([]string{"topics", "plan", "-f", file, "-o", "json"}))
+	require.Contains(t, stdout.String(), `"action": "create"`)
+
+	stdout.Reset()
+	require.Equal(t, 1, cli.Run([]string{"topics", "apply", "-f", file}))
+	require.Contains(t, stderr.String(), "-confirm-cluster")
+	require.Empty(t, stdout.String())
+
+	require.Equal(t, 0, cli.Run([]string{"topics", "apply", "-f", file, "-confirm-cluster", "prod"}))
+	require.Equal(t, []string{"prod", "create", "orders", "done"}, strings.Fields(strings.Split(stdout.String(), "\n")[1]))
+
+	client.Err = errors.New("boom")
+	stdout.Reset()
+	require.Equal(t, 1, cli.Run([]string{"topics", "apply", "-f", file, "-confirm-cluster", "prod"}))
+
+	stderr.Reset()
+	require.Equal(t, 1, cli.Run([]string{"topics"}))
+	require.Contains(t, stderr.String(), "apply")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (c *CLI) clustersList(args []string) error {
+	cmd := c.newCommand("clusters list", "", "Lists the configured clusters with their status and statistics.").withOutput()
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	cfgs := c.Clusters.ListClusters()
+	out := make([]domain.ClusterOverview, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		cluster, stats, err := c.Clusters.GetClusterInfo(cfg.Name)
+		if err != nil {
+			utils.Logger.Warn("get cluster info failed", "cluster", cfg.Name, "err", err)
+			continue
+		}
+		out = append(out, domain.ClusterOverview{Cluster: *cluster, Stats: stats})
+	}
+
+	return cmd.print(out, func(w io.Writer) {
+		row(w, "NAME", "STATUS", "MODE", "AUTH", "TOPICS", "PARTITIONS", "GROUPS", "BROKERS")
+		for _, o := range out {
+			status, mode := "offline", "-"
+			if o.IsOnline {
+				status = "online"
+			}
+			switch {
+			case o.ReadOnly:
+				mode = "read-only"
+			case o.Protected:
+				mode = "protected"
+			}
+			topics, partitions, groups := "-", "-", "-"
+			if o.Stats != nil {
+				topics = fmt.Sprint(o.Stats.TotalTopics)
+				partitions = fmt.Sprint(o.Stats.TotalPartitions)
+				groups = fmt.Sprint(o.Stats.TotalConsumerGroups)
+			}
+			row(w, o.Name, status, mode, o.AuthType, topics, partitions, groups, strings.Join(o.Brokers, ","))
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+)
+
+func (c *CLI) configValidate(args []string) error {
+	cmd := c.newCommand("config validate", "",
+		"Checks the configuration file for unknown keys and settings the server would reject or fail on later.")
+	path := cmd.fs.String("f", c.ConfigPath, "configuration file to check")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	cfg, err := config.ReadConfigStrict(*path)
+	if err == nil {
+		err = application.ValidateConfig(cfg)
+	}
+	if err != nil {
+		var joined interface{ Unwrap() []error }
+		problems := []error{err}
+		if errors.As(err, &joined) {
+			problems = joined.Unwrap()
+		}
+		for _, p := range problems {
+			_, _ = fmt.Fprintf(c.Stdout, "%s: %v\n", *path, p)
+		}
+		return fmt.Errorf("%s is not valid", *path)
+	}
+	_, _ = fmt.Fprintf(c.Stdout, "%s is valid\n", *path)
+	return nil
+}
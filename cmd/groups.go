@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+func (c *CLI) groupsList(args []string) error {
+	cmd := c.newCommand("groups list", "", "Lists the consumer groups of a cluster with their lag.").withCluster().withOutput()
+	topic := cmd.fs.String("topic", "", "only list the groups consuming this topic")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	lags, err := application.NewConsumerGroupsService(c.Clusters).ListConsumerGroupsWithLagFromTopic(context.Background(), *cmd.cluster, *topic)
+	if err != nil {
+		return err
+	}
+	out := make([]domain.ConsumerGroup, 0, len(lags))
+	for _, g := range lags {
+		out = append(out, domain.NewConsumerGroup(g))
+	}
+	slices.SortFunc(out, func(a, b domain.ConsumerGroup) int { return strings.Compare(a.GroupID, b.GroupID) })
+
+	return cmd.print(out, func(w io.Writer) {
+		row(w, "GROUP", "STATE", "MEMBERS", "LAG")
+		for _, g := range out {
+			row(w, g.GroupID, g.State, len(g.Members), g.TotalLag)
+		}
+	})
+}
+
+func (c *CLI) groupsDescribe(args []string) error {
+	cmd := c.newCommand("groups describe", "<group>", "Shows the members of a consumer group and its lag on every partition.").withCluster().withOutput()
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+
+	lag, err := application.NewConsumerGroupsService(c.Clusters).FetchConsumerGroupWithLag(context.Background(), *cmd.cluster, positional[0])
+	if err != nil {
+		return err
+	}
+	if lag.Group == "" {
+		return fmt.Errorf("consumer group %s not found", positional[0])
+	}
+	g := domain.NewConsumerGroup(lag)
+
+	return cmd.print(g, func(w io.Writer) {
+		row(w, "Group:", g.GroupID)
+		row(w, "State:", g.State)
+		row(w, "Protocol:", g.Protocol)
+		row(w, "Coordinator:", g.Coordinator)
+		row(w, "Total lag:", g.TotalLag)
+		row(w)
+		row(w, "MEMBER", "CLIENT", "HOST")
+		for _, m := range g.Members {
+			row(w, m.MemberID, m.ClientID, m.ClientHost)
+		}
+		row(w)
+		row(w, "TOPIC", "PARTITION", "COMMITTED", "END", "LAG", "MEMBER")
+		for _, l := range g.Lag {
+			row(w, l.Topic, l.Partition, l.CommittedOffset, l.EndOffset, l.Lag, l.MemberID)
+		}
+	})
+}
+
+func (c *CLI) groupsResetOffsets(args []string) error {
+	cmd := c.newCommand("groups reset-offsets", "<group>",
+		"Moves the committed offsets of a consumer group, which must have no active members.\n"+
+			"Without -execute it only prints the new offsets.").withCluster().withOutput()
+	topic := cmd.fs.String("topic", "", "only move the offsets of this topic, otherwise every topic the group committed offsets for")
+	to := cmd.fs.String("to", "", "new position: earliest, latest, an offset or an RFC 3339 time (required)")
+	execute := cmd.fs.Bool("execute", false, "commit the new offsets instead of printing them")
+	confirm := cmd.fs.String("confirm-cluster", "", "name of the protected cluster being changed")
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if *to == "" {
+		return fmt.Errorf("-to is required")
+	}
+	spec, err := domain.ParseOffsetSpec(*to)
+	if err != nil {
+		return err
+	}
+	if *execute {
+		if err := c.confirmProtected(*cmd.cluster, *confirm); err != nil {
+			return err
+		}
+	}
+
+	req := domain.ResetOffsetsRequest{Topic: *topic, To: spec, DryRun: !*execute}
+	resets, err := application.NewConsumerGroupsService(c.Clusters).ResetOffsets(context.Background(), *cmd.cluster, positional[0], req)
+	if err != nil {
+		return err
+	}
+	if !*execute {
+		_, _ = fmt.Fprintln(c.Stderr, "dry run: rerun with -execute to commit these offsets")
+	}
+
+	return cmd.print(resets, func(w io.Writer) {
+		row(w, "TOPIC", "PARTITION", "FROM", "TO")
+		for _, r := range resets {
+			row(w, r.Topic, r.Partition, r.From, r.To)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// printedMessage is a consumed message as printed, with its key and value as text.
+type printedMessage struct {
+	Partition int32     `json:"partition" yaml:"partition"`
+	Offset    int64     `json:"offset" yaml:"offset"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Key       string    `json:"key" yaml:"key"`
+	Value     string    `json:"value" yaml:"value"`
+}
+
+func (c *CLI) consume(args []string) error {
+	cmd := c.newCommand("consume", "<topic>",
+		"Prints the messages of a topic until -limit messages matched, -timeout passed or it is interrupted.\n"+
+			"JSON and YAML print one document per message.").withCluster().withOutput()
+	from := cmd.fs.String("from", domain.OffsetLatest, "where to start: earliest, latest, an offset or an RFC 3339 time")
+	partition := cmd.fs.Int("partition", -1, "partition to read, all of them when negative")
+	limit := cmd.fs.Int("limit", 0, "stop after this many messages, no limit when 0")
+	timeout := cmd.fs.Duration("timeout", 0, "stop after this long, no limit when 0")
+	key := cmd.fs.String("key", "", "only print messages with this key")
+	contains := cmd.fs.String("contains", "", "only print messages whose key or value contains this text")
+	since := cmd.fs.String("since", "", "only print messages produced after this RFC 3339 time or this long ago, such as 15m")
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+
+	start, err := domain.ParseOffsetSpec(*from)
+	if err != nil {
+		return err
+	}
+	filter := domain.MessageFilter{Key: *key, Contains: *contains}
+	if *since != "" {
+		if filter.Since, err = parseSince(*since, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	msgs := make(chan domain.Message)
+	done := make(chan error, 1)
+	req := domain.ConsumeRequest{From: start, Partition: int32(*partition)}
+	go func() {
+		done <- application.NewTopicService(c.Clusters).ConsumeMessages(ctx, *cmd.cluster, positional[0], req, msgs)
+	}()
+
+	if *cmd.format == formatTable {
+		_, _ = fmt.Fprintf(c.Stdout, "%-9s  %-10s  %-29s  %-20s  %s\n", "PARTITION", "OFFSET", "TIMESTAMP", "KEY", "VALUE")
+	}
+	printed := 0
+	for {
+		select {
+		case msg := <-msgs:
+			if !filter.Match(msg) {
+				continue
+			}
+			if err := c.printMessage(*cmd.format, msg); err != nil {
+				return err
+			}
+			if printed++; *limit > 0 && printed >= *limit {
+				return nil
+			}
+		case err := <-done:
+			return err
+		}
+	}
+}
+
+func (c *CLI) printMessage(format string, msg domain.Message) error {
+	m := printedMessage{Partition: msg.Partition, Offset: msg.Offset, Timestamp: msg.Timestamp, Key: string(msg.Key), Value: string(msg.Value)}
+	switch format {
+	case formatJSON:
+		return json.NewEncoder(c.Stdout).Encode(m)
+	case formatYAML:
+		out, err := yaml.Marshal(m)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(c.Stdout, "---\n%s", out)
+		return err
+	}
+	_, err := fmt.Fprintf(c.Stdout, "%-9d  %-10d  %-29s  %-20s  %s\n", m.Partition, m.Offset, m.Timestamp.Format(time.RFC3339Nano), m.Key, m.Value)
+	return err
+}
+
+// parseSince parses an RFC 3339 time, or a duration counted back from now.
+func parseSince(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid -since %q: want an RFC 3339 time or a duration such as 15m", s)
+	}
+	return t, nil
+}
+
+func (c *CLI) produce(args []string) error {
+	cmd := c.newCommand("produce", "<topic>",
+		"Writes a message with -value, or one message per line read from the standard input.").withCluster()
+	key := cmd.fs.String("key", "", "key of the messages")
+	value := cmd.fs.String("value", "", "value of the message; without it the lines of the standard input are written")
+	keySeparator := cmd.fs.String("key-separator", "", "split each input line into a key and a value at this separator")
+	confirm := cmd.fs.String("confirm-cluster", "", "name of the protected cluster being changed")
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if err := c.confirmProtected(*cmd.cluster, *confirm); err != nil {
+		return err
+	}
+
+	topics := application.NewTopicService(c.Clusters)
+	written := 0
+	write := func(k, v string) error {
+		if err := topics.WriteMessage(*cmd.cluster, positional[0], domain.Message{Key: []byte(k), Value: []byte(v)}); err != nil {
+			return fmt.Errorf("message %d not written, %d written before: %w", written+1, written, err)
+		}
+		return nil
+	}
+
+	if isFlagSet(cmd, "value") {
+		if err := write(*key, *value); err != nil {
+			return err
+		}
+		written++
+	} else {
+		scanner := bufio.NewScanner(c.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+		for scanner.Scan() {
+			k, v := *key, scanner.Text()
+			if *keySeparator != "" {
+				var ok bool
+				if k, v, ok = strings.Cut(v, *keySeparator); !ok {
+					return fmt.Errorf("line %d has no key separator %q", written+1, *keySeparator)
+				}
+			}
+			if err := write(k, v); err != nil {
+				return err
+			}
+			written++
+		}
+		if err := scanner.Err(); err != nil {
+			return err
+		}
+	}
+	_, _ = fmt.Fprintf(c.Stderr, "%d messages written to %s\n", written, positional[0])
+	return nil
+}
+
+// isFlagSet reports whether the flag was given on the command line.
+func isFlagSet(cmd *command, name string) bool {
+	set := false
+	cmd.fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
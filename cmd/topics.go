@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+func (c *CLI) topicsPlan(args []string) error {
+	cmd := c.newCommand("topics plan", "", "Shows the changes needed to match the topics of a desired state file.\n"+
+		"Exits with 2 when changes are pending, which lets CI detect drift.").withOutput()
+	file := cmd.fs.String("f", "", "desired topic state YAML file")
+	allowDelete := cmd.fs.Bool("delete", false, "delete topics that exist but are not declared")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+	state, err := readTopicState(cmd, *file)
+	if err != nil {
+		return err
+	}
+
+	plans, err := application.NewTopicPlanService(c.Clusters, application.NewTopicService(c.Clusters)).Plan(state, *allowDelete)
+	if err != nil {
+		return err
+	}
+	if err := cmd.print(plans, func(w io.Writer) {
+		for _, plan := range plans {
+			printTopicPlan(w, plan)
+		}
+	}); err != nil {
+		return err
+	}
+	if slices.ContainsFunc(plans, domain.TopicPlan.HasChanges) {
+		return errPending
+	}
+	return nil
+}
+
+func (c *CLI) topicsApply(args []string) error {
+	cmd := c.newCommand("topics apply", "", "Executes the changes needed to match the topics of a desired state file.").withOutput()
+	file := cmd.fs.String("f", "", "desired topic state YAML file")
+	allowDelete := cmd.fs.Bool("delete", false, "delete topics that exist but are not declared")
+	confirmed := clusterNames{}
+	cmd.fs.Var(&confirmed, "confirm-cluster", "name of a protected cluster being changed, repeatable")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+	state, err := readTopicState(cmd, *file)
+	if err != nil {
+		return err
+	}
+
+	service := application.NewTopicPlanService(c.Clusters, application.NewTopicService(c.Clusters))
+	plans, err := service.Plan(state, *allowDelete)
+	if err != nil {
+		return err
+	}
+	for _, plan := range plans {
+		if !plan.HasChanges() {
+			continue
+		}
+		confirm := ""
+		if slices.Contains(confirmed, plan.Cluster) {
+			confirm = plan.Cluster
+		}
+		if err := c.confirmProtected(plan.Cluster, confirm); err != nil {
+			return err
+		}
+	}
+
+	results, err := service.ApplyPlans(plans)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, result := range results {
+		for _, r := range result.Results {
+			if r.Error != "" {
+				failed++
+			}
+		}
+	}
+	if err := cmd.print(results, func(w io.Writer) {
+		row(w, "CLUSTER", "ACTION", "TOPIC", "RESULT")
+		for _, result := range results {
+			for _, r := range result.Results {
+				outcome := "done"
+				if r.Error != "" {
+					outcome = "failed: " + r.Error
+				}
+				row(w, result.Cluster, r.Change.Action, r.Change.Topic, outcome)
+			}
+		}
+	}); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d topic changes failed", failed)
+	}
+	return nil
+}
+
+// readTopicState reads the desired state file given with -f.
+func readTopicState(cmd *command, file string) (domain.DesiredTopicState, error) {
+	if file == "" {
+		cmd.fs.Usage()
+		return domain.DesiredTopicState{}, errUsage
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return domain.DesiredTopicState{}, err
+	}
+	return application.ParseDesiredTopicState(data)
+}
+
+// clusterNames collects repeated cluster name flags.
+type clusterNames []string
+
+func (n *clusterNames) String() string { return strings.Join(*n, ",") }
+
+func (n *clusterNames) Set(s string) error {
+	*n = append(*n, s)
+	return nil
+}
+
+func printTopicPlan(w io.Writer, plan domain.TopicPlan) {
+	_, _ = fmt.Fprintf(w, "Cluster %s:\n", plan.Cluster)
+	if !plan.HasChanges() {
+		_, _ = fmt.Fprintln(w, "  no changes")
+	}
+
+	counts := make(map[domain.TopicChangeAction]int)
+	for _, c := range plan.Changes {
+		counts[c.Action]++
+		switch c.Action {
+		case domain.TopicActionCreate:
+			_, _ = fmt.Fprintf(w, "  + create %s (partitions=%d, replication_factor=%d)\n", c.Topic, c.ToPartitions, c.ReplicationFactor)
+			for _, cfg := range c.Configs {
+				_, _ = fmt.Fprintf(w, "      %s = %s\n", cfg.Key, cfg.New)
+			}
+		case domain.TopicActionUpdateConfig:
+			_, _ = fmt.Fprintf(w, "  ~ update config %s\n", c.Topic)
+			for _, cfg := range c.Configs {
+				_, _ = fmt.Fprintf(w, "      %s: %q -> %q\n", cfg.Key, cfg.Old, cfg.New)
+			}
+		case domain.TopicActionIncreasePartitions:
+			_, _ = fmt.Fprintf(w, "  ~ increase partitions %s: %d -> %d\n", c.Topic, c.FromPartitions, c.ToPartitions)
+		case domain.TopicActionDelete:
+			_, _ = fmt.Fprintf(w, "  - delete %s\n", c.Topic)
+		}
+	}
+	for _, warning := range plan.Warnings {
+		_, _ = fmt.Fprintf(w, "  ! %s\n", warning)
+	}
+	_, _ = fmt.Fprintf(w, "  %d to create, %d to reconfigure, %d to repartition, %d to delete\n\n",
+		counts[domain.TopicActionCreate], counts[domain.TopicActionUpdateConfig],
+		counts[domain.TopicActionIncreasePartitions], counts[domain.TopicActionDelete])
+}
+
+func (c *CLI) topicsList(args []string) error {
+	cmd := c.newCommand("topics list", "", "Lists the topics of a cluster.").withCluster().withOutput()
+	internal := cmd.fs.Bool("internal", false, "include internal topics")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+
+	topics, err := application.NewTopicService(c.Clusters).ListTopics(*cmd.cluster, *internal)
+	if err != nil {
+		return err
+	}
+	out := make([]domain.TopicSummary, 0, len(topics))
+	for name, partitions := range topics {
+		out = append(out, domain.TopicSummary{Name: name, Partitions: partitions})
+	}
+	slices.SortFunc(out, func(a, b domain.TopicSummary) int { return strings.Compare(a.Name, b.Name) })
+
+	return cmd.print(out, func(w io.Writer) {
+		row(w, "NAME", "PARTITIONS")
+		for _, t := range out {
+			row(w, t.Name, t.Partitions)
+		}
+	})
+}
+
+func (c *CLI) topicsDescribe(args []string) error {
+	cmd := c.newCommand("topics describe", "<topic>", "Describes a topic with its partitions and configs.").withCluster().withOutput()
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+
+	detail, err := application.NewTopicService(c.Clusters).GetTopicDetail(*cmd.cluster, positional[0])
+	if err != nil {
+		return err
+	}
+	return cmd.print(detail, func(w io.Writer) {
+		row(w, "Name:", detail.Name)
+		row(w, "Partitions:", detail.Partitions)
+		row(w, "Replication factor:", detail.ReplicationFactor)
+		row(w)
+		row(w, "PARTITION", "LEADER", "REPLICAS", "ISR", "OFFLINE")
+		for _, p := range detail.PartitionDetails {
+			row(w, p.Partition, p.Leader, joinInts(p.Replicas), joinInts(p.ISR), p.Offline)
+		}
+		row(w)
+		row(w, "CONFIG", "VALUE", "SOURCE")
+		for _, e := range detail.ConfigEntries {
+			value := e.Value
+			if e.Sensitive {
+				value = "******"
+			}
+			row(w, e.Name, value, e.Source)
+		}
+	})
+}
+
+func (c *CLI) topicsCreate(args []string) error {
+	cmd := c.newCommand("topics create", "<topic>", "Creates a topic. A template fills the settings left unset.").withCluster()
+	partitions := cmd.fs.Int("partitions", 0, "number of partitions")
+	replication := cmd.fs.Int("replication-factor", 0, "replication factor")
+	template := cmd.fs.String("template", "", "topic template to start from")
+	configs := keyValues{}
+	cmd.fs.Var(configs, "config", "topic config as key=value, repeatable")
+	confirm := cmd.fs.String("confirm-cluster", "", "name of the protected cluster being changed")
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if err := c.confirmProtected(*cmd.cluster, *confirm); err != nil {
+		return err
+	}
+
+	req := domain.CreateTopicRequest{
+		Name:              positional[0],
+		NumPartitions:     int32(*partitions),
+		ReplicationFactor: int16(*replication),
+		Template:          *template,
+	}
+	if len(configs) > 0 {
+		req.Configs = configs
+	}
+	if err := application.NewTopicService(c.Clusters).CreateTopic(*cmd.cluster, req); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(c.Stdout, "topic %s created on cluster %s\n", req.Name, *cmd.cluster)
+	return nil
+}
+
+func (c *CLI) topicsDelete(args []string) error {
+	cmd := c.newCommand("topics delete", "<topic>",
+		"Deletes a topic. While it is still in use its name must be repeated with -confirm-topic.").withCluster()
+	confirmTopic := cmd.fs.String("confirm-topic", "", "name of the topic, to delete it while it is in use")
+	confirm := cmd.fs.String("confirm-cluster", "", "name of the protected cluster being changed")
+	positional, err := cmd.parse(args, 1)
+	if err != nil {
+		return err
+	}
+	if err := c.confirmProtected(*cmd.cluster, *confirm); err != nil {
+		return err
+	}
+
+	topic := positional[0]
+	err = application.NewTopicService(c.Clusters).DeleteTopic(*cmd.cluster, topic, domain.DeleteTopicRequest{Confirmation: *confirmTopic})
+	var unconfirmed *application.TopicDeletionError
+	if errors.As(err, &unconfirmed) {
+		return fmt.Errorf("%w\nrepeat the topic name with -confirm-topic %s to delete it anyway", err, topic)
+	}
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(c.Stdout, "topic %s deleted from cluster %s\n", topic, *cmd.cluster)
+	return nil
+}
+
+// keyValues collects repeated key=value flags.
+type keyValues map[string]*string
+
+func (kv keyValues) String() string {
+	pairs := make([]string, 0, len(kv))
+	for k, v := range kv {
+		pairs = append(pairs, k+"="+*v)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (kv keyValues) Set(s string) error {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("want key=value, got %q", s)
+	}
+	kv[k] = &v
+	return nil
+}
+
+func joinInts(ns []int32) string {
+	s := make([]string, len(ns))
+	for i, n := range ns {
+		s[i] = strconv.Itoa(int(n))
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/tui"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (c *CLI) tui(args []string) error {
+	cmd := c.newCommand("tui", "",
+		"Browses the clusters, topics and consumer groups in a full-screen terminal interface, with their lag\n"+
+			"refreshed every few seconds and live message tails. Arrows or j/k move, enter opens, esc goes back,\n"+
+			"/ filters and q quits.")
+	if _, err := cmd.parse(args, 0); err != nil {
+		return err
+	}
+	// Logs would be drawn over the screen.
+	utils.SetLogOutput(io.Discard)
+	defer utils.SetLogOutput(c.Stderr)
+	return tui.Run(c.Clusters)
+}
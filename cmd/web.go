@@ -4,23 +4,182 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	httpserver "github.com/OliveiraNt/maned-scout/internal/adapters/http"
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
 	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/infrastructure/auth"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
 )
 
+// quarantineSweepInterval is how often quarantined topics past their deadline are deleted.
+const quarantineSweepInterval = 10 * time.Minute
+
+// defaultShutdownTimeout bounds how long a shutdown waits for the requests in flight, below the 30 seconds
+// Kubernetes grants a pod between SIGTERM and SIGKILL.
+const defaultShutdownTimeout = 20 * time.Second
+
 // StartWeb starts the HTTP server using already-initialized application and repository layers.
-func StartWeb(clusterService *application.ClusterService) {
+// authCfg may be nil, in which case the server does not ask anyone to sign in, and serverCfg may be nil,
+// in which case the server listens on MANED_SCOUT_HTTP_PORT with the default settings.
+// It serves until SIGINT or SIGTERM, then drains the requests in flight, closes the WebSocket streams and
+// stops the background jobs within the shutdown timeout, and returns so the clients can be closed.
+func StartWeb(clusterService *application.ClusterService, authCfg *config.AuthConfig, serverCfg *config.ServerConfig) error {
+	authService, err := newAuthService(context.Background(), authCfg)
+	if err != nil {
+		return fmt.Errorf("configure authentication: %w", err)
+	}
+	if !authService.Enabled() {
+		utils.Logger.Warn("authentication is disabled: anyone reaching the server can manage the clusters")
+	}
+
+	tokenService, err := newTokenService(authCfg, authService, clusterService)
+	if err != nil {
+		return fmt.Errorf("load api tokens: %w", err)
+	}
+
+	opts, addr, timeout, err := serverOptions(serverCfg)
+	if err != nil {
+		return fmt.Errorf("configure server: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	topicService := application.NewTopicService(clusterService)
-	server := httpserver.New(clusterService, topicService)
-	port := os.Getenv("MANED_SCOUT_HTTP_PORT")
-	if port == "" {
-		port = "8080"
+	sweeper := make(chan struct{})
+	go func() {
+		defer close(sweeper)
+		topicService.RunQuarantineSweeper(ctx, quarantineSweepInterval)
+	}()
+
+	server := httpserver.New(clusterService, topicService, authService, tokenService).WithOptions(opts)
+	utils.Logger.Info("HTTP UI starting", "addr", addr)
+	err = server.Run(ctx, addr, timeout)
+
+	// The server also returns when it fails to listen; the sweeper stops either way.
+	stop()
+	<-sweeper
+	if err != nil {
+		return fmt.Errorf("HTTP UI terminated: %w", err)
+	}
+	return nil
+}
+
+// serverOptions reads the server settings. The address defaults to MANED_SCOUT_HTTP_PORT on every
+// interface, and MANED_SCOUT_SHUTDOWN_TIMEOUT overrides the configured shutdown timeout.
+func serverOptions(cfg *config.ServerConfig) (opts httpserver.Options, addr string, shutdown time.Duration, err error) {
+	if cfg == nil {
+		cfg = &config.ServerConfig{}
+	}
+	opts.BasePath = cfg.BasePath
+	opts.MaxBodyBytes = cfg.MaxBodyBytes
+	if cfg.TLS != nil {
+		opts.CertFile, opts.KeyFile = cfg.TLS.CertFile, cfg.TLS.KeyFile
+	}
+	if opts.TrustedProxies, err = mid.ParseTrustedProxies(cfg.TrustedProxies); err != nil {
+		return opts, "", 0, fmt.Errorf("trusted_proxies: %w", err)
+	}
+
+	shutdownName, shutdownTimeout := "shutdown_timeout", cfg.ShutdownTimeout
+	if v := os.Getenv("MANED_SCOUT_SHUTDOWN_TIMEOUT"); v != "" {
+		shutdownName, shutdownTimeout = "MANED_SCOUT_SHUTDOWN_TIMEOUT", v
+	}
+	shutdown = defaultShutdownTimeout
+	for _, d := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{"read_timeout", cfg.ReadTimeout, &opts.ReadTimeout},
+		{"write_timeout", cfg.WriteTimeout, &opts.WriteTimeout},
+		{"idle_timeout", cfg.IdleTimeout, &opts.IdleTimeout},
+		{shutdownName, shutdownTimeout, &shutdown},
+	} {
+		if d.value == "" {
+			continue
+		}
+		if *d.out, err = time.ParseDuration(d.value); err != nil {
+			return opts, "", 0, fmt.Errorf("%s: %w", d.name, err)
+		}
+	}
+
+	addr = cfg.Address
+	if addr == "" {
+		port := os.Getenv("MANED_SCOUT_HTTP_PORT")
+		if port == "" {
+			port = "8080"
+		}
+		addr = ":" + port
+	}
+	return opts, addr, shutdown, nil
+}
+
+// newAuthService loads the user sources named in the configuration. The users and htpasswd files are
+// reloaded when they change, so removing a user ends their sessions and personal tokens without a restart.
+func newAuthService(ctx context.Context, cfg *config.AuthConfig) (*application.AuthService, error) {
+	if !cfg.Enabled() {
+		return application.NewAuthService(nil, nil, 0), nil
+	}
+
+	var ttl time.Duration
+	if cfg.SessionTTL != "" {
+		d, err := time.ParseDuration(cfg.SessionTTL)
+		if err != nil {
+			return nil, fmt.Errorf("session_ttl: %w", err)
+		}
+		ttl = d
+	}
+
+	var passwords []domain.PasswordVerifier
+	if cfg.UsersFile != "" {
+		users, err := auth.WatchUsersFile(cfg.UsersFile)
+		if err != nil {
+			return nil, err
+		}
+		utils.Logger.Info("local users loaded", "file", cfg.UsersFile, "users", users.Len())
+		passwords = append(passwords, users)
+	}
+	if cfg.HtpasswdFile != "" {
+		users, err := auth.WatchHtpasswd(cfg.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+		utils.Logger.Info("htpasswd users loaded", "file", cfg.HtpasswdFile, "users", users.Len())
+		passwords = append(passwords, users)
+	}
+
+	var idp domain.IdentityProvider
+	if cfg.OIDC != nil {
+		provider, err := auth.NewOIDCProvider(ctx, *cfg.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		utils.Logger.Info("oidc sign-in enabled", "issuer", cfg.OIDC.Issuer)
+		idp = provider
+	}
+
+	return application.NewAuthService(passwords, idp, ttl), nil
+}
+
+// newTokenService loads the API tokens file. Tokens belong to signed-in users, so they stay
+// disabled while authentication is.
+func newTokenService(cfg *config.AuthConfig, authService *application.AuthService, clusterService *application.ClusterService) (*application.TokenService, error) {
+	if !authService.Enabled() || cfg.TokensFile == "" {
+		return application.NewTokenService(nil, clusterService), nil
 	}
-	utils.Logger.Info("HTTP UI starting", "port", port)
-	if err := server.Run(":" + port); err != nil {
-		utils.Logger.Fatal("HTTP UI terminated", "err", err)
+	tokens, err := auth.LoadTokenFile(cfg.TokensFile)
+	if err != nil {
+		return nil, err
 	}
+	utils.Logger.Info("api tokens loaded", "file", cfg.TokensFile, "tokens", tokens.Len())
+	return application.NewTokenService(tokens, clusterService).WithUsers(authService), nil
 }
@@ -0,0 +1,49 @@
+//go:build testing
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestWeb_TokensStopWorkingOnceTheOwnerIsRemovedFromTheUsersFile(t *testing.T) {
+	utils.InitLogger()
+	dir := t.TempDir()
+	hash, err := bcrypt.GenerateFromPassword([]byte("s3cret"), bcrypt.MinCost)
+	require.NoError(t, err)
+	cfg := &config.AuthConfig{
+		UsersFile:  filepath.Join(dir, "users.yml"),
+		TokensFile: filepath.Join(dir, "tokens.yml"),
+	}
+	require.NoError(t, os.WriteFile(cfg.UsersFile, []byte("users:\n  - username: alice\n    password_hash: "+string(hash)+"\n"), 0600))
+
+	authService, err := newAuthService(context.Background(), cfg)
+	require.NoError(t, err)
+	tokens, err := newTokenService(cfg, authService, application.NewClusterService(testutil.NewFakeClusterRepository()))
+	require.NoError(t, err)
+
+	alice, err := authService.Login("alice", "s3cret")
+	require.NoError(t, err)
+	created, err := tokens.CreateToken(alice.User, domain.CreateTokenRequest{Name: "ci", Scopes: []domain.Action{domain.ActionView}})
+	require.NoError(t, err)
+	_, err = tokens.AuthenticateToken(created.Secret)
+	require.NoError(t, err)
+
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.WriteFile(cfg.UsersFile, []byte("users: []\n"), 0600))
+	require.NoError(t, os.Chtimes(cfg.UsersFile, later, later))
+	_, err = tokens.AuthenticateToken(created.Secret)
+	require.ErrorIs(t, err, application.ErrInvalidToken)
+}
@@ -0,0 +1,271 @@
+package httpserver
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	sessionCookie   = "maned_scout_session"
+	oidcStateCookie = "maned_scout_oidc"
+	oidcCookiePath  = "/auth/oidc"
+	oidcStateTTL    = 10 * time.Minute
+)
+
+// requireAuth lets requests through once they carry a session cookie or, for API clients, valid
+// basic auth credentials or an API token. Pages redirect to the login page, everything else gets
+// 401 Unauthorized, as a structured error on the JSON API.
+// The user and its access rules are put in the request context.
+func (s *Server) requireAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.authService.Enabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		user, ok := s.requestUser(r)
+		if !ok {
+			s.writeUnauthorized(w, r)
+			return
+		}
+		ctx := mid.WithUser(r.Context(), user)
+		ctx = mid.WithAccess(ctx, s.clusterService.As(user).Allowed)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// clusters returns the cluster service acting on behalf of the signed-in user, so its RBAC rules apply
+// and its changes are audited with the user and request.
+func (s *Server) clusters(r *http.Request) *application.ClusterService {
+	svc := s.clusterService.From(requestInfo(r))
+	if user, ok := mid.UserFromContext(r.Context()); ok {
+		return svc.As(user)
+	}
+	return svc
+}
+
+// topics returns the topic service acting on behalf of the signed-in user.
+func (s *Server) topics(r *http.Request) *application.TopicService {
+	svc := s.topicService.From(requestInfo(r))
+	if user, ok := mid.UserFromContext(r.Context()); ok {
+		return svc.As(user)
+	}
+	return svc
+}
+
+// requestInfo returns the client address, as set by mid.RealIP, and the request ID.
+func requestInfo(r *http.Request) domain.RequestInfo {
+	ip := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return domain.RequestInfo{SourceIP: ip, RequestID: middleware.GetReqID(r.Context())}
+}
+
+func (s *Server) requestUser(r *http.Request) (domain.User, bool) {
+	// Tokens are meant for automation, so they only open the API.
+	if token, ok := bearerToken(r); ok && isAPIRequest(r) {
+		user, err := s.tokenService.AuthenticateToken(token)
+		return user, err == nil
+	}
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		if session, ok := s.authService.Session(c.Value); ok {
+			return session.User, true
+		}
+	}
+	if username, password, ok := r.BasicAuth(); ok && s.authService.PasswordEnabled() {
+		user, err := s.authService.Authenticate(username, password)
+		return user, err == nil
+	}
+	return domain.User{}, false
+}
+
+func (s *Server) writeUnauthorized(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("HX-Redirect", mid.URL(r.Context(), "/login"))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.Method == http.MethodGet && !isAPIRequest(r) && !strings.HasPrefix(r.URL.Path, "/ui/") {
+		http.Redirect(w, r, mid.URL(r.Context(), "/login?next="+url.QueryEscape(r.URL.RequestURI())), http.StatusSeeOther)
+		return
+	}
+	// Only clients that sent credentials get the challenge, so browsers never pop up a credentials dialog.
+	if _, ok := bearerToken(r); ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="Maned Scout"`)
+	} else if s.authService.PasswordEnabled() && r.Header.Get("Authorization") != "" {
+		w.Header().Set("WWW-Authenticate", `Basic realm="Maned Scout"`)
+	}
+	if isAPIRequest(r) {
+		writeErrorStatus(w, http.StatusUnauthorized, "authentication required", nil)
+		return
+	}
+	http.Error(w, "authentication required", http.StatusUnauthorized)
+}
+
+func (s *Server) uiLogin(w http.ResponseWriter, r *http.Request) {
+	next := safeRedirect(r.URL.Query().Get("next"))
+	if !s.authService.Enabled() {
+		http.Redirect(w, r, mid.URL(r.Context(), next), http.StatusSeeOther)
+		return
+	}
+	if _, ok := s.requestUser(r); ok {
+		http.Redirect(w, r, mid.URL(r.Context(), next), http.StatusSeeOther)
+		return
+	}
+	s.renderLogin(w, r, http.StatusOK, next, "")
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	next := safeRedirect(r.PostForm.Get("next"))
+	session, err := s.authService.Login(r.PostForm.Get("username"), r.PostForm.Get("password"))
+	if err != nil {
+		utils.Logger.Warn("login failed", "user", r.PostForm.Get("username"), "remote", r.RemoteAddr)
+		s.renderLogin(w, r, mapErrorToHTTPStatus(err), next, "auth.invalid-credentials")
+		return
+	}
+	utils.Logger.Info("user signed in", "user", session.User.Name, "provider", session.User.Provider)
+	setSessionCookie(w, r, session)
+	http.Redirect(w, r, mid.URL(r.Context(), next), http.StatusSeeOther)
+}
+
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		s.authService.Logout(c.Value)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     mid.URL(r.Context(), "/"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+	})
+	http.Redirect(w, r, mid.URL(r.Context(), "/login"), http.StatusSeeOther)
+}
+
+// oidcLogin starts the authorization code flow. The state and nonce travel in a short-lived cookie
+// so the callback can check that it answers a sign-in started by this browser.
+func (s *Server) oidcLogin(w http.ResponseWriter, r *http.Request) {
+	authURL, state, nonce, err := s.authService.BeginOIDC()
+	if err != nil {
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	next := safeRedirect(r.URL.Query().Get("next"))
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state + "." + nonce + "." + url.QueryEscape(next),
+		Path:     mid.URL(r.Context(), oidcCookiePath),
+		HttpOnly: true,
+		Secure:   isSecureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   int(oidcStateTTL.Seconds()),
+	})
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+func (s *Server) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	c, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		s.renderLogin(w, r, http.StatusBadRequest, "/", "auth.sso-failed")
+		return
+	}
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookie, Path: mid.URL(r.Context(), oidcCookiePath), HttpOnly: true, MaxAge: -1})
+
+	parts := strings.SplitN(c.Value, ".", 3)
+	q := r.URL.Query()
+	if len(parts) != 3 || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(q.Get("state"))) != 1 {
+		s.renderLogin(w, r, http.StatusBadRequest, "/", "auth.sso-failed")
+		return
+	}
+	next, _ := url.QueryUnescape(parts[2])
+	next = safeRedirect(next)
+	if idpErr := q.Get("error"); idpErr != "" {
+		utils.Logger.Warn("oidc sign-in refused", "error", idpErr, "description", q.Get("error_description"))
+		s.renderLogin(w, r, http.StatusUnauthorized, next, "auth.sso-failed")
+		return
+	}
+
+	session, err := s.authService.LoginOIDC(r.Context(), q.Get("code"), parts[1])
+	if err != nil {
+		utils.Logger.Warn("oidc sign-in failed", "err", err)
+		s.renderLogin(w, r, mapErrorToHTTPStatus(err), next, "auth.sso-failed")
+		return
+	}
+	utils.Logger.Info("user signed in", "user", session.User.Name, "provider", session.User.Provider)
+	setSessionCookie(w, r, session)
+	http.Redirect(w, r, mid.URL(r.Context(), next), http.StatusSeeOther)
+}
+
+func (s *Server) renderLogin(w http.ResponseWriter, r *http.Request, status int, next, errorKey string) {
+	form := pages.LoginForm{
+		Next:     next,
+		Error:    errorKey,
+		Password: s.authService.PasswordEnabled(),
+		OIDC:     s.authService.OIDCEnabled(),
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := pages.Login(form).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render login view failed", "err", err)
+	}
+}
+
+func setSessionCookie(w http.ResponseWriter, r *http.Request, session domain.Session) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    session.ID,
+		Path:     mid.URL(r.Context(), "/"),
+		Expires:  session.ExpiresAt,
+		HttpOnly: true,
+		Secure:   isSecureRequest(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// bearerToken returns the token of an Authorization: Bearer header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+// safeRedirect keeps post-login redirects on this site. They are paths rooted at the base path.
+func safeRedirect(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+	return next
+}
+
+// isSecureRequest reports whether the client reached the server over TLS, directly or through a trusted proxy.
+func isSecureRequest(r *http.Request) bool {
+	return r.TLS != nil || mid.FromTrustedProxy(r) && r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// requestHost returns the host the client asked for, which a trusted proxy passes on in X-Forwarded-Host.
+func requestHost(r *http.Request) string {
+	if mid.FromTrustedProxy(r) {
+		if host, _, _ := strings.Cut(r.Header.Get("X-Forwarded-Host"), ","); strings.TrimSpace(host) != "" {
+			return strings.TrimSpace(host)
+		}
+	}
+	return r.Host
+}
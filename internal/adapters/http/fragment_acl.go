@@ -0,0 +1,112 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentListACLs(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	q := r.URL.Query()
+	filter := domain.ACLFilter{
+		Principal:    q.Get("principal"),
+		Host:         q.Get("host"),
+		ResourceType: q.Get("resourceType"),
+		ResourceName: q.Get("resourceName"),
+		PatternType:  q.Get("patternType"),
+		Operation:    q.Get("operation"),
+		Permission:   q.Get("permission"),
+	}
+
+	service := application.NewACLService(s.clusters(r))
+	acls, err := service.ListACLs(clusterName, filter)
+	if err != nil {
+		utils.Logger.Error("ui list acls failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.ACLListFragment(acls, s.clusters(r).Allowed(domain.ActionClusterAdmin, clusterName, domain.ClusterResource)).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render acl list fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render acl list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentListTopicACLs(w http.ResponseWriter, r *http.Request) {
+	s.renderResourceACLs(w, r, "TOPIC", chi.URLParam(r, "topicName"))
+}
+
+func (s *Server) fragmentListConsumerGroupACLs(w http.ResponseWriter, r *http.Request) {
+	s.renderResourceACLs(w, r, "GROUP", chi.URLParam(r, "consumerGroupName"))
+}
+
+func (s *Server) renderResourceACLs(w http.ResponseWriter, r *http.Request, resourceType, resourceName string) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	service := application.NewACLService(s.clusters(r))
+	acls, err := service.ListResourceACLs(clusterName, resourceType, resourceName)
+	if err != nil {
+		utils.Logger.Error("ui list resource acls failed", "cluster", clusterName, "resource", resourceName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.ACLListFragment(acls, false).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render acl list fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render acl list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentCreateACL(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	var acl domain.ACL
+	if err := json.NewDecoder(r.Body).Decode(&acl); err != nil {
+		utils.Logger.Warn("ui create acl bad request", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	service := application.NewACLService(s.clusters(r))
+	if err := service.CreateACL(clusterName, acl); err != nil {
+		utils.Logger.Error("ui create acl failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(201)
+}
+
+func (s *Server) fragmentDeleteACLs(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	var filter domain.ACLFilter
+	if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+		utils.Logger.Warn("ui delete acls bad request", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	service := application.NewACLService(s.clusters(r))
+	deleted, err := service.DeleteACLs(clusterName, filter)
+	if err != nil {
+		utils.Logger.Error("ui delete acls failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(deleted); err != nil {
+		utils.Logger.Error("encode response failed", "err", err)
+	}
+}
@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+const (
+	defaultAuditLimit = 200
+	maxAuditLimit     = 5000
+)
+
+func (s *Server) fragmentListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := s.clusters(r).QueryAudit(filter)
+	if err != nil {
+		utils.Logger.Error("ui list audit events failed", "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.AuditEventsFragment(events).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render audit events fragment failed", "err", err)
+		http.Error(w, "failed to render audit events view", 500)
+		return
+	}
+}
+
+// parseAuditFilter reads the audit filter from the query string. Since and until are RFC 3339 times
+// or dates, and an until date includes the whole day.
+func parseAuditFilter(r *http.Request) (domain.AuditFilter, error) {
+	q := r.URL.Query()
+	filter := domain.AuditFilter{
+		User:     q.Get("user"),
+		Cluster:  q.Get("cluster"),
+		Resource: q.Get("resource"),
+		Action:   q.Get("action"),
+		Result:   q.Get("result"),
+		Limit:    defaultAuditLimit,
+	}
+	if v := q.Get("since"); v != "" {
+		since, err := parseAuditTime(v, false)
+		if err != nil {
+			return filter, err
+		}
+		filter.Since = since
+	}
+	if v := q.Get("until"); v != "" {
+		until, err := parseAuditTime(v, true)
+		if err != nil {
+			return filter, err
+		}
+		filter.Until = until
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit <= 0 {
+			return filter, fmt.Errorf("invalid limit %q", v)
+		}
+		filter.Limit = min(limit, maxAuditLimit)
+	}
+	return filter, nil
+}
+
+func parseAuditTime(v string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	day, err := time.ParseInLocation(time.DateOnly, v, time.Local)
+	if err != nil {
+		return day, fmt.Errorf("invalid time %q: use a date or an RFC 3339 time", v)
+	}
+	if endOfDay {
+		return day.AddDate(0, 0, 1), nil
+	}
+	return day, nil
+}
@@ -0,0 +1,39 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (s *Server) fragmentCompare(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	source, target := q.Get("source"), q.Get("target")
+	sourceTopic, targetTopic := q.Get("source_topic"), q.Get("target_topic")
+
+	service := application.NewCompareService(s.topics(r))
+	var (
+		cmp domain.TopicComparison
+		err error
+	)
+	if sourceTopic != "" {
+		cmp, err = service.CompareTopics(source, sourceTopic, target, targetTopic)
+	} else {
+		cmp, err = service.CompareClusters(source, target)
+	}
+	if err != nil {
+		utils.Logger.Error("ui compare failed", "source", source, "target", target, "topic", sourceTopic, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.CompareResultFragment(cmp).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render compare result fragment failed", "source", source, "target", target, "err", err)
+		http.Error(w, "failed to render compare view", 500)
+		return
+	}
+}
@@ -9,15 +9,15 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
-func (s *Server) apiListConsumerGroup(w http.ResponseWriter, r *http.Request) {
+func (s *Server) fragmentListConsumerGroup(w http.ResponseWriter, r *http.Request) {
 	clusterName := chi.URLParam(r, "clusterName")
 
-	service := application.NewConsumerGroupsService(s.clusterService)
+	service := application.NewConsumerGroupsService(s.clusters(r))
 
 	cgs, err := service.ListConsumerGroupsWithLagFromTopic(r.Context(), clusterName, "")
 
 	if err != nil {
-		utils.Logger.Error("api get consumer groups failed", "cluster", clusterName, "err", err)
+		utils.Logger.Error("ui get consumer groups failed", "cluster", clusterName, "err", err)
 		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
 		return
 	}
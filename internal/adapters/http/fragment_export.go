@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentExportCluster(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	q := r.URL.Query()
+	opts := application.ExportOptions{
+		ConsumerGroups: q.Get("groups") == "true",
+		ACLs:           q.Get("acls") == "true",
+	}
+
+	service := application.NewExportService(s.clusters(r))
+	state, err := service.Export(clusterName, opts)
+	if err != nil {
+		utils.Logger.Error("ui export cluster failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	data, err := application.MarshalDesiredTopicState(state)
+	if err != nil {
+		utils.Logger.Error("encode cluster export failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to encode cluster export", 500)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", clusterName+".yaml"))
+	if _, err := w.Write(data); err != nil {
+		utils.Logger.Error("write cluster export failed", "cluster", clusterName, "err", err)
+	}
+}
@@ -0,0 +1,27 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentGetClusterInternals(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	internals, err := s.clusters(r).GetClusterInternals(clusterName)
+	if err != nil {
+		utils.Logger.Error("ui get cluster internals failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.ClusterInternalsFragment(internals).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render cluster internals fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render cluster internals view", 500)
+		return
+	}
+}
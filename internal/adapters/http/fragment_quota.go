@@ -0,0 +1,51 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentListQuotas(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	service := application.NewQuotaService(s.clusters(r))
+	quotas, err := service.ListQuotas(clusterName)
+	if err != nil {
+		utils.Logger.Error("ui list quotas failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.QuotasListFragment(quotas, s.clusters(r).Allowed(domain.ActionClusterAdmin, clusterName, domain.ClusterResource)).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render quotas list fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render quotas list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentAlterQuotas(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	var req domain.AlterClientQuotaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui alter quotas bad request", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	service := application.NewQuotaService(s.clusters(r))
+	if err := service.AlterQuotas(clusterName, req); err != nil {
+		utils.Logger.Error("ui alter quotas failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(200)
+}
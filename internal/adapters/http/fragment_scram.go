@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentListSCRAMUsers(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	service := application.NewSCRAMService(s.clusters(r))
+	users, err := service.ListUsers(clusterName)
+	if err != nil {
+		utils.Logger.Error("ui list scram users failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.SCRAMUsersListFragment(users, s.clusters(r).Allowed(domain.ActionClusterAdmin, clusterName, domain.ClusterResource)).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render scram users list fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render users list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentUpsertSCRAMUser(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	var req domain.UpsertSCRAMUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui upsert scram user bad request", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	service := application.NewSCRAMService(s.clusters(r))
+	if err := service.UpsertUser(clusterName, req); err != nil {
+		utils.Logger.Error("ui upsert scram user failed", "cluster", clusterName, "user", req.Name, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(200)
+}
+
+func (s *Server) fragmentDeleteSCRAMUser(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	userName := chi.URLParam(r, "userName")
+	mechanism := r.URL.Query().Get("mechanism")
+
+	service := application.NewSCRAMService(s.clusters(r))
+	if err := service.DeleteUser(clusterName, userName, mechanism); err != nil {
+		utils.Logger.Error("ui delete scram user failed", "cluster", clusterName, "user", userName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(204)
+}
@@ -0,0 +1,89 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// tokenUser returns the signed-in user managing tokens. Without sign-in there is nobody to own
+// a token, so tokens count as disabled.
+func (s *Server) tokenUser(r *http.Request) (domain.User, error) {
+	user, ok := mid.UserFromContext(r.Context())
+	if !ok || !s.tokenService.Enabled() {
+		return domain.User{}, application.ErrTokensDisabled
+	}
+	return user, nil
+}
+
+func (s *Server) fragmentListTokens(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+	tokens, err := s.tokenService.ListTokens(user)
+	if err != nil {
+		utils.Logger.Error("ui list tokens failed", "user", user.Name, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.TokensListFragment(tokens).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render tokens list fragment failed", "err", err)
+		http.Error(w, "failed to render tokens list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentCreateToken(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	var req domain.CreateTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui create token bad request", "user", user.Name, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	created, err := s.tokenService.CreateToken(user, req)
+	if err != nil {
+		utils.Logger.Error("ui create token failed", "user", user.Name, "name", req.Name, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(created); err != nil {
+		utils.Logger.Error("encode created token failed", "err", err)
+	}
+}
+
+func (s *Server) fragmentRevokeToken(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+	tokenID := chi.URLParam(r, "tokenID")
+
+	if err := s.tokenService.RevokeToken(user, tokenID); err != nil {
+		utils.Logger.Error("ui revoke token failed", "user", user.Name, "id", tokenID, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(204)
+}
@@ -0,0 +1,452 @@
+package httpserver
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// mapErrorToHTTPStatus maps application errors to HTTP status codes
+func mapErrorToHTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, application.ErrClusterNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrInvalidCredentials),
+		errors.Is(err, application.ErrInvalidToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, application.ErrReadOnly),
+		errors.Is(err, application.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, application.ErrOIDCDisabled),
+		errors.Is(err, application.ErrTokensDisabled),
+		errors.Is(err, application.ErrTokenNotFound),
+		errors.Is(err, application.ErrAuditDisabled):
+		return http.StatusNotFound
+	case errors.Is(err, application.ErrTopicPolicyViolation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, application.ErrInvalidTopicName),
+		errors.Is(err, application.ErrInvalidPartitionCount),
+		errors.Is(err, application.ErrInvalidReplicationFactor),
+		errors.Is(err, application.ErrInvalidTopicConfig),
+		errors.Is(err, application.ErrInvalidDeleteRecords),
+		errors.Is(err, application.ErrInvalidACL),
+		errors.Is(err, application.ErrInvalidACLFilter),
+		errors.Is(err, application.ErrInvalidSCRAMUser),
+		errors.Is(err, application.ErrInvalidSCRAMIterations),
+		errors.Is(err, application.ErrInvalidQuotaEntity),
+		errors.Is(err, application.ErrInvalidQuota),
+		errors.Is(err, application.ErrInvalidAbortTransaction),
+		errors.Is(err, application.ErrInvalidOffsetReset),
+		errors.Is(err, application.ErrInvalidTopicState),
+		errors.Is(err, application.ErrInvalidComparison),
+		errors.Is(err, application.ErrTopicTemplateNotFound),
+		errors.Is(err, application.ErrInvalidBatchTopics),
+		errors.Is(err, application.ErrInvalidQuarantine),
+		errors.Is(err, application.ErrInvalidTokenRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, application.ErrTopicDeletionUnconfirmed),
+		errors.Is(err, application.ErrClusterExists):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) fragmentListTopics(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	showInternal := r.URL.Query().Get("showInternal") == "true"
+	topics, err := s.topics(r).ListTopics(name, showInternal)
+	if err != nil {
+		utils.Logger.Error("ui list topics failed", "cluster", name, "err", err)
+		w.Header().Set("X-Notification-Type", "error")
+		msg := "Falha ao listar tópicos"
+		w.Header().Set("X-Notification", msg)
+		w.Header().Set("X-Notification-Base64", base64.StdEncoding.EncodeToString([]byte(msg)))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.TopicsListFragment(name, topics, false).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render topics list fragment failed", "cluster", name, "err", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	{
+		totalTopics := len(topics)
+		totalPartitions := 0
+		for _, p := range topics {
+			totalPartitions += p
+		}
+		avg := 0.0
+		if totalTopics > 0 {
+			avg = float64(totalPartitions) / float64(totalTopics)
+		}
+		_, _ = w.Write([]byte("<p class=\"text-3xl font-bold text-gray-900 dark:text-white mt-2\" id=\"topics-total\" hx-swap-oob=\"true\">" + strconv.Itoa(totalTopics) + "</p>"))
+		_, _ = w.Write([]byte("<p class=\"text-3xl font-bold text-gray-900 dark:text-white mt-2\" id=\"partitions-total\" hx-swap-oob=\"true\">" + strconv.Itoa(totalPartitions) + "</p>"))
+		_, _ = w.Write([]byte("<p class=\"text-3xl font-bold text-gray-900 dark:text-white mt-2\" id=\"partitions-avg\" hx-swap-oob=\"true\">" + strconv.FormatFloat(avg, 'f', 2, 64) + "</p>"))
+	}
+}
+
+func (s *Server) fragmentCreateTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var req domain.CreateTopicRequest
+	ct := r.Header.Get("Content-Type")
+	if strings.HasPrefix(ct, "application/json") {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.Logger.Warn("ui create topic bad request", "cluster", clusterName, "err", err)
+			w.Header().Set("X-Notification-Type", "error")
+			{
+				msg := "Requisição inválida: " + err.Error()
+				w.Header().Set("X-Notification", msg)
+				w.Header().Set("X-Notification-Base64", base64.StdEncoding.EncodeToString([]byte(msg)))
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	} else {
+		_ = r.ParseForm()
+		req.Name = strings.TrimSpace(r.FormValue("name"))
+		if np, err := strconv.Atoi(r.FormValue("numPartitions")); err == nil {
+			req.NumPartitions = int32(np)
+		}
+		if rf, err := strconv.Atoi(r.FormValue("replicationFactor")); err == nil {
+			req.ReplicationFactor = int16(rf)
+		}
+		cfgs := map[string]*string{}
+		if v := strings.TrimSpace(r.FormValue("retention.ms")); v != "" {
+			cfgs["retention.ms"] = &v
+		}
+		if v := strings.TrimSpace(r.FormValue("cleanup.policy")); v != "" {
+			cfgs["cleanup.policy"] = &v
+		}
+		if v := strings.TrimSpace(r.FormValue("compression.type")); v != "" {
+			cfgs["compression.type"] = &v
+		}
+		keys, values := r.Form["config_key"], r.Form["config_value"]
+		for i, k := range keys {
+			k = strings.TrimSpace(k)
+			if k == "" || i >= len(values) {
+				continue
+			}
+			v := strings.TrimSpace(values[i])
+			cfgs[k] = &v
+		}
+		req.Template = strings.TrimSpace(r.FormValue("template"))
+		if len(cfgs) > 0 {
+			req.Configs = cfgs
+		}
+	}
+
+	if err := s.topics(r).CreateTopic(clusterName, req); err != nil {
+		w.Header().Set("X-Notification-Type", "error")
+		{
+			msg := "Falha ao criar tópico: " + err.Error()
+			w.Header().Set("X-Notification", msg)
+			w.Header().Set("X-Notification-Base64", base64.StdEncoding.EncodeToString([]byte(msg)))
+		}
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("X-Notification-Type", "success")
+	{
+		msg := "Tópico criado com sucesso"
+		w.Header().Set("X-Notification", msg)
+		w.Header().Set("X-Notification-Base64", base64.StdEncoding.EncodeToString([]byte(msg)))
+	}
+
+	w.Header().Set("HX-Trigger", "topic-created")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) fragmentDeleteTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	req := domain.DeleteTopicRequest{Confirmation: r.URL.Query().Get("confirmation")}
+	if err := s.topics(r).DeleteTopic(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("ui delete topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(204)
+}
+
+func (s *Server) fragmentTopicDeletionCheck(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	check, err := s.topics(r).CheckTopicDeletion(clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("ui topic deletion check failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	manage := s.clusters(r).Allowed(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName))
+	if err := pages.TopicDeletionCheckFragment(check, manage).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render topic deletion check failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, "failed to render topic deletion check view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentQuarantineTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.QuarantineTopicRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui quarantine topic bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	if err := s.topics(r).QuarantineTopic(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("ui quarantine topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(204)
+}
+
+func (s *Server) fragmentReleaseTopicQuarantine(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	if err := s.topics(r).ReleaseTopicQuarantine(clusterName, topicName); err != nil {
+		utils.Logger.Error("ui release topic quarantine failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(204)
+}
+
+func (s *Server) fragmentUpdateTopicConfig(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.UpdateTopicConfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui update topic config bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	if err := s.topics(r).UpdateTopicConfig(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("ui update topic config failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(200)
+	if err := json.NewEncoder(w).Encode(map[string]string{"message": "topic config updated successfully"}); err != nil {
+		utils.Logger.Error("encode response failed", "err", err)
+	}
+}
+
+func (s *Server) fragmentIncreasePartitions(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.IncreasePartitionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui increase partitions bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	if err := s.topics(r).IncreasePartitions(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("ui increase partitions failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(200)
+	if err := json.NewEncoder(w).Encode(map[string]string{"message": "partitions increased successfully"}); err != nil {
+		utils.Logger.Error("encode response failed", "err", err)
+	}
+}
+
+func (s *Server) fragmentBatchTopics(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	var req domain.BatchTopicRequest
+	isJSON := strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+	if isJSON {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.Logger.Warn("ui batch topics bad request", "cluster", clusterName, "err", err)
+			http.Error(w, err.Error(), 400)
+			return
+		}
+	} else {
+		_ = r.ParseForm()
+		req.Action = domain.TopicChangeAction(r.FormValue("action"))
+		req.Topics = r.Form["topics"]
+		req.Confirmations = strings.FieldsFunc(r.FormValue("confirmations"), func(c rune) bool { return c == ',' || unicode.IsSpace(c) })
+		if np, err := strconv.Atoi(r.FormValue("totalPartitions")); err == nil {
+			req.TotalPartitions = int32(np)
+		}
+		keys, values := r.Form["config_key"], r.Form["config_value"]
+		for i, k := range keys {
+			k = strings.TrimSpace(k)
+			if k == "" || i >= len(values) {
+				continue
+			}
+			if req.Configs == nil {
+				req.Configs = map[string]*string{}
+			}
+			v := strings.TrimSpace(values[i])
+			req.Configs[k] = &v
+		}
+	}
+
+	results, err := s.topics(r).BatchTopics(clusterName, req)
+	if err != nil {
+		utils.Logger.Error("ui batch topics failed", "cluster", clusterName, "action", req.Action, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	if isJSON {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(results); err != nil {
+			utils.Logger.Error("encode response failed", "err", err)
+		}
+		return
+	}
+
+	w.Header().Set("HX-Trigger", "topics-changed")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.BatchTopicResultsFragment(results).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render batch topic results failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render batch topic results view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentPreviewDeleteRecords(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.DeleteRecordsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui preview delete records bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	results, err := s.topics(r).PreviewDeleteRecords(clusterName, topicName, req)
+	if err != nil {
+		utils.Logger.Error("ui preview delete records failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		utils.Logger.Error("encode response failed", "err", err)
+	}
+}
+
+func (s *Server) fragmentDeleteRecords(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.DeleteRecordsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui delete records bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	results, err := s.topics(r).DeleteRecords(clusterName, topicName, req)
+	if err != nil {
+		utils.Logger.Error("ui delete records failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(results); err != nil {
+		utils.Logger.Error("encode response failed", "err", err)
+	}
+}
+
+func (s *Server) fragmentReadMessages(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	if err := pages.MessageView(clusterName, topicName).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render message view failed", "err", err)
+		http.Error(w, "failed to render message view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentStopMessages(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	if err := pages.StopView(clusterName, topicName).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render stop view failed", "err", err)
+		http.Error(w, "failed to render stop view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentWriteMessage(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.MessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui write message bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	m := domain.Message{
+		Key:   []byte(req.Key),
+		Value: []byte(req.Value),
+	}
+	if err := s.topics(r).WriteMessage(clusterName, topicName, m); err != nil {
+		utils.Logger.Error("ui write message failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+}
+
+func (s *Server) fragmentListTopicConsumerGroups(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	service := application.NewConsumerGroupsService(s.clusters(r))
+
+	cgs, err := service.ListConsumerGroupsWithLagFromTopic(r.Context(), clusterName, topicName)
+
+	if err != nil {
+		utils.Logger.Error("ui get consumer groups failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	if err := pages.ConsumerGroupsListFragment(clusterName, cgs, service).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render consumer groups list view failed", "err", err)
+		http.Error(w, "failed to render consumer groups list view", 500)
+		return
+	}
+}
@@ -0,0 +1,72 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) fragmentListTransactions(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+
+	service := application.NewTransactionService(s.clusters(r))
+	txns, err := service.ListTransactions(clusterName)
+	if err != nil {
+		utils.Logger.Error("ui list transactions failed", "cluster", clusterName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.TransactionsListFragment(clusterName, txns).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render transactions list fragment failed", "cluster", clusterName, "err", err)
+		http.Error(w, "failed to render transactions list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentListTopicProducers(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	service := application.NewTransactionService(s.clusters(r))
+	producers, err := service.ListProducers(clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("ui list topic producers failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.ProducersListFragment(producers, s.clusters(r).Allowed(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName))).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render producers list fragment failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, "failed to render producers list view", 500)
+		return
+	}
+}
+
+func (s *Server) fragmentAbortTransaction(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	var req domain.AbortTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.Logger.Warn("ui abort transaction bad request", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	service := application.NewTransactionService(s.clusters(r))
+	if err := service.AbortTransaction(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("ui abort transaction failed", "cluster", clusterName, "topic", topicName, "err", err)
+		http.Error(w, err.Error(), mapErrorToHTTPStatus(err))
+		return
+	}
+
+	w.WriteHeader(200)
+}
@@ -0,0 +1,52 @@
+package mid
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type basePathKey struct{}
+
+// BasePath serves next under a URL prefix, such as /tools/kafka behind a reverse proxy forwarding a subpath
+// as is. The prefix is stripped from the request path, so routes stay rooted at /, and kept in the request
+// context for the links and redirects built with URL. Requests outside of the prefix are not found, and the
+// prefix itself redirects to the home page under it. An empty prefix or / serves next at the root.
+func BasePath(prefix string) func(http.Handler) http.Handler {
+	prefix = strings.TrimRight(prefix, "/")
+	return func(next http.Handler) http.Handler {
+		if prefix == "" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == prefix {
+				target := prefix + "/"
+				if r.URL.RawQuery != "" {
+					target += "?" + r.URL.RawQuery
+				}
+				http.Redirect(w, r, target, http.StatusMovedPermanently)
+				return
+			}
+			rest, ok := strings.CutPrefix(r.URL.Path, prefix+"/")
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			r2 := r.WithContext(context.WithValue(r.Context(), basePathKey{}, prefix))
+			u := *r.URL
+			u.Path = "/" + rest
+			u.RawPath = ""
+			if raw, ok := strings.CutPrefix(r.URL.RawPath, prefix+"/"); ok {
+				u.RawPath = "/" + raw
+			}
+			r2.URL = &u
+			next.ServeHTTP(w, r2)
+		})
+	}
+}
+
+// URL returns path, rooted at /, under the base path the request is served at.
+func URL(ctx context.Context, path string) string {
+	prefix, _ := ctx.Value(basePathKey{}).(string)
+	return prefix + path
+}
@@ -39,7 +39,7 @@ func I18n(next http.Handler) http.Handler {
 			http.SetCookie(w, &http.Cookie{
 				Name:     langCookie,
 				Value:    ctxi18n.Locale(ctx).Code().String(),
-				Path:     "/",
+				Path:     URL(r.Context(), "/"),
 				HttpOnly: false,
 				SameSite: http.SameSiteLaxMode,
 				MaxAge:   int((365 * 24 * time.Hour).Seconds()),
@@ -0,0 +1,106 @@
+package mid
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// TrustedProxies holds the addresses of the reverse proxies allowed to tell the client address.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses addresses and CIDR ranges, such as 10.0.0.0/8 or ::1.
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	out := make(TrustedProxies, 0, len(values))
+	for _, v := range values {
+		p, err := netip.ParsePrefix(v)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(v)
+			if addrErr != nil {
+				return nil, err
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		out = append(out, p.Masked())
+	}
+	return out, nil
+}
+
+func (t TrustedProxies) contains(ip string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, p := range t {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RealIP sets the request remote address to the client address forwarded by the trusted proxies, from the
+// X-Forwarded-For header, or X-Real-IP when it is missing. The headers are ignored unless the request comes
+// from a trusted proxy, since any client can send them. X-Forwarded-For is read from the right, skipping the
+// trusted proxies, so the address a client made up at the left of the list is never believed.
+// Whether the request came from a trusted proxy is kept for FromTrustedProxy.
+func RealIP(trusted TrustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if trusted.peer(r) {
+				r = r.WithContext(context.WithValue(r.Context(), trustedProxyKey{}, true))
+				if ip := trusted.clientIP(r); ip != "" {
+					r.RemoteAddr = ip
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type trustedProxyKey struct{}
+
+// FromTrustedProxy reports whether the request was passed on by a trusted proxy, whose X-Forwarded-* headers
+// can be believed.
+func FromTrustedProxy(r *http.Request) bool {
+	trusted, _ := r.Context().Value(trustedProxyKey{}).(bool)
+	return trusted
+}
+
+// peer reports whether the request comes straight from a trusted proxy.
+func (t TrustedProxies) peer(r *http.Request) bool {
+	if len(t) == 0 {
+		return false
+	}
+	remote, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remote = r.RemoteAddr
+	}
+	return t.contains(remote)
+}
+
+// clientIP returns the forwarded client address of a request from a trusted proxy, or "" when the request
+// address stands.
+func (t TrustedProxies) clientIP(r *http.Request) string {
+	if xff := r.Header.Values("X-Forwarded-For"); len(xff) > 0 {
+		hops := strings.Split(strings.Join(xff, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				return ""
+			}
+			if i == 0 || !t.contains(hop) {
+				return hop
+			}
+		}
+	}
+	if ip := strings.TrimSpace(r.Header.Get("X-Real-IP")); ip != "" {
+		if _, err := netip.ParseAddr(ip); err == nil {
+			return ip
+		}
+	}
+	return ""
+}
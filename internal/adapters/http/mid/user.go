@@ -0,0 +1,37 @@
+package mid
+
+import (
+	"context"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+type userKey struct{}
+
+// WithUser returns a copy of ctx carrying the signed-in user.
+func WithUser(ctx context.Context, user domain.User) context.Context {
+	return context.WithValue(ctx, userKey{}, user)
+}
+
+// UserFromContext returns the signed-in user, if authentication is enabled and the request carries one.
+func UserFromContext(ctx context.Context) (domain.User, bool) {
+	user, ok := ctx.Value(userKey{}).(domain.User)
+	return user, ok
+}
+
+type accessKey struct{}
+
+// AccessFunc reports whether the signed-in user may perform an action on a resource of a cluster.
+type AccessFunc func(action domain.Action, cluster string, res domain.Resource) bool
+
+// WithAccess returns a copy of ctx carrying the access rules of the signed-in user.
+func WithAccess(ctx context.Context, access AccessFunc) context.Context {
+	return context.WithValue(ctx, accessKey{}, access)
+}
+
+// Can reports whether the signed-in user may perform the action, so pages can hide what is forbidden.
+// Everything is allowed when the request carries no access rules.
+func Can(ctx context.Context, action domain.Action, cluster string, res domain.Resource) bool {
+	access, ok := ctx.Value(accessKey{}).(AccessFunc)
+	return !ok || access(action, cluster, res)
+}
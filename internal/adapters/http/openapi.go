@@ -0,0 +1,173 @@
+package httpserver
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/openapi"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// The OpenAPI document and its docs page are served without sign-in.
+const (
+	apiSpecPath = "/api/openapi.json"
+	apiDocsPath = "/api/docs"
+)
+
+const apiDescription = "Manage the Kafka clusters configured in Maned Scout. " +
+	"When sign-in is enabled, send an API token as a bearer token or a local user with basic auth. " +
+	"Errors answer a JSON body with a stable code. Changes to protected clusters answer 428 Precondition Required " +
+	"until the " + confirmClusterHeader + " header repeats the cluster name."
+
+// apiDocument is the document served, built once.
+var apiDocument = sync.OnceValue(OpenAPI)
+
+// OpenAPI returns the OpenAPI document of the JSON API, describing every route of apiV1Routes
+// with the schemas of the types their handlers decode and encode.
+func OpenAPI() *openapi.Document {
+	reg := openapi.NewRegistry()
+	reg.Name(reflect.TypeFor[apiErrorBody](), "ErrorResponse")
+	reg.Name(reflect.TypeFor[apiError](), "APIError")
+	actions := make([]string, 0, len(domain.Actions))
+	for _, a := range domain.Actions {
+		actions = append(actions, string(a))
+	}
+	reg.Enum(reflect.TypeFor[domain.Action](), actions...)
+	reg.Enum(reflect.TypeFor[domain.TopicChangeAction](),
+		string(domain.TopicActionCreate), string(domain.TopicActionUpdateConfig),
+		string(domain.TopicActionIncreasePartitions), string(domain.TopicActionDelete))
+
+	doc := &openapi.Document{
+		OpenAPI:  openapi.Version,
+		Info:     openapi.Info{Title: "Maned Scout API", Description: apiDescription, Version: "1"},
+		Servers:  []openapi.Server{{URL: apiV1Prefix}},
+		Security: []map[string][]string{{"bearerAuth": {}}, {"basicAuth": {}}, {}},
+		Paths:    map[string]openapi.PathItem{},
+		Components: openapi.Components{
+			Responses: map[string]*openapi.Response{
+				"Error": {
+					Description: "The request failed; the code tells why, such as not_found or confirmation_required",
+					Content:     jsonContent(reg.Schema(reflect.TypeFor[apiErrorBody]())),
+				},
+			},
+			SecuritySchemes: map[string]openapi.SecurityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", Description: "API token"},
+				"basicAuth":  {Type: "http", Scheme: "basic", Description: "Local or htpasswd user"},
+			},
+		},
+	}
+	for _, tag := range apiTags {
+		doc.Tags = append(doc.Tags, openapi.Tag{Name: tag.name, Description: tag.description})
+	}
+	for _, route := range apiV1Routes {
+		item, ok := doc.Paths[route.pattern]
+		if !ok {
+			item = openapi.PathItem{}
+			doc.Paths[route.pattern] = item
+		}
+		item[strings.ToLower(route.method)] = route.operation(reg)
+	}
+	doc.Components.Schemas = reg.Schemas()
+	return doc
+}
+
+func (route apiRoute) operation(reg *openapi.Registry) *openapi.Operation {
+	op := &openapi.Operation{
+		OperationID: route.id,
+		Summary:     route.summary,
+		Description: route.description,
+		Tags:        []string{route.tag},
+		Responses:   map[string]*openapi.Response{"default": {Ref: "#/components/responses/Error"}},
+	}
+	for _, name := range openapi.PathParameters(route.pattern) {
+		op.Parameters = append(op.Parameters, openapi.Parameter{
+			Name: name, In: "path", Description: apiPathParams[name], Required: true, Schema: &openapi.Schema{Type: "string"},
+		})
+	}
+	for _, p := range route.query {
+		op.Parameters = append(op.Parameters, openapi.Parameter{
+			Name: p.name, In: "query", Description: p.description, Required: p.required, Schema: &openapi.Schema{Type: p.typ},
+		})
+	}
+	if route.confirmsCluster() {
+		op.Parameters = append(op.Parameters, openapi.Parameter{
+			Name: confirmClusterHeader, In: "header", Description: "Names of the protected clusters the change is confirmed for, comma separated",
+			Schema: &openapi.Schema{Type: "string"},
+		})
+	}
+
+	if route.body != nil {
+		schema := reg.Schema(reflect.TypeOf(route.body))
+		content := jsonContent(schema)
+		if route.yamlBody {
+			content["application/yaml"] = openapi.MediaType{Schema: schema}
+		}
+		op.RequestBody = &openapi.RequestBody{Required: true, Content: content}
+	}
+
+	status := route.successStatus()
+	resp := &openapi.Response{Description: http.StatusText(status)}
+	if route.result != nil {
+		resp.Content = jsonContent(reg.Schema(reflect.TypeOf(route.result)))
+	}
+	if route.location {
+		resp.Headers = map[string]openapi.Header{
+			"Location": {Description: "Path of the created resource", Schema: &openapi.Schema{Type: "string"}},
+		}
+	}
+	op.Responses[strconv.Itoa(status)] = resp
+	return op
+}
+
+func (route apiRoute) successStatus() int {
+	switch {
+	case route.status != 0:
+		return route.status
+	case route.result != nil:
+		return http.StatusOK
+	}
+	return http.StatusNoContent
+}
+
+// confirmsCluster reports whether the route asks for the confirmation of protected clusters.
+func (route apiRoute) confirmsCluster() bool {
+	if route.confirm {
+		return true
+	}
+	return !route.unconfirmed && !isSafeMethod(route.method) && strings.Contains(route.pattern, "{clusterName}")
+}
+
+func jsonContent(schema *openapi.Schema) map[string]openapi.MediaType {
+	return map[string]openapi.MediaType{"application/json": {Schema: schema}}
+}
+
+func (s *Server) apiOpenAPI(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, servedDocument(r))
+}
+
+// servedDocument returns the document with its server URL under the base path the request is served at.
+func servedDocument(r *http.Request) *openapi.Document {
+	doc := apiDocument()
+	if url := mid.URL(r.Context(), apiV1Prefix); url != apiV1Prefix {
+		served := *doc
+		served.Servers = []openapi.Server{{URL: url}}
+		return &served
+	}
+	return doc
+}
+
+func (s *Server) uiAPIDocs(w http.ResponseWriter, r *http.Request) {
+	utils.Logger.Debug("render api docs")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.APIDocs(servedDocument(r)).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render api docs view failed", "err", err)
+		http.Error(w, "failed to render api docs view", 500)
+		return
+	}
+}
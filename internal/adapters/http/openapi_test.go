@@ -0,0 +1,152 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/openapi"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// samplePathParams fill in the path parameters of the conformance requests, naming what the test server has.
+var samplePathParams = map[string]string{
+	"clusterName":       "dev",
+	"topicName":         "orders",
+	"consumerGroupName": "group-a",
+	"userName":          "alice",
+	"tokenID":           "missing",
+}
+
+// sampleQuery fills in the query parameters of the conformance requests.
+var sampleQuery = map[string]string{
+	"source":  "dev",
+	"target":  "dev",
+	"timeout": "10ms",
+}
+
+func TestOpenAPI_DescribesEveryRoute(t *testing.T) {
+	t.Parallel()
+	h := newTestServer(t, nil)
+	doc := OpenAPI()
+	base := doc.Servers[0].URL
+
+	var served []string
+	err := chi.Walk(h.(chi.Routes), func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		if strings.HasPrefix(route, base+"/") {
+			served = append(served, method+" "+strings.TrimSuffix(strings.TrimPrefix(route, base), "/"))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var documented []string
+	for _, e := range doc.Endpoints() {
+		documented = append(documented, e.Method+" "+e.Path)
+	}
+	slices.Sort(served)
+	slices.Sort(documented)
+	require.Equal(t, documented, served)
+}
+
+func TestOpenAPI_ResponsesConformToSpec(t *testing.T) {
+	t.Parallel()
+	doc := OpenAPI()
+	errSchema := openapi.JSONSchema(doc.Components.Responses["Error"].Content)
+
+	for _, e := range doc.Endpoints() {
+		t.Run(e.OperationID, func(t *testing.T) {
+			t.Parallel()
+			path := e.Path
+			for _, name := range openapi.PathParameters(e.Path) {
+				path = strings.Replace(path, "{"+name+"}", samplePathParams[name], 1)
+			}
+			var query []string
+			for _, p := range e.Parameters {
+				if v, ok := sampleQuery[p.Name]; ok && p.In == "query" {
+					query = append(query, p.Name+"="+v)
+				}
+			}
+			if len(query) > 0 {
+				path += "?" + strings.Join(query, "&")
+			}
+			body := ""
+			if e.RequestBody != nil {
+				b, err := json.Marshal(example(doc, openapi.JSONSchema(e.RequestBody.Content)))
+				require.NoError(t, err)
+				body = string(b)
+			}
+
+			rec := serve(newTestServer(t, nil), e.Method, doc.Servers[0].URL+path, body, confirmClusterHeader, "dev")
+
+			status, resp := e.SuccessStatus()
+			if rec.Code < http.StatusBadRequest {
+				require.Equal(t, status, strconv.Itoa(rec.Code), rec.Body.String())
+				if schema := openapi.JSONSchema(resp.Content); schema != nil {
+					require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+					require.NoError(t, doc.Validate(schema, rec.Body.Bytes()), rec.Body.String())
+				} else {
+					require.Empty(t, rec.Body.String())
+				}
+				return
+			}
+			require.NoError(t, doc.Validate(errSchema, rec.Body.Bytes()), rec.Body.String())
+			require.NotContains(t, rec.Body.String(), "invalid request body", "the documented request body must decode")
+		})
+	}
+}
+
+func TestOpenAPI_PublishedWithoutSignIn(t *testing.T) {
+	t.Parallel()
+	h := newTestServer(t, application.NewAuthService([]domain.PasswordVerifier{staticPasswords{"alice": "s3cret"}}, nil, 0))
+
+	rec := serve(h, http.MethodGet, apiSpecPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Equal(t, openapi.Version, doc.OpenAPI)
+	require.NotEmpty(t, doc.Paths)
+
+	rec = serve(h, http.MethodGet, apiDocsPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "listTopics")
+}
+
+// example returns a value of the schema with every property set, so decoding it exercises each documented field.
+func example(doc *openapi.Document, s *openapi.Schema) any {
+	if name, ok := s.RefName(); ok {
+		return example(doc, doc.Components.Schemas[name])
+	}
+	if len(s.Enum) > 0 {
+		return s.Enum[0]
+	}
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			return "2026-01-01T00:00:00Z"
+		case "byte":
+			return ""
+		}
+		return "example"
+	case "integer", "number":
+		return 1
+	case "boolean":
+		return false
+	case "array":
+		return []any{example(doc, s.Items)}
+	case "object":
+		obj := map[string]any{}
+		for name, prop := range s.Properties {
+			obj[name] = example(doc, prop)
+		}
+		return obj
+	}
+	return map[string]any{}
+}
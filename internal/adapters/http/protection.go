@@ -0,0 +1,53 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// confirmClusterHeader carries the cluster names typed by the user to confirm a change on a protected cluster.
+const confirmClusterHeader = "X-Confirm-Cluster"
+
+// confirmProtectedCluster answers 428 Precondition Required to changes on a protected cluster
+// until the request repeats the cluster name in the X-Confirm-Cluster header.
+func (s *Server) confirmProtectedCluster(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clusterName := chi.URLParam(r, "clusterName")
+		if clusterName == "" || isSafeMethod(r.Method) || s.clusterConfirmed(r, clusterName) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		writeConfirmationRequired(w, r, clusterName)
+	})
+}
+
+// clusterConfirmed reports whether a change to the cluster may go on. Clusters that are not protected
+// need no confirmation, and read-only ones are left for the services to reject.
+func (s *Server) clusterConfirmed(r *http.Request, clusterName string) bool {
+	cfg, ok := s.clusterService.GetCluster(clusterName)
+	if !ok || !cfg.Protected || s.clusterService.CheckWritable(clusterName) != nil {
+		return true
+	}
+	for _, name := range strings.Split(r.Header.Get(confirmClusterHeader), ",") {
+		if strings.TrimSpace(name) == clusterName {
+			return true
+		}
+	}
+	return false
+}
+
+func writeConfirmationRequired(w http.ResponseWriter, r *http.Request, clusterName string) {
+	msg := fmt.Sprintf("cluster %s is protected: type its name to confirm this change (header %s)", clusterName, confirmClusterHeader)
+	if isAPIRequest(r) {
+		writeErrorStatus(w, http.StatusPreconditionRequired, msg, map[string]string{"cluster": clusterName, "header": confirmClusterHeader})
+		return
+	}
+	http.Error(w, msg, http.StatusPreconditionRequired)
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
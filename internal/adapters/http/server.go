@@ -1,3 +1,6 @@
+// Package httpserver provides HTTP adapter implementations for the maned-scout application.
+// It serves the web UI pages, the HTMX fragments they load and a versioned JSON API covering
+// cluster, topic, consumer group, security and audit operations.
 package httpserver
 
 import (
@@ -18,22 +21,64 @@ import (
 type Server struct {
 	clusterService *application.ClusterService
 	topicService   *application.TopicService
+	authService    *application.AuthService
+	tokenService   *application.TokenService
+	streams        *streams
+	opts           Options
 }
 
-// New creates a new HTTP server instance.
-func New(clusterService *application.ClusterService, topicService *application.TopicService) *Server {
+// Options are the settings of the HTTP server. Zero values keep the defaults.
+type Options struct {
+	// BasePath serves every route under a URL prefix, such as /tools/kafka behind a reverse proxy.
+	BasePath string
+	// CertFile and KeyFile serve HTTPS with a certificate reloaded when the files change.
+	CertFile string
+	KeyFile  string
+	// ReadTimeout, WriteTimeout and IdleTimeout bound the reading of requests, the writing of responses
+	// and the keep-alive wait for the next request, 30 seconds, 60 seconds and 2 minutes by default.
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// MaxBodyBytes bounds request bodies, 10 MiB by default.
+	MaxBodyBytes int64
+	// TrustedProxies are the reverse proxies whose forwarded client addresses are believed.
+	TrustedProxies mid.TrustedProxies
+}
+
+const (
+	defaultReadTimeout       = 30 * time.Second
+	defaultWriteTimeout      = 60 * time.Second
+	defaultIdleTimeout       = 2 * time.Minute
+	defaultReadHeaderTimeout = 10 * time.Second
+	defaultMaxBodyBytes      = 10 << 20
+)
+
+// New creates a new HTTP server instance. Pages are served at the root, their HTMX fragments under /ui and the JSON API
+// under /api/v1. Every route requires sign-in when authService is enabled, and the API also accepts the bearer tokens
+// of tokenService.
+func New(clusterService *application.ClusterService, topicService *application.TopicService, authService *application.AuthService, tokenService *application.TokenService) *Server {
 	return &Server{
 		clusterService: clusterService,
 		topicService:   topicService,
+		authService:    authService,
+		tokenService:   tokenService,
+		streams:        newStreams(),
 	}
 }
 
-// Run starts the HTTP server on the given address.
-func (s *Server) Run(addr string) error {
+// WithOptions returns the server with the given settings.
+func (s *Server) WithOptions(opts Options) *Server {
+	s.opts = opts
+	return s
+}
+
+// Handler returns the router serving every page, fragment and API route, under the base path.
+func (s *Server) Handler() http.Handler {
 	r := chi.NewRouter()
 	r.Use(mid.I18n)
 	r.Use(middleware.RequestID)
-	r.Use(middleware.RealIP)
+	r.Use(mid.RealIP(s.opts.TrustedProxies))
+	r.Use(s.limitBody)
 	r.Use(func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
@@ -66,47 +111,119 @@ func (s *Server) Run(addr string) error {
 
 	r.Get("/lang", ChangeLanguage)
 
-	r.Get("/", s.uiHome)
-	r.Get("/clusters/{clusterName}", s.uiClusterDetail)
-	r.Get("/clusters/{clusterName}/topics", s.uiTopicsList)
-	r.Get("/clusters/{clusterName}/topics/{topicName}", s.uiTopicDetail)
-	r.Get("/clusters/{clusterName}/consumer-groups", s.uiConsumerGroupList)
-	r.Get("/clusters/{clusterName}/consumer-groups/{consumerGroupName}", s.uiConsumerGroupDetail)
-
-	r.Get("/api/clusters", s.apiListClusters)
-	r.Post("/api/clusters", s.apiAddCluster)
-	r.Put("/api/clusters/{clusterName}", s.apiUpdateCluster)
-	r.Delete("/api/clusters/{clusterName}", s.apiDeleteCluster)
-
-	r.Get("/api/clusters/{clusterName}/topics", s.apiListTopics)
-	r.Get("/api/clusters/{clusterName}/topics/{topicName}", s.apiGetTopicDetail)
-	r.Post("/api/clusters/{clusterName}/topics", s.apiCreateTopic)
-	r.Delete("/api/clusters/{clusterName}/topics/{topicName}", s.apiDeleteTopic)
-	r.Put("/api/clusters/{clusterName}/topics/{topicName}/config", s.apiUpdateTopicConfig)
-	r.Post("/api/clusters/{clusterName}/topics/{topicName}/partitions", s.apiIncreasePartitions)
-	r.Get("/api/clusters/{clusterName}/topics/{topicName}/ws-on", s.apiReadMessages)
-	r.Get("/api/clusters/{clusterName}/topics/{topicName}/ws-off", s.apiStopMessages)
-	r.Post("/api/clusters/{clusterName}/topics/{topicName}/messages", s.apiWriteMessage)
-	r.Get("/api/clusters/{clusterName}/topics/{topicName}/ws", s.wsStreamTopic)
-	r.Get("/api/clusters/{clusterName}/topics/{topicName}/consumer-groups", s.apiListTopicConsumerGroups)
-	r.Get("/api/clusters/{clusterName}/consumer-groups", s.apiListConsumerGroup)
-
-	utils.Logger.Info("HTTP server listening", "addr", addr)
-	return http.ListenAndServe(addr, r)
+	r.Get("/login", s.uiLogin)
+	r.Post("/login", s.login)
+	r.Post("/logout", s.logout)
+	r.Get("/auth/oidc/login", s.oidcLogin)
+	r.Get("/auth/oidc/callback", s.oidcCallback)
+	r.Get(apiSpecPath, s.apiOpenAPI)
+	r.Get(apiDocsPath, s.uiAPIDocs)
+
+	r.Group(func(r chi.Router) {
+		r.Use(s.requireAuth)
+
+		r.Get("/", s.uiHome)
+		r.Get("/compare", s.uiCompare)
+		r.Get("/clusters/{clusterName}", s.uiClusterDetail)
+		r.Get("/clusters/{clusterName}/topics", s.uiTopicsList)
+		r.Get("/clusters/{clusterName}/topics/{topicName}", s.uiTopicDetail)
+		r.Get("/clusters/{clusterName}/consumer-groups", s.uiConsumerGroupList)
+		r.Get("/clusters/{clusterName}/consumer-groups/{consumerGroupName}", s.uiConsumerGroupDetail)
+		r.Get("/clusters/{clusterName}/acls", s.uiACLs)
+		r.Get("/clusters/{clusterName}/users", s.uiSCRAMUsers)
+		r.Get("/clusters/{clusterName}/quotas", s.uiQuotas)
+		r.Get("/clusters/{clusterName}/transactions", s.uiTransactions)
+		r.Get("/clusters/{clusterName}/internals", s.uiClusterInternals)
+		r.Get("/tokens", s.uiTokens)
+		r.Get("/audit", s.uiAudit)
+
+		r.Route(apiV1Prefix, s.apiV1)
+
+		// HTMX fragments and the calls of the page scripts.
+		r.Get("/ui/tokens", s.fragmentListTokens)
+		r.Post("/ui/tokens", s.fragmentCreateToken)
+		r.Delete("/ui/tokens/{tokenID}", s.fragmentRevokeToken)
+		r.Get("/ui/audit", s.fragmentListAuditEvents)
+
+		// The preview only reads offsets, so it stays out of the protected cluster confirmation.
+		r.Post("/ui/clusters/{clusterName}/topics/{topicName}/records/preview", s.fragmentPreviewDeleteRecords)
+
+		r.Group(func(r chi.Router) {
+			r.Use(s.confirmProtectedCluster)
+
+			r.Get("/ui/clusters/{clusterName}/internals", s.fragmentGetClusterInternals)
+			r.Get("/ui/clusters/{clusterName}/export", s.fragmentExportCluster)
+
+			r.Get("/ui/compare", s.fragmentCompare)
+
+			r.Get("/ui/clusters/{clusterName}/topics", s.fragmentListTopics)
+			r.Post("/ui/clusters/{clusterName}/topics", s.fragmentCreateTopic)
+			r.Post("/ui/clusters/{clusterName}/topics/batch", s.fragmentBatchTopics)
+			r.Delete("/ui/clusters/{clusterName}/topics/{topicName}", s.fragmentDeleteTopic)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/delete-check", s.fragmentTopicDeletionCheck)
+			r.Post("/ui/clusters/{clusterName}/topics/{topicName}/quarantine", s.fragmentQuarantineTopic)
+			r.Delete("/ui/clusters/{clusterName}/topics/{topicName}/quarantine", s.fragmentReleaseTopicQuarantine)
+			r.Put("/ui/clusters/{clusterName}/topics/{topicName}/config", s.fragmentUpdateTopicConfig)
+			r.Post("/ui/clusters/{clusterName}/topics/{topicName}/partitions", s.fragmentIncreasePartitions)
+			r.Post("/ui/clusters/{clusterName}/topics/{topicName}/records/delete", s.fragmentDeleteRecords)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/ws-on", s.fragmentReadMessages)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/ws-off", s.fragmentStopMessages)
+			r.Post("/ui/clusters/{clusterName}/topics/{topicName}/messages", s.fragmentWriteMessage)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/ws", s.wsStreamTopic)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/consumer-groups", s.fragmentListTopicConsumerGroups)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/acls", s.fragmentListTopicACLs)
+			r.Get("/ui/clusters/{clusterName}/consumer-groups", s.fragmentListConsumerGroup)
+			r.Get("/ui/clusters/{clusterName}/consumer-groups/{consumerGroupName}/acls", s.fragmentListConsumerGroupACLs)
+			r.Get("/ui/clusters/{clusterName}/acls", s.fragmentListACLs)
+			r.Post("/ui/clusters/{clusterName}/acls", s.fragmentCreateACL)
+			r.Delete("/ui/clusters/{clusterName}/acls", s.fragmentDeleteACLs)
+			r.Get("/ui/clusters/{clusterName}/users", s.fragmentListSCRAMUsers)
+			r.Post("/ui/clusters/{clusterName}/users", s.fragmentUpsertSCRAMUser)
+			r.Delete("/ui/clusters/{clusterName}/users/{userName}", s.fragmentDeleteSCRAMUser)
+			r.Get("/ui/clusters/{clusterName}/quotas", s.fragmentListQuotas)
+			r.Put("/ui/clusters/{clusterName}/quotas", s.fragmentAlterQuotas)
+			r.Get("/ui/clusters/{clusterName}/transactions", s.fragmentListTransactions)
+			r.Get("/ui/clusters/{clusterName}/topics/{topicName}/producers", s.fragmentListTopicProducers)
+			r.Post("/ui/clusters/{clusterName}/topics/{topicName}/producers/abort", s.fragmentAbortTransaction)
+		})
+	})
+
+	return mid.BasePath(s.opts.BasePath)(r)
+}
+
+// limitBody bounds the request bodies to MaxBodyBytes. Handlers reading a body past it get an
+// *http.MaxBytesError.
+func (s *Server) limitBody(next http.Handler) http.Handler {
+	limit := s.opts.MaxBodyBytes
+	if limit <= 0 {
+		limit = defaultMaxBodyBytes
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			if isAPIRequest(r) {
+				writeErrorStatus(w, http.StatusRequestEntityTooLarge, "request body too large", nil)
+			} else {
+				http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+			}
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
 }
 
 // ChangeLanguage changes the language preference via a query parameter and sets a cookie.
 func ChangeLanguage(w http.ResponseWriter, r *http.Request) {
 	lang := r.URL.Query().Get("lang")
 	if lang == "" {
-		http.Redirect(w, r, "/", http.StatusSeeOther)
+		http.Redirect(w, r, mid.URL(r.Context(), "/"), http.StatusSeeOther)
 		return
 	}
 
 	http.SetCookie(w, &http.Cookie{
 		Name:     "lang",
 		Value:    lang,
-		Path:     "/",
+		Path:     mid.URL(r.Context(), "/"),
 		SameSite: http.SameSiteLaxMode,
 		MaxAge:   31536000,
 	})
@@ -114,7 +231,7 @@ func ChangeLanguage(w http.ResponseWriter, r *http.Request) {
 	// volta para a página anterior
 	ref := r.Header.Get("Referer")
 	if ref == "" {
-		ref = "/"
+		ref = mid.URL(r.Context(), "/")
 	}
 
 	http.Redirect(w, r, ref, http.StatusSeeOther)
@@ -0,0 +1,245 @@
+//go:build testing
+
+package httpserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_BasePath(t *testing.T) {
+	t.Parallel()
+	h := newServer(t, nil).WithOptions(Options{BasePath: "/tools/kafka/"}).Handler()
+
+	rec := serve(h, http.MethodGet, "/tools/kafka", "")
+	require.Equal(t, http.StatusMovedPermanently, rec.Code)
+	require.Equal(t, "/tools/kafka/", rec.Header().Get("Location"))
+	require.Equal(t, http.StatusNotFound, serve(h, http.MethodGet, "/clusters/dev", "").Code)
+
+	rec = serve(h, http.MethodGet, "/tools/kafka/", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	page := rec.Body.String()
+	require.Contains(t, page, `<meta name="base-path" content="/tools/kafka">`)
+	require.Contains(t, page, `src="/tools/kafka/static/app.js"`)
+	require.Contains(t, page, `href="/tools/kafka/clusters/dev"`)
+	require.NotContains(t, page, `href="/clusters`)
+
+	require.Equal(t, http.StatusOK, serve(h, http.MethodGet, "/tools/kafka/static/app.js", "").Code)
+	rec = serve(h, http.MethodGet, "/tools/kafka/clusters/dev/topics/orders", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `hx-get="/tools/kafka/ui/clusters/dev/topics/orders/ws-on"`)
+	rec = serve(h, http.MethodGet, "/tools/kafka/ui/clusters/dev/topics/orders/ws-on", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `ws-connect="/tools/kafka/ui/clusters/dev/topics/orders/ws"`)
+
+	rec = serve(h, http.MethodPost, "/tools/kafka/api/v1/clusters/dev/topics", `{"name":"audit","num_partitions":1,"replication_factor":1}`, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, "/tools/kafka/api/v1/clusters/dev/topics/audit", rec.Header().Get("Location"))
+
+	rec = serve(h, http.MethodGet, "/tools/kafka/api/openapi.json", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"servers":[{"url":"/tools/kafka/api/v1"}]`)
+	// the document built for clients stays rooted at /
+	require.Equal(t, apiV1Prefix, OpenAPI().Servers[0].URL)
+}
+
+func TestServer_BasePathSignIn(t *testing.T) {
+	t.Parallel()
+	auth := application.NewAuthService([]domain.PasswordVerifier{staticPasswords{"alice": "s3cret"}}, nil, 0)
+	h := newServer(t, auth).WithOptions(Options{BasePath: "/tools/kafka"}).Handler()
+
+	rec := serve(h, http.MethodGet, "/tools/kafka/clusters/dev", "")
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	require.Equal(t, "/tools/kafka/login?next=%2Fclusters%2Fdev", rec.Header().Get("Location"))
+
+	rec = serve(h, http.MethodGet, "/tools/kafka/ui/clusters/dev/topics", "", "HX-Request", "true")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, "/tools/kafka/login", rec.Header().Get("HX-Redirect"))
+
+	rec = serve(h, http.MethodGet, "/tools/kafka/login?next=%2Fclusters%2Fdev", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `action="/tools/kafka/login"`)
+
+	form := url.Values{"username": {"alice"}, "password": {"s3cret"}, "next": {"/clusters/dev"}}
+	rec = serve(h, http.MethodPost, "/tools/kafka/login", form.Encode(), "Content-Type", "application/x-www-form-urlencoded")
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+	require.Equal(t, "/tools/kafka/clusters/dev", rec.Header().Get("Location"))
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+	require.Equal(t, "/tools/kafka/", cookies[0].Path)
+}
+
+func TestServer_LimitsRequestBodies(t *testing.T) {
+	t.Parallel()
+	h := newServer(t, nil).WithOptions(Options{MaxBodyBytes: 32}).Handler()
+
+	body := `{"name":"audit","num_partitions":1,"replication_factor":1}`
+	rec := serve(h, http.MethodPost, "/api/v1/clusters/dev/topics", body, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	require.Equal(t, "request_too_large", decodeAPIError(t, rec).Code)
+
+	// bodies of unknown length are cut at the limit as they are read
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/clusters/dev/topics", strings.NewReader(body))
+	req.ContentLength = -1
+	req.Header.Set("X-Confirm-Cluster", "dev")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+func TestServer_AcceptsBodiesUpToTheConfiguredLimit(t *testing.T) {
+	t.Parallel()
+	h := newServer(t, nil).WithOptions(Options{MaxBodyBytes: 4 << 20}).Handler()
+
+	value := strings.Repeat("x", 2<<20)
+	body := `{"name":"audit","num_partitions":1,"replication_factor":1,"configs":{"description":"` + value + `"}}`
+	rec := serve(h, http.MethodPost, "/api/v1/clusters/dev/topics", body, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+}
+
+func TestRealIP_TrustsOnlyConfiguredProxies(t *testing.T) {
+	t.Parallel()
+	trusted, err := mid.ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+	_, err = mid.ParseTrustedProxies([]string{"proxy"})
+	require.Error(t, err)
+
+	h := mid.RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.RemoteAddr))
+	}))
+	for _, tt := range []struct {
+		name   string
+		remote string
+		header []string
+		want   string
+	}{
+		{"untrusted client", "203.0.113.7:4000", []string{"X-Forwarded-For", "1.2.3.4"}, "203.0.113.7:4000"},
+		{"trusted proxy", "10.1.2.3:4000", []string{"X-Forwarded-For", "1.2.3.4"}, "1.2.3.4"},
+		{"chain of proxies", "10.1.2.3:4000", []string{"X-Forwarded-For", "1.2.3.4, 192.168.1.1"}, "1.2.3.4"},
+		{"made up address", "192.168.1.1:4000", []string{"X-Forwarded-For", "9.9.9.9, 1.2.3.4"}, "1.2.3.4"},
+		{"real ip header", "10.1.2.3:4000", []string{"X-Real-IP", "1.2.3.4"}, "1.2.3.4"},
+		{"no header", "10.1.2.3:4000", nil, "10.1.2.3:4000"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remote
+			if tt.header != nil {
+				req.Header.Set(tt.header[0], tt.header[1])
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tt.want, rec.Body.String())
+		})
+	}
+}
+
+func TestCertReloader_ReloadsChangedFiles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeCert(t, certFile, keyFile, "first")
+
+	certs, err := newCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+	cert, err := certs.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, "first", cert.Leaf.Subject.CommonName)
+
+	writeCert(t, certFile, keyFile, "second")
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(certFile, later, later))
+	require.NoError(t, os.Chtimes(keyFile, later, later))
+	cert, err = certs.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, "second", cert.Leaf.Subject.CommonName)
+
+	// a broken renewal keeps the certificate served
+	require.NoError(t, os.WriteFile(keyFile, []byte("broken"), 0600))
+	require.NoError(t, os.Chtimes(keyFile, later.Add(time.Minute), later.Add(time.Minute)))
+	cert, err = certs.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, "second", cert.Leaf.Subject.CommonName)
+
+	_, err = newCertReloader(certFile, keyFile)
+	require.Error(t, err)
+}
+
+// writeCert writes a self-signed certificate for name and its key.
+func writeCert(t *testing.T, certFile, keyFile, name string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+}
+
+func TestServer_WebSocketsOnlyFromTheSameOrigin(t *testing.T) {
+	t.Parallel()
+	h := newServer(t, nil).WithOptions(Options{BasePath: "/tools/kafka"}).Handler()
+	upgrade := func(origin string) int {
+		return serve(h, http.MethodGet, "/tools/kafka/ui/clusters/dev/topics/orders/ws", "",
+			"Connection", "Upgrade", "Upgrade", "websocket", "Sec-WebSocket-Version", "13",
+			"Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==", "Origin", origin).Code
+	}
+	require.Equal(t, http.StatusForbidden, upgrade("https://evil.example.com"))
+	// the recorder cannot be hijacked, so an accepted upgrade fails only after the origin check
+	require.NotEqual(t, http.StatusForbidden, upgrade("http://example.com"))
+}
+
+func TestServer_ForwardedHeadersOnlyFromTrustedProxies(t *testing.T) {
+	t.Parallel()
+	trusted, err := mid.ParseTrustedProxies([]string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	h := mid.RealIP(trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, isSecureRequest(r), sameOrigin(r))
+	}))
+
+	for _, tt := range []struct {
+		name   string
+		remote string
+		want   string
+	}{
+		{"trusted proxy", "10.1.2.3:4000", "true true"},
+		{"untrusted client", "203.0.113.7:4000", "false false"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://internal:8080/", nil)
+			req.RemoteAddr = tt.remote
+			req.Header.Set("X-Forwarded-Proto", "https")
+			req.Header.Set("X-Forwarded-Host", "kafka.example.com")
+			req.Header.Set("Origin", "https://kafka.example.com")
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			require.Equal(t, tt.want, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// streams tracks the long-lived responses, such as WebSocket streams, which http.Server.Shutdown does not
+// wait for once their connection is hijacked. Closing it tells them to end.
+type streams struct {
+	mu      sync.Mutex
+	wg      sync.WaitGroup
+	closing bool
+	done    chan struct{}
+}
+
+func newStreams() *streams {
+	return &streams{done: make(chan struct{})}
+}
+
+// add registers a stream, unless the server is already shutting down. Streams call release when they end.
+func (s *streams) add() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closing {
+		return false
+	}
+	s.wg.Add(1)
+	return true
+}
+
+func (s *streams) release() {
+	s.wg.Done()
+}
+
+// close asks the streams to end.
+func (s *streams) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closing {
+		s.closing = true
+		close(s.done)
+	}
+}
+
+// wait waits for the streams to end, or for ctx to be done.
+func (s *streams) wait(ctx context.Context) error {
+	ended := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(ended)
+	}()
+	select {
+	case <-ended:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Run serves on addr, over HTTPS when a certificate is configured, until ctx is done, then shuts down
+// gracefully: it stops accepting connections, closes the WebSocket streams with a going-away close frame and
+// waits up to timeout for the requests in flight, after which the remaining connections are dropped.
+func (s *Server) Run(ctx context.Context, addr string, timeout time.Duration) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	utils.Logger.Info("HTTP server listening", "addr", ln.Addr().String(), "tls", s.opts.CertFile != "", "base_path", s.opts.BasePath)
+	return s.serve(ctx, ln, timeout)
+}
+
+func (s *Server) serve(ctx context.Context, ln net.Listener, timeout time.Duration) error {
+	srv := &http.Server{
+		Handler:      s.Handler(),
+		ReadTimeout:  orDefault(s.opts.ReadTimeout, defaultReadTimeout),
+		WriteTimeout: orDefault(s.opts.WriteTimeout, defaultWriteTimeout),
+		IdleTimeout:  orDefault(s.opts.IdleTimeout, defaultIdleTimeout),
+	}
+	srv.ReadHeaderTimeout = min(srv.ReadTimeout, defaultReadHeaderTimeout)
+	if s.opts.CertFile != "" {
+		certs, err := newCertReloader(s.opts.CertFile, s.opts.KeyFile)
+		if err != nil {
+			_ = ln.Close()
+			return err
+		}
+		srv.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12, GetCertificate: certs.getCertificate}
+	}
+	srv.RegisterOnShutdown(s.streams.close)
+
+	served := make(chan error, 1)
+	go func() {
+		if srv.TLSConfig != nil {
+			served <- srv.ServeTLS(ln, "", "")
+			return
+		}
+		served <- srv.Serve(ln)
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	utils.Logger.Info("HTTP server shutting down", "timeout", timeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := errors.Join(srv.Shutdown(shutdownCtx), s.streams.wait(shutdownCtx))
+	if err != nil {
+		_ = srv.Close()
+		return err
+	}
+	utils.Logger.Info("HTTP server stopped")
+	return nil
+}
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
@@ -0,0 +1,108 @@
+//go:build testing
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+// startServer serves on a random local port until the returned cancel function is called.
+func startServer(t *testing.T) (addr string, cancel context.CancelFunc, stopped <-chan error) {
+	t.Helper()
+	utils.InitLogger()
+	initI18n.Do(config.InitI18n)
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "dev", Brokers: []string{"b1"}}}
+	client := testutil.NewFakeKafkaClient()
+	client.Messages = []domain.Message{{Key: []byte("k"), Value: []byte("v")}}
+	client.Follow = true
+	repo.Clients["dev"] = client
+	clusters := application.NewClusterService(repo)
+	s := New(clusters, application.NewTopicService(clusters), application.NewAuthService(nil, nil, 0), application.NewTokenService(nil, clusters))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.serve(ctx, ln, 5*time.Second) }()
+	t.Cleanup(cancel)
+	return ln.Addr().String(), cancel, done
+}
+
+func waitStopped(t *testing.T, stopped <-chan error) {
+	t.Helper()
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+}
+
+func TestServer_ShutdownClosesWebSocketStreams(t *testing.T) {
+	addr, cancel, stopped := startServer(t)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr+"/ui/clusters/dev/topics/orders/ws", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(msg), "v")
+
+	cancel()
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.True(t, errors.As(err, &closeErr), "got %v", err)
+	require.Equal(t, websocket.CloseGoingAway, closeErr.Code)
+	waitStopped(t, stopped)
+}
+
+func TestServer_ShutdownAnswersWaitingRequests(t *testing.T) {
+	addr, cancel, stopped := startServer(t)
+
+	type result struct {
+		body string
+		err  error
+	}
+	answered := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + addr + "/api/v1/clusters/dev/topics/orders/messages?limit=5&timeout=30s")
+		if err != nil {
+			answered <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		answered <- result{body: string(b), err: err}
+	}()
+
+	// let the request start waiting for messages before shutting down
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	select {
+	case r := <-answered:
+		require.NoError(t, r.err)
+		// the message already received is answered, base64 encoded
+		require.Contains(t, r.body, `"key":"aw=="`)
+	case <-time.After(3 * time.Second):
+		t.Fatal("waiting request was not answered")
+	}
+	waitStopped(t, stopped)
+
+	_, err := http.Get("http://" + addr + "/api/v1/clusters")
+	require.Error(t, err)
+}
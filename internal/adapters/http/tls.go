@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// certReloader serves a certificate loaded from PEM files and loads it again once the files change, so
+// renewed certificates are served without a restart. A renewal that fails to load keeps the previous
+// certificate until the files are fixed.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	c := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// reload loads the key pair when either file changed since it was last loaded. It is called with mu held,
+// or before the reloader is shared.
+func (c *certReloader) reload() error {
+	certInfo, err := os.Stat(c.certFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(c.keyFile)
+	if err != nil {
+		return err
+	}
+	if c.cert != nil && certInfo.ModTime().Equal(c.certMod) && keyInfo.ModTime().Equal(c.keyMod) {
+		return nil
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+	if c.cert != nil {
+		utils.Logger.Info("TLS certificate reloaded", "cert", c.certFile)
+	}
+	c.cert, c.certMod, c.keyMod = &cert, certInfo.ModTime(), keyInfo.ModTime()
+	return nil
+}
+
+// getCertificate implements tls.Config.GetCertificate.
+func (c *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.reload(); err != nil {
+		utils.Logger.Warn("TLS certificate reload failed, serving the previous one", "cert", c.certFile, "err", err)
+	}
+	return c.cert, nil
+}
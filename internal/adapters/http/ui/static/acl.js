@@ -0,0 +1,62 @@
+function showCreateACLModal() {
+    document.getElementById('createACLModal').classList.remove('hidden');
+}
+
+function closeCreateACLModal() {
+    document.getElementById('createACLModal').classList.add('hidden');
+}
+
+function refreshACLs() {
+    htmx.trigger('#aclFilterForm', 'refresh');
+}
+
+async function createACL(event) {
+    event.preventDefault();
+    const form = event.target;
+    const acl = Object.fromEntries(new FormData(form).entries());
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/acls`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(acl)
+        });
+
+        if (response.ok) {
+            showNotification('ACL criada com sucesso!', 'success');
+            closeCreateACLModal();
+            form.reset();
+            refreshACLs();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function deleteACL(button) {
+    const acl = JSON.parse(button.dataset.acl);
+    if (!confirm(`${acl.permission} ${acl.operation} ${acl.principal} @ ${acl.resource_type}:${acl.resource_name}?`)) {
+        return;
+    }
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/acls`), {
+            method: 'DELETE',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(acl)
+        });
+
+        if (response.ok) {
+            showNotification('ACL removida com sucesso!', 'success');
+            refreshACLs();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
@@ -101,6 +101,62 @@ document.addEventListener('DOMContentLoaded', () => {
     });
 })();
 
+// The server may be served under a base path, such as /tools/kafka behind a reverse proxy. appURL puts
+// a path rooted at / under it, and appPath takes the base path off the path of the current page.
+const basePath = (document.querySelector('meta[name="base-path"]') || {}).content || '';
+
+function appURL(path) {
+    return basePath + path;
+}
+
+function appPath(pathname) {
+    return basePath && pathname.startsWith(basePath + '/') ? pathname.slice(basePath.length) : pathname;
+}
+
+// Protected clusters answer 428 to changes that do not carry the cluster name typed by the user.
+// Both fetch and htmx requests ask for it and are sent again with the confirmation header.
+// A fetch answered 401 means the session ended, so the browser goes back to the login page.
+(function () {
+    const confirmClusterHeader = 'X-Confirm-Cluster';
+    const originalFetch = window.fetch.bind(window);
+
+    window.fetch = async function (input, init) {
+        const response = await originalFetch(input, init);
+        if (response.status === 401) {
+            window.location.href = appURL('/login?next=' + encodeURIComponent(appPath(window.location.pathname) + window.location.search));
+            return response;
+        }
+        if (response.status !== 428) {
+            return response;
+        }
+        const message = await response.text();
+        const name = window.prompt(message);
+        if (!name) {
+            return new Response(message, { status: response.status, statusText: response.statusText });
+        }
+        const headers = new Headers((init && init.headers) || {});
+        headers.set(confirmClusterHeader, name.trim());
+        return originalFetch(input, Object.assign({}, init, { headers: headers }));
+    };
+
+    document.addEventListener('htmx:beforeSwap', function (evt) {
+        const xhr = evt.detail.xhr;
+        if (!xhr || xhr.status !== 428) {
+            return;
+        }
+        evt.detail.shouldSwap = false;
+        evt.detail.isError = false;
+        const name = window.prompt(xhr.responseText);
+        if (!name) {
+            return;
+        }
+        const config = evt.detail.requestConfig;
+        const headers = {};
+        headers[confirmClusterHeader] = name.trim();
+        htmx.ajax(config.verb, config.path, { source: evt.detail.elt, headers: headers });
+    });
+})();
+
 function initLanguageDropdown() {
     const toggle = document.getElementById('lang-toggle');
     const menu = document.getElementById('lang-menu');
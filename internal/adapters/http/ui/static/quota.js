@@ -0,0 +1,105 @@
+const quotaKeys = ['producer_byte_rate', 'consumer_byte_rate', 'request_percentage', 'controller_mutation_rate'];
+
+function showQuotaModal() {
+    document.getElementById('quotaModal').classList.remove('hidden');
+}
+
+function closeQuotaModal() {
+    document.getElementById('quotaModal').classList.add('hidden');
+    document.getElementById('quotaForm').reset();
+}
+
+function refreshQuotas() {
+    htmx.trigger('#quotas-list', 'refresh');
+}
+
+function editQuota(button) {
+    const quota = JSON.parse(button.dataset.quota);
+    const form = document.getElementById('quotaForm');
+    form.reset();
+
+    for (const c of quota.entity) {
+        if (c.type === 'user') {
+            form.elements['user'].value = c.name || '';
+            form.elements['userDefault'].checked = !!c.default;
+        } else if (c.type === 'client-id') {
+            form.elements['clientId'].value = c.name || '';
+            form.elements['clientIdDefault'].checked = !!c.default;
+        }
+    }
+    for (const key of quotaKeys) {
+        if (quota.values[key] !== undefined) {
+            form.elements[key].value = quota.values[key];
+        }
+    }
+    showQuotaModal();
+}
+
+function buildQuotaEntity(form) {
+    const entity = [];
+    const user = form.elements['user'].value.trim();
+    const clientId = form.elements['clientId'].value.trim();
+
+    if (form.elements['userDefault'].checked) {
+        entity.push({ type: 'user', default: true });
+    } else if (user) {
+        entity.push({ type: 'user', name: user });
+    }
+    if (form.elements['clientIdDefault'].checked) {
+        entity.push({ type: 'client-id', default: true });
+    } else if (clientId) {
+        entity.push({ type: 'client-id', name: clientId });
+    }
+    return entity;
+}
+
+async function alterQuota(body) {
+    const response = await fetch(appURL(`/ui/clusters/${clusterName}/quotas`), {
+        method: 'PUT',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify(body)
+    });
+    if (!response.ok) {
+        throw new Error(await response.text());
+    }
+}
+
+async function saveQuota(event) {
+    event.preventDefault();
+    const form = event.target;
+    const set = {};
+    const remove = [];
+
+    for (const key of quotaKeys) {
+        const value = form.elements[key].value;
+        if (value === '') {
+            remove.push(key);
+        } else {
+            set[key] = parseFloat(value);
+        }
+    }
+
+    try {
+        await alterQuota({ entity: buildQuotaEntity(form), set, remove });
+        showNotification('Quota salva com sucesso!', 'success');
+        closeQuotaModal();
+        refreshQuotas();
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function deleteQuota(button) {
+    const quota = JSON.parse(button.dataset.quota);
+    if (!confirm(quota.entity.map(c => `${c.type}=${c.default ? '<default>' : c.name}`).join(', ') + '?')) {
+        return;
+    }
+
+    try {
+        await alterQuota({ entity: quota.entity, remove: Object.keys(quota.values) });
+        showNotification('Quota removida com sucesso!', 'success');
+        refreshQuotas();
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
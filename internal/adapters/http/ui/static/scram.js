@@ -0,0 +1,65 @@
+function showUpsertUserModal() {
+    document.getElementById('upsertUserModal').classList.remove('hidden');
+}
+
+function closeUpsertUserModal() {
+    document.getElementById('upsertUserModal').classList.add('hidden');
+}
+
+function refreshSCRAMUsers() {
+    htmx.trigger('#users-list', 'refresh');
+}
+
+async function upsertSCRAMUser(event) {
+    event.preventDefault();
+    const form = event.target;
+    const formData = new FormData(form);
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/users`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({
+                name: formData.get('name'),
+                mechanism: formData.get('mechanism'),
+                password: formData.get('password'),
+                iterations: parseInt(formData.get('iterations')) || 0
+            })
+        });
+
+        if (response.ok) {
+            showNotification('Usuário salvo com sucesso!', 'success');
+            closeUpsertUserModal();
+            form.reset();
+            refreshSCRAMUsers();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function deleteSCRAMUser(name, mechanism) {
+    if (!confirm(mechanism ? `${name} (${mechanism})?` : `${name}?`)) {
+        return;
+    }
+
+    try {
+        const query = mechanism ? `?mechanism=${encodeURIComponent(mechanism)}` : '';
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/users/${encodeURIComponent(name)}${query}`), {
+            method: 'DELETE'
+        });
+
+        if (response.ok) {
+            showNotification('Credencial removida com sucesso!', 'success');
+            refreshSCRAMUsers();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
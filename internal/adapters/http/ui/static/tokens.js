@@ -0,0 +1,64 @@
+function refreshTokens() {
+    htmx.trigger('#tokens-list', 'refresh');
+}
+
+async function createToken(event) {
+    event.preventDefault();
+    const form = event.target;
+    const formData = new FormData(form);
+
+    try {
+        const response = await fetch(appURL('/ui/tokens'), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({
+                name: formData.get('name'),
+                kind: formData.get('kind') || 'personal',
+                scopes: formData.getAll('scopes'),
+                expires_in_days: parseInt(formData.get('expires_in_days')) || 0
+            })
+        });
+
+        if (response.ok) {
+            const created = await response.json();
+            document.getElementById('tokenSecretValue').value = created.secret;
+            document.getElementById('tokenSecret').classList.remove('hidden');
+            showNotification('Token criado com sucesso!', 'success');
+            form.reset();
+            refreshTokens();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+function copyTokenSecret() {
+    const input = document.getElementById('tokenSecretValue');
+    navigator.clipboard.writeText(input.value);
+    showNotification('Token copiado!', 'success');
+}
+
+async function revokeToken(id, message) {
+    if (!confirm(message)) {
+        return;
+    }
+
+    try {
+        const response = await fetch(appURL(`/ui/tokens/${encodeURIComponent(id)}`), {
+            method: 'DELETE'
+        });
+
+        if (response.ok) {
+            showNotification('Token revogado com sucesso!', 'success');
+            refreshTokens();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
@@ -4,6 +4,27 @@ function showUpdateConfigModal() {
 
 function closeUpdateConfigModal() {
     document.getElementById('updateConfigModal').classList.add('hidden');
+    document.getElementById('updateConfigForm').reset();
+    document.getElementById('topicConfigDoc').textContent = '';
+}
+
+function editTopicConfig(button) {
+    const form = document.getElementById('updateConfigForm');
+    form.elements['name'].value = button.dataset.name;
+    form.elements['value'].value = button.dataset.value || '';
+    document.getElementById('topicConfigDoc').textContent = button.dataset.doc || '';
+    showUpdateConfigModal();
+}
+
+function showTopicConfigDoc(name) {
+    const option = Array.from(document.querySelectorAll('#topicConfigNames option')).find(o => o.value === name);
+    document.getElementById('topicConfigDoc').textContent = option ? option.dataset.doc || '' : '';
+}
+
+function toggleOverriddenConfigs(onlyOverridden) {
+    document.querySelectorAll('#topicConfigsTable tbody tr').forEach(row => {
+        row.classList.toggle('hidden', onlyOverridden && row.dataset.overridden !== 'true');
+    });
 }
 
 function showIncreasePartitionsModal() {
@@ -16,6 +37,18 @@ function closeIncreasePartitionsModal() {
 
 function confirmDeleteTopic() {
     document.getElementById('deleteTopicModal').classList.remove('hidden');
+    document.getElementById('deleteTopicButton').disabled = true;
+    htmx.ajax('GET', appURL(`/ui/clusters/${clusterName}/topics/${topicName}/delete-check`), {
+        target: '#deleteTopicChecks',
+        swap: 'innerHTML'
+    }).then(updateDeleteTopicButton);
+}
+
+function updateDeleteTopicButton() {
+    const check = document.getElementById('topicDeletionCheck');
+    const confirmation = document.getElementById('deleteTopicConfirmation');
+    const confirmed = check && (check.dataset.requiresConfirmation !== 'true' || (confirmation && confirmation.value === topicName));
+    document.getElementById('deleteTopicButton').disabled = !confirmed;
 }
 
 function closeDeleteTopicModal() {
@@ -29,37 +62,46 @@ function closeWriteMessageModal() {
     document.getElementById('writeMessageModal').classList.add('hidden');
 }
 
+async function sendTopicConfig(configs) {
+    const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/config`), {
+        method: 'PUT',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify({ configs })
+    });
+    if (!response.ok) {
+        throw new Error(await response.text());
+    }
+}
+
 async function updateTopicConfig(event) {
     event.preventDefault();
     const form = event.target;
-    const formData = new FormData(form);
-
-    const configs = {};
-    for (const [key, value] of formData.entries()) {
-        if (value) {
-            configs[key] = value;
-        }
-    }
+    const name = form.elements['name'].value.trim();
+    const value = form.elements['value'].value.trim();
 
-    if (Object.keys(configs).length === 0) {
+    if (!name || !value) {
         showNotification('Nenhuma configuração foi modificada', 'error');
         return;
     }
 
     try {
-        const response = await fetch(`/api/clusters/${clusterName}/topics/${topicName}/config`, {
-            method: 'PUT',
-            headers: { 'Content-Type': 'application/json' },
-            body: JSON.stringify({ configs })
-        });
+        await sendTopicConfig({ [name]: value });
+        queueNotification('Configurações atualizadas com sucesso!', 'success');
+        location.reload();
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
 
-        if (response.ok) {
-            queueNotification('Configurações atualizadas com sucesso!', 'success');
-            location.reload();
-        } else {
-            const error = await response.text();
-            showNotification(`Erro: ${error}`, 'error');
-        }
+async function resetTopicConfig(name) {
+    if (!confirm(`Restaurar ${name} para o valor padrão?`)) {
+        return;
+    }
+
+    try {
+        await sendTopicConfig({ [name]: null });
+        queueNotification(`${name} restaurada para o valor padrão`, 'success');
+        location.reload();
     } catch (error) {
         showNotification(`Erro: ${error.message}`, 'error');
     }
@@ -72,10 +114,10 @@ async function increasePartitions(event) {
     const totalPartitions = parseInt(formData.get('totalPartitions'));
 
     try {
-        const response = await fetch(`/api/clusters/${clusterName}/topics/${topicName}/partitions`, {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/partitions`), {
             method: 'POST',
             headers: { 'Content-Type': 'application/json' },
-            body: JSON.stringify({ totalPartitions })
+            body: JSON.stringify({ total_partitions: totalPartitions })
         });
 
         if (response.ok) {
@@ -92,14 +134,55 @@ async function increasePartitions(event) {
 }
 
 async function deleteTopic() {
+    const confirmation = document.getElementById('deleteTopicConfirmation');
+    const query = confirmation ? `?confirmation=${encodeURIComponent(confirmation.value)}` : '';
     try {
-        const response = await fetch(`/api/clusters/${clusterName}/topics/${topicName}`, {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}${query}`), {
             method: 'DELETE'
         });
 
         if (response.ok) {
             queueNotification('Tópico deletado com sucesso!', 'success');
-            window.location.href = `/clusters/${clusterName}/topics`;
+            window.location.href = appURL(`/clusters/${clusterName}/topics`);
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function quarantineTopic() {
+    const days = parseInt(document.getElementById('quarantineDays').value);
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/quarantine`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({ days })
+        });
+
+        if (response.ok) {
+            queueNotification('Tópico colocado em quarentena', 'success');
+            location.reload();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function releaseTopicQuarantine() {
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/quarantine`), {
+            method: 'DELETE'
+        });
+
+        if (response.ok) {
+            queueNotification('Quarentena do tópico removida', 'success');
+            location.reload();
         } else {
             const error = await response.text();
             showNotification(`Erro: ${error}`, 'error');
@@ -117,7 +200,7 @@ async function sendMessage(event) {
     const value = formData.get('value');
     
     try {
-        const response = await fetch(`/api/clusters/${clusterName}/topics/${topicName}/messages`, {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/messages`), {
             method: 'POST',
             headers: { 'Content-Type': 'application/json' },
             body: JSON.stringify({ key, value })
@@ -135,3 +218,144 @@ async function sendMessage(event) {
         showNotification(`Erro: ${error.message}`, 'error');
     }
 }
+
+function showPurgeRecordsModal() {
+    document.getElementById('purgeRecordsModal').classList.remove('hidden');
+}
+
+function closePurgeRecordsModal() {
+    document.getElementById('purgeRecordsModal').classList.add('hidden');
+    resetPurgePreview();
+}
+
+function switchPurgeMode(mode) {
+    document.querySelectorAll('#purgeRecordsModal .purge-mode').forEach(function (el) {
+        el.classList.toggle('hidden', el.id !== `purge-mode-${mode}`);
+    });
+}
+
+function resetPurgePreview() {
+    document.getElementById('purge-preview').classList.add('hidden');
+    document.getElementById('purge-preview-rows').innerHTML = '';
+    document.getElementById('confirmPurgeButton').disabled = true;
+}
+
+function buildPurgeRequest() {
+    const form = document.getElementById('purgeRecordsForm');
+    const mode = form.elements['mode'].value;
+
+    if (mode === 'all') {
+        return { all: true };
+    }
+    if (mode === 'timestamp') {
+        const value = form.elements['beforeTimestamp'].value;
+        if (!value) {
+            return null;
+        }
+        return { before_timestamp: new Date(value).getTime() };
+    }
+
+    const offsets = {};
+    form.querySelectorAll('.purge-offset').forEach(function (input) {
+        if (input.value !== '') {
+            offsets[input.dataset.partition] = parseInt(input.value);
+        }
+    });
+    if (Object.keys(offsets).length === 0) {
+        return null;
+    }
+    return { offsets };
+}
+
+async function previewPurgeRecords(event) {
+    event.preventDefault();
+    const body = buildPurgeRequest();
+    if (!body) {
+        showNotification('Informe um timestamp ou ao menos um offset', 'error');
+        return;
+    }
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/records/preview`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(body)
+        });
+
+        if (!response.ok) {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+            return;
+        }
+
+        const results = await response.json();
+        const rows = document.getElementById('purge-preview-rows');
+        rows.innerHTML = '';
+        let total = 0;
+        for (const r of results) {
+            total += r.records;
+            const tr = document.createElement('tr');
+            for (const v of [r.partition, r.start_offset, r.end_offset, r.target_offset, r.records]) {
+                const td = document.createElement('td');
+                td.className = 'px-4 py-2 text-sm text-neutral-600 dark:text-neutral-300 font-mono';
+                td.textContent = v;
+                tr.appendChild(td);
+            }
+            rows.appendChild(tr);
+        }
+        document.getElementById('purge-preview-total').textContent = total;
+        document.getElementById('purge-preview').classList.remove('hidden');
+        document.getElementById('confirmPurgeButton').disabled = total === 0;
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function purgeRecords() {
+    const body = buildPurgeRequest();
+    if (!body) {
+        return;
+    }
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/records/delete`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify(body)
+        });
+
+        if (response.ok) {
+            queueNotification('Registros removidos com sucesso!', 'success');
+            location.reload();
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
+
+async function abortTransaction(partition, producerId) {
+    if (!confirm(`Abortar a transação aberta do produtor ${producerId} na partição ${partition}?`)) {
+        return;
+    }
+
+    try {
+        const response = await fetch(appURL(`/ui/clusters/${clusterName}/topics/${topicName}/producers/abort`), {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({ partition, producer_id: producerId })
+        });
+
+        if (response.ok) {
+            showNotification('Transação abortada com sucesso!', 'success');
+            htmx.trigger('#producers-list', 'refresh');
+        } else {
+            const error = await response.text();
+            showNotification(`Erro: ${error}`, 'error');
+        }
+    } catch (error) {
+        showNotification(`Erro: ${error.message}`, 'error');
+    }
+}
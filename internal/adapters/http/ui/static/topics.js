@@ -0,0 +1,113 @@
+const namedTopicConfigs = ['retention.ms', 'cleanup.policy', 'compression.type'];
+
+function addTopicConfigRow(key = '', value = '', containerId = 'topicConfigRows') {
+    const row = document.createElement('div');
+    row.className = 'flex items-center space-x-2';
+
+    const keyInput = document.createElement('input');
+    keyInput.type = 'text';
+    keyInput.name = 'config_key';
+    keyInput.placeholder = 'min.insync.replicas';
+    keyInput.value = key;
+    keyInput.setAttribute('list', 'topicConfigKeys');
+    keyInput.className = 'flex-1 px-3 py-2 border border-neutral-300 dark:border-neutral-600 rounded-lg focus:ring-2 focus:ring-guara-500 dark:bg-neutral-700 dark:text-white font-mono text-sm';
+
+    const valueInput = document.createElement('input');
+    valueInput.type = 'text';
+    valueInput.name = 'config_value';
+    valueInput.placeholder = '2';
+    valueInput.value = value;
+    valueInput.className = 'flex-1 px-3 py-2 border border-neutral-300 dark:border-neutral-600 rounded-lg focus:ring-2 focus:ring-guara-500 dark:bg-neutral-700 dark:text-white font-mono text-sm';
+
+    const remove = document.createElement('button');
+    remove.type = 'button';
+    remove.className = 'px-3 py-2 text-red-600 hover:text-red-800 dark:text-red-400 dark:hover:text-red-300';
+    remove.innerHTML = '<i class="fas fa-trash"></i>';
+    remove.onclick = () => row.remove();
+
+    row.append(keyInput, valueInput, remove);
+    document.getElementById(containerId).appendChild(row);
+}
+
+function clearTopicConfigRows() {
+    document.getElementById('topicConfigRows').replaceChildren();
+}
+
+function applyTopicTemplate(select) {
+    const option = select.options[select.selectedIndex];
+    if (!option || !option.dataset.template) {
+        return;
+    }
+    const template = JSON.parse(option.dataset.template);
+    const form = document.getElementById('createTopicForm');
+
+    if (template.partitions) {
+        form.elements['numPartitions'].value = template.partitions;
+    }
+    if (template.replication_factor) {
+        form.elements['replicationFactor'].value = template.replication_factor;
+    }
+
+    for (const key of namedTopicConfigs) {
+        form.elements[key].value = '';
+    }
+    clearTopicConfigRows();
+    for (const [key, value] of Object.entries(template.configs || {})) {
+        if (namedTopicConfigs.includes(key)) {
+            form.elements[key].value = value;
+        } else {
+            addTopicConfigRow(key, value);
+        }
+    }
+}
+
+function selectedTopicCheckboxes() {
+    return document.querySelectorAll('input[name="topics"]:checked');
+}
+
+function selectAllTopics(checked) {
+    document.querySelectorAll('#topicsTable tbody tr:not([style*="display: none"]) input[name="topics"]').forEach(cb => {
+        cb.checked = checked;
+    });
+    updateBatchTopicSelection();
+}
+
+function updateBatchTopicSelection() {
+    const count = selectedTopicCheckboxes().length;
+    const bar = document.getElementById('batchTopicBar');
+    if (!bar) {
+        return;
+    }
+    bar.classList.toggle('hidden', count === 0);
+    document.getElementById('batchTopicCount').textContent = count;
+    const selectAll = document.getElementById('selectAllTopics');
+    if (selectAll && count === 0) {
+        selectAll.checked = false;
+    }
+}
+
+function showBatchTopicModal(action) {
+    const modal = document.getElementById('batchTopicModal');
+    const form = document.getElementById('batchTopicForm');
+    form.reset();
+    form.elements['action'].value = action;
+    document.getElementById('batchConfigRows').replaceChildren();
+    document.getElementById('batchTopicResults').replaceChildren();
+    document.getElementById('batchTopicModalCount').textContent = selectedTopicCheckboxes().length;
+    modal.querySelectorAll('[data-batch-action]').forEach(section => {
+        section.classList.toggle('hidden', section.dataset.batchAction !== action);
+    });
+    if (action === 'update_config') {
+        addTopicConfigRow('', '', 'batchConfigRows');
+    }
+    modal.classList.remove('hidden');
+}
+
+function closeBatchTopicModal() {
+    document.getElementById('batchTopicModal').classList.add('hidden');
+}
+
+document.addEventListener('DOMContentLoaded', () => {
+    document.body.addEventListener('topic-created', () => clearTopicConfigRows());
+    document.body.addEventListener('htmx:afterSwap', updateBatchTopicSelection);
+});
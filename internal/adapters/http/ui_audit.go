@@ -0,0 +1,24 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (s *Server) uiAudit(w http.ResponseWriter, r *http.Request) {
+	utils.Logger.Debug("render audit log")
+	clusters := s.clusters(r)
+	view := pages.AuditView{Enabled: clusters.AuditEnabled()}
+	for _, c := range clusters.ListClusters() {
+		view.Clusters = append(view.Clusters, c.Name)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Audit(view).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render audit view failed", "err", err)
+		http.Error(w, "failed to render audit view", 500)
+		return
+	}
+}
@@ -10,10 +10,11 @@ import (
 )
 
 func (s *Server) uiHome(w http.ResponseWriter, r *http.Request) {
-	cfgs := s.clusterService.ListClusters()
+	clusters := s.clusters(r)
+	cfgs := clusters.ListClusters()
 	clustersList := make([]pages.ClusterWithStats, 0, len(cfgs))
 	for _, c := range cfgs {
-		cluster, stats, err := s.clusterService.GetClusterInfo(c.Name)
+		cluster, stats, err := clusters.GetClusterInfo(c.Name)
 		if err != nil {
 			utils.Logger.Error("get cluster info failed", "cluster", c.Name, "err", err)
 			continue
@@ -35,7 +36,7 @@ func (s *Server) uiClusterDetail(w http.ResponseWriter, r *http.Request) {
 	name := chi.URLParam(r, "clusterName")
 	utils.Logger.Debug("render cluster detail", "cluster", name)
 
-	cluster, topics, stats, brokerDetails, consumerGroups, err := s.clusterService.GetClusterDetail(name)
+	cluster, topics, stats, brokerDetails, consumerGroups, err := s.clusters(r).GetClusterDetail(name)
 	if err != nil {
 		utils.Logger.Error("get cluster detail failed", "cluster", name, "err", err)
 		http.Error(w, "cluster not found", http.StatusNotFound)
@@ -0,0 +1,31 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (s *Server) uiCompare(w http.ResponseWriter, r *http.Request) {
+	cfgs := s.clusters(r).ListClusters()
+	names := make([]string, 0, len(cfgs))
+	for _, c := range cfgs {
+		names = append(names, c.Name)
+	}
+
+	q := r.URL.Query()
+	form := pages.CompareForm{
+		Source:      q.Get("source"),
+		Target:      q.Get("target"),
+		SourceTopic: q.Get("source_topic"),
+		TargetTopic: q.Get("target_topic"),
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Compare(names, form).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render compare view failed", "err", err)
+		http.Error(w, "failed to render compare view", 500)
+		return
+	}
+}
@@ -12,7 +12,7 @@ import (
 func (s *Server) uiConsumerGroupList(w http.ResponseWriter, r *http.Request) {
 	clusterName := chi.URLParam(r, "clusterName")
 	utils.Logger.Debug("render consumer group list", "cluster", clusterName)
-	_, ok := s.clusterService.GetCluster(clusterName)
+	_, ok := s.clusters(r).GetCluster(clusterName)
 	if !ok {
 		http.Error(w, "cluster not found", http.StatusNotFound)
 		return
@@ -31,17 +31,17 @@ func (s *Server) uiConsumerGroupDetail(w http.ResponseWriter, r *http.Request) {
 	groupName := chi.URLParam(r, "consumerGroupName")
 	utils.Logger.Debug("render consumer group detail", "cluster", clusterName, "group", groupName)
 
-	_, ok := s.clusterService.GetCluster(clusterName)
+	_, ok := s.clusters(r).GetCluster(clusterName)
 	if !ok {
 		http.Error(w, "cluster not found", http.StatusNotFound)
 		return
 	}
 
-	service := application.NewConsumerGroupsService(s.clusterService)
+	service := application.NewConsumerGroupsService(s.clusters(r))
 	group, err := service.FetchConsumerGroupWithLag(r.Context(), clusterName, groupName)
 	if err != nil {
 		utils.Logger.Error("fetch consumer group detail failed", "cluster", clusterName, "group", groupName, "err", err)
-		http.Error(w, "failed to fetch consumer group detail", 500)
+		http.Error(w, "failed to fetch consumer group detail", mapErrorToHTTPStatus(err))
 		return
 	}
 
@@ -50,8 +50,17 @@ func (s *Server) uiConsumerGroupDetail(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	clientIDs := make([]string, 0, len(group.Members))
+	for _, m := range group.Members {
+		clientIDs = append(clientIDs, m.ClientID)
+	}
+	quotas, err := application.NewQuotaService(s.clusters(r)).EffectiveClientIDQuotas(clusterName, clientIDs)
+	if err != nil {
+		utils.Logger.Warn("fetch client quotas failed", "cluster", clusterName, "group", groupName, "err", err)
+	}
+
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := pages.ConsumerGroupDetail(clusterName, group).Render(r.Context(), w); err != nil {
+	if err := pages.ConsumerGroupDetail(clusterName, group, quotas).Render(r.Context(), w); err != nil {
 		utils.Logger.Error("render consumer group detail view failed", "err", err)
 		http.Error(w, "failed to render consumer group detail view", 500)
 		return
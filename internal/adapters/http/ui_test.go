@@ -0,0 +1,57 @@
+package httpserver
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUI_HidesChangesFromViewOnlyUsers(t *testing.T) {
+	t.Parallel()
+	repo := newTestRepository()
+	repo.RBAC = &config.RBACConfig{
+		Roles: []config.Role{
+			{Name: "viewer", Permissions: []config.Permission{{Actions: []string{string(domain.ActionView)}}}},
+			{Name: "admin", Permissions: []config.Permission{{Actions: []string{string(domain.ActionClusterAdmin)}}}},
+		},
+		Bindings: []config.RoleBinding{
+			{Role: "viewer", Users: []string{"vera"}},
+			{Role: "admin", Users: []string{"alice"}},
+		},
+	}
+	auth := application.NewAuthService([]domain.PasswordVerifier{staticPasswords{"vera": "s3cret", "alice": "s3cret"}}, nil, 0)
+	h := newServerWithRepository(t, repo, auth).Handler()
+
+	// each page with the controls it shows only to those who may make the change
+	pages := []struct {
+		path     string
+		controls []string
+	}{
+		{"/clusters/dev/topics", []string{`id="createTopicModal"`, `id="batchTopicBar"`, `id="batchTopicModal"`, `id="exportClusterModal"`}},
+		{"/ui/clusters/dev/topics", []string{`id="selectAllTopics"`, `name="topics"`}},
+		{"/clusters/dev/topics/orders", []string{"showUpdateConfigModal()", `id="updateConfigModal"`, `id="deleteTopicModal"`, `id="purgeRecordsModal"`, `id="writeMessageModal"`}},
+		{"/ui/clusters/dev/topics/orders/delete-check", []string{"quarantineTopic()"}},
+		{"/clusters/dev/acls", []string{"showCreateACLModal()", `id="createACLModal"`}},
+		{"/clusters/dev/users", []string{"showUpsertUserModal()", `id="upsertUserModal"`}},
+		{"/clusters/dev/quotas", []string{"showQuotaModal()", `id="quotaModal"`}},
+	}
+	for _, page := range pages {
+		admin := serve(h, http.MethodGet, page.path, "", "Authorization", basicAuth("alice", "s3cret"))
+		require.Equal(t, http.StatusOK, admin.Code, page.path)
+		viewer := serve(h, http.MethodGet, page.path, "", "Authorization", basicAuth("vera", "s3cret"))
+		require.Equal(t, http.StatusOK, viewer.Code, page.path)
+		for _, control := range page.controls {
+			require.Contains(t, admin.Body.String(), control, page.path)
+			require.NotContains(t, viewer.Body.String(), control, page.path)
+		}
+	}
+}
+
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
@@ -0,0 +1,24 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+func (s *Server) uiTokens(w http.ResponseWriter, r *http.Request) {
+	utils.Logger.Debug("render api tokens")
+	view := pages.TokensView{}
+	if user, err := s.tokenUser(r); err == nil {
+		view.Enabled = true
+		view.Services = s.tokenService.ManagesServiceTokens(user)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Tokens(view).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render tokens view failed", "err", err)
+		http.Error(w, "failed to render tokens view", 500)
+		return
+	}
+}
@@ -12,7 +12,7 @@ import (
 func (s *Server) uiTopicsList(w http.ResponseWriter, r *http.Request) {
 	name := chi.URLParam(r, "clusterName")
 	utils.Logger.Debug("render topics list", "cluster", name)
-	_, ok := s.clusterService.GetCluster(name)
+	_, ok := s.clusters(r).GetCluster(name)
 	if !ok {
 		http.Error(w, "cluster not found", http.StatusNotFound)
 		return
@@ -20,7 +20,7 @@ func (s *Server) uiTopicsList(w http.ResponseWriter, r *http.Request) {
 	topics := make(map[string]int)
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	if err := pages.TopicsList(name, topics).Render(r.Context(), w); err != nil {
+	if err := pages.TopicsList(name, topics, s.topics(r).ListTopicTemplates()).Render(r.Context(), w); err != nil {
 		utils.Logger.Error("render topics list failed", "cluster", name, "err", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -32,13 +32,13 @@ func (s *Server) uiTopicDetail(w http.ResponseWriter, r *http.Request) {
 	topicName := chi.URLParam(r, "topicName")
 	utils.Logger.Debug("render topic detail", "cluster", clusterName, "topic", topicName)
 
-	_, ok := s.clusterService.GetCluster(clusterName)
+	_, ok := s.clusters(r).GetCluster(clusterName)
 	if !ok {
 		http.Error(w, "cluster not found", http.StatusNotFound)
 		return
 	}
 
-	topicDetail, err := s.topicService.GetTopicDetail(clusterName, topicName)
+	topicDetail, err := s.topics(r).GetTopicDetail(clusterName, topicName)
 	if err != nil {
 		utils.Logger.Error("get topic detail failed", "cluster", clusterName, "topic", topicName, "err", err)
 		http.Error(w, "topic not found", http.StatusNotFound)
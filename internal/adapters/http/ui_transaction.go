@@ -0,0 +1,26 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) uiTransactions(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	utils.Logger.Debug("render transactions", "cluster", clusterName)
+	_, ok := s.clusters(r).GetCluster(clusterName)
+	if !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := pages.Transactions(clusterName).Render(r.Context(), w); err != nil {
+		utils.Logger.Error("render transactions view failed", "err", err)
+		http.Error(w, "failed to render transactions view", 500)
+		return
+	}
+}
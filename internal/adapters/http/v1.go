@@ -0,0 +1,133 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// apiV1Prefix is the path of the versioned JSON API.
+const apiV1Prefix = "/api/v1"
+
+// apiErrorBody is the body of every error answered by the JSON API.
+type apiErrorBody struct {
+	Error apiError `json:"error"`
+}
+
+// apiError describes a failed API request. Code is stable and meant for programs, Message for people,
+// and Details carries what the client needs to recover, such as the deletion check of a topic.
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Details any    `json:"details,omitempty"`
+}
+
+// apiErrorCodes names the error code of each status the API answers.
+var apiErrorCodes = map[int]string{
+	http.StatusBadRequest:            "invalid_request",
+	http.StatusUnauthorized:          "unauthenticated",
+	http.StatusForbidden:             "forbidden",
+	http.StatusNotFound:              "not_found",
+	http.StatusMethodNotAllowed:      "method_not_allowed",
+	http.StatusConflict:              "conflict",
+	http.StatusRequestEntityTooLarge: "request_too_large",
+	http.StatusUnprocessableEntity:   "policy_violation",
+	http.StatusPreconditionRequired:  "confirmation_required",
+	http.StatusInternalServerError:   "internal_error",
+}
+
+// apiV1 registers the routes of apiV1Routes. Every response is JSON, changes answer 204 No Content
+// or 201 Created, and errors carry an apiErrorBody.
+func (s *Server) apiV1(r chi.Router) {
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		writeErrorStatus(w, http.StatusNotFound, fmt.Sprintf("no route for %s %s", r.Method, r.URL.Path), nil)
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		writeErrorStatus(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s is not allowed on %s", r.Method, r.URL.Path), nil)
+	})
+
+	for _, route := range apiV1Routes {
+		var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { route.handler(s, w, r) })
+		if !route.unconfirmed {
+			h = s.confirmProtectedCluster(h)
+		}
+		r.Method(route.method, route.pattern, h)
+	}
+}
+
+// isAPIRequest reports whether the request is for the JSON API rather than the web UI.
+func isAPIRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/api/")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		utils.Logger.Error("encode api response failed", "err", err)
+	}
+}
+
+// writeError answers err as a structured error, with the status of the application error.
+func writeError(w http.ResponseWriter, err error) {
+	writeErrorDetails(w, err, nil)
+}
+
+// writeErrorDetails answers err as a structured error carrying details.
+func writeErrorDetails(w http.ResponseWriter, err error, details any) {
+	status := mapErrorToHTTPStatus(err)
+	body := apiErrorBody{Error: apiError{Code: apiErrorCodes[status], Message: err.Error(), Details: details}}
+	if errors.Is(err, application.ErrReadOnly) {
+		body.Error.Code = "read_only"
+	}
+	writeJSON(w, status, body)
+}
+
+// writeErrorStatus answers a structured error with the given status.
+func writeErrorStatus(w http.ResponseWriter, status int, msg string, details any) {
+	code, ok := apiErrorCodes[status]
+	if !ok {
+		code = strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+	}
+	writeJSON(w, status, apiErrorBody{Error: apiError{Code: code, Message: msg, Details: details}})
+}
+
+// decodeJSON reads the JSON request body into v, answering 400 Bad Request when it is malformed
+// or has unknown fields, and 413 when it runs past the limit limitBody set.
+func decodeJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeErrorStatus(w, http.StatusRequestEntityTooLarge, err.Error(), nil)
+			return false
+		}
+		writeErrorStatus(w, http.StatusBadRequest, "invalid request body: "+err.Error(), nil)
+		return false
+	}
+	return true
+}
+
+// writeCreated answers 201 Created for the resource at location, a path under the base path.
+func writeCreated(w http.ResponseWriter, r *http.Request, location string) {
+	if location != "" {
+		w.Header().Set("Location", mid.URL(r.Context(), location))
+	}
+	w.WriteHeader(http.StatusCreated)
+}
+
+// writeList answers a JSON array, empty rather than null when there are no items.
+func writeList[T any](w http.ResponseWriter, items []T) {
+	if items == nil {
+		items = []T{}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
@@ -0,0 +1,130 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) v1ListClusters(w http.ResponseWriter, r *http.Request) {
+	clusters := s.clusters(r)
+	cfgs := clusters.ListClusters()
+	out := make([]domain.ClusterOverview, 0, len(cfgs))
+	for _, c := range cfgs {
+		cluster, stats, err := clusters.GetClusterInfo(c.Name)
+		if err != nil {
+			utils.Logger.Error("get cluster info failed", "cluster", c.Name, "err", err)
+			continue
+		}
+		out = append(out, domain.ClusterOverview{Cluster: *cluster, Stats: stats})
+	}
+	writeList(w, out)
+}
+
+func (s *Server) v1GetCluster(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	cluster, _, stats, brokers, groups, err := s.clusters(r).GetClusterDetail(name)
+	if err != nil {
+		utils.Logger.Error("api get cluster failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.ClusterOverview{Cluster: *cluster, Stats: stats, BrokerDetails: brokers, ConsumerGroups: groups})
+}
+
+func (s *Server) v1AddCluster(w http.ResponseWriter, r *http.Request) {
+	var c config.ClusterConfig
+	if !decodeJSON(w, r, &c) {
+		return
+	}
+	if err := s.clusters(r).AddCluster(c); err != nil {
+		utils.Logger.Error("api add cluster failed", "cluster", c.Name, "err", err)
+		writeError(w, err)
+		return
+	}
+	utils.Logger.Info("cluster added", "cluster", c.Name)
+	writeCreated(w, r, apiV1Prefix+"/clusters/"+url.PathEscape(c.Name))
+}
+
+func (s *Server) v1UpdateCluster(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	var c config.ClusterConfig
+	if !decodeJSON(w, r, &c) {
+		return
+	}
+	if err := s.clusters(r).UpdateCluster(name, c); err != nil {
+		utils.Logger.Error("api update cluster failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	utils.Logger.Info("cluster updated", "cluster", name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1DeleteCluster(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	if err := s.clusters(r).DeleteCluster(name); err != nil {
+		utils.Logger.Error("api delete cluster failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	utils.Logger.Info("cluster deleted", "cluster", name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1GetClusterInternals(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	internals, err := s.clusters(r).GetClusterInternals(name)
+	if err != nil {
+		utils.Logger.Error("api get cluster internals failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, internals)
+}
+
+// v1ExportCluster answers the desired topic state of a cluster as JSON, ready to be posted to the plan
+// and apply routes.
+func (s *Server) v1ExportCluster(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	q := r.URL.Query()
+	opts := application.ExportOptions{
+		ConsumerGroups: q.Get("groups") == "true",
+		ACLs:           q.Get("acls") == "true",
+	}
+	state, err := application.NewExportService(s.clusters(r)).Export(name, opts)
+	if err != nil {
+		utils.Logger.Error("api export cluster failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, state)
+}
+
+func (s *Server) v1Compare(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	source, target := q.Get("source"), q.Get("target")
+	sourceTopic, targetTopic := q.Get("source_topic"), q.Get("target_topic")
+
+	service := application.NewCompareService(s.topics(r))
+	var (
+		cmp domain.TopicComparison
+		err error
+	)
+	if sourceTopic != "" {
+		cmp, err = service.CompareTopics(source, sourceTopic, target, targetTopic)
+	} else {
+		cmp, err = service.CompareClusters(source, target)
+	}
+	if err != nil {
+		utils.Logger.Error("api compare failed", "source", source, "target", target, "topic", sourceTopic, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, cmp)
+}
@@ -0,0 +1,73 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) v1ListConsumerGroups(w http.ResponseWriter, r *http.Request) {
+	s.v1ConsumerGroups(w, r, "")
+}
+
+func (s *Server) v1ListTopicConsumerGroups(w http.ResponseWriter, r *http.Request) {
+	s.v1ConsumerGroups(w, r, chi.URLParam(r, "topicName"))
+}
+
+func (s *Server) v1ConsumerGroups(w http.ResponseWriter, r *http.Request, topicName string) {
+	clusterName := chi.URLParam(r, "clusterName")
+	lags, err := application.NewConsumerGroupsService(s.clusters(r)).ListConsumerGroupsWithLagFromTopic(r.Context(), clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("api list consumer groups failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	out := make([]domain.ConsumerGroup, 0, len(lags))
+	for _, g := range lags {
+		out = append(out, domain.NewConsumerGroup(g))
+	}
+	slices.SortFunc(out, func(a, b domain.ConsumerGroup) int { return strings.Compare(a.GroupID, b.GroupID) })
+	writeList(w, out)
+}
+
+func (s *Server) v1GetConsumerGroup(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	groupName := chi.URLParam(r, "consumerGroupName")
+	group, err := application.NewConsumerGroupsService(s.clusters(r)).FetchConsumerGroupWithLag(r.Context(), clusterName, groupName)
+	if err != nil {
+		utils.Logger.Error("api get consumer group failed", "cluster", clusterName, "group", groupName, "err", err)
+		writeError(w, err)
+		return
+	}
+	if group.Group == "" {
+		writeErrorStatus(w, http.StatusNotFound, fmt.Sprintf("consumer group %s not found", groupName), nil)
+		return
+	}
+	writeJSON(w, http.StatusOK, domain.NewConsumerGroup(group))
+}
+
+func (s *Server) v1ResetOffsets(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	groupName := chi.URLParam(r, "consumerGroupName")
+	var req domain.ResetOffsetsRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if !req.DryRun && !s.clusterConfirmed(r, clusterName) {
+		writeConfirmationRequired(w, r, clusterName)
+		return
+	}
+	resets, err := application.NewConsumerGroupsService(s.clusters(r)).ResetOffsets(r.Context(), clusterName, groupName, req)
+	if err != nil {
+		utils.Logger.Error("api reset offsets failed", "cluster", clusterName, "group", groupName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, resets)
+}
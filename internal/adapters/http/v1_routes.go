@@ -0,0 +1,272 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// apiRoute is a route of the JSON API along with what the OpenAPI document says about it.
+// Body and result hold a zero value of the request and response bodies, nil when there is none.
+// Status is the success status, 200 when there is a result and 204 otherwise.
+type apiRoute struct {
+	method  string
+	pattern string
+	handler func(*Server, http.ResponseWriter, *http.Request)
+	// unconfirmed routes skip the protected cluster confirmation, and confirm routes check it
+	// themselves for the clusters named in their body
+	unconfirmed bool
+	confirm     bool
+
+	id          string
+	tag         string
+	summary     string
+	description string
+	query       []apiParam
+	body        any
+	yamlBody    bool
+	result      any
+	status      int
+	location    bool
+}
+
+// apiParam is a query parameter. Type is a JSON schema type: string, boolean or integer.
+type apiParam struct {
+	name        string
+	typ         string
+	description string
+	required    bool
+}
+
+// API operation tags, in the order the docs list them.
+const (
+	tagClusters       = "Clusters"
+	tagTopics         = "Topics"
+	tagMessages       = "Messages"
+	tagConsumerGroups = "Consumer groups"
+	tagACLs           = "ACLs"
+	tagSCRAMUsers     = "SCRAM users"
+	tagQuotas         = "Quotas"
+	tagTransactions   = "Transactions"
+	tagTopicState     = "Topic state"
+	tagTokens         = "Tokens"
+	tagAudit          = "Audit"
+)
+
+var apiTags = []struct{ name, description string }{
+	{tagClusters, "Configured clusters, their brokers and internals"},
+	{tagTopics, "Topics, their configs, partitions, records and quarantine"},
+	{tagMessages, "Produce and consume messages"},
+	{tagConsumerGroups, "Consumer groups and their lag"},
+	{tagACLs, "Access control lists of a cluster"},
+	{tagSCRAMUsers, "SCRAM credentials of a cluster"},
+	{tagQuotas, "Client quotas of a cluster"},
+	{tagTransactions, "Transactions and the producers writing to topics"},
+	{tagTopicState, "Plan and apply a declarative topic state"},
+	{tagTokens, "API tokens of the signed-in user"},
+	{tagAudit, "Audit log of changes"},
+}
+
+var apiPathParams = map[string]string{
+	"clusterName":       "Name of the cluster",
+	"topicName":         "Name of the topic",
+	"consumerGroupName": "ID of the consumer group",
+	"userName":          "Name of the SCRAM user",
+	"tokenID":           "ID of the token",
+}
+
+var aclFilterParams = []apiParam{
+	{name: "principal", typ: "string", description: "Principal, such as User:alice"},
+	{name: "host", typ: "string"},
+	{name: "resource_type", typ: "string"},
+	{name: "resource_name", typ: "string"},
+	{name: "pattern_type", typ: "string", description: "LITERAL, PREFIXED or MATCH"},
+	{name: "operation", typ: "string"},
+	{name: "permission", typ: "string"},
+}
+
+var deleteParam = apiParam{name: "delete", typ: "boolean", description: "Also delete the topics missing from the state"}
+
+// apiV1Routes lists every route of the JSON API, relative to apiV1Prefix.
+var apiV1Routes = []apiRoute{
+	{method: http.MethodGet, pattern: "/clusters", handler: (*Server).v1ListClusters,
+		id: "listClusters", tag: tagClusters, summary: "List the clusters with their statistics",
+		result: []domain.ClusterOverview{}},
+	{method: http.MethodPost, pattern: "/clusters", handler: (*Server).v1AddCluster,
+		id: "addCluster", tag: tagClusters, summary: "Add a cluster",
+		body: config.ClusterConfig{}, status: http.StatusCreated, location: true},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}", handler: (*Server).v1GetCluster,
+		id: "getCluster", tag: tagClusters, summary: "Get a cluster with its brokers and consumer groups",
+		result: domain.ClusterOverview{}},
+	{method: http.MethodPut, pattern: "/clusters/{clusterName}", handler: (*Server).v1UpdateCluster,
+		id: "updateCluster", tag: tagClusters, summary: "Update the configuration of a cluster",
+		body: config.ClusterConfig{}},
+	{method: http.MethodDelete, pattern: "/clusters/{clusterName}", handler: (*Server).v1DeleteCluster,
+		id: "deleteCluster", tag: tagClusters, summary: "Remove a cluster from the configuration"},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/internals", handler: (*Server).v1GetClusterInternals,
+		id: "getClusterInternals", tag: tagClusters, summary: "Get the metadata quorum, features and API versions of a cluster",
+		result: domain.ClusterInternals{}},
+	{method: http.MethodGet, pattern: "/compare", handler: (*Server).v1Compare,
+		id: "compare", tag: tagClusters, summary: "Compare the topics of two clusters, or two single topics",
+		query: []apiParam{
+			{name: "source", typ: "string", description: "Source cluster", required: true},
+			{name: "target", typ: "string", description: "Target cluster", required: true},
+			{name: "source_topic", typ: "string", description: "Compare this topic only"},
+			{name: "target_topic", typ: "string", description: "Topic of the target cluster to compare source_topic with, the same name by default"},
+		},
+		result: domain.TopicComparison{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics", handler: (*Server).v1ListTopics,
+		id: "listTopics", tag: tagTopics, summary: "List the topics of a cluster",
+		query:  []apiParam{{name: "internal", typ: "boolean", description: "Include internal topics"}},
+		result: []domain.TopicSummary{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics", handler: (*Server).v1CreateTopic,
+		id: "createTopic", tag: tagTopics, summary: "Create a topic",
+		body: domain.CreateTopicRequest{}, status: http.StatusCreated, location: true},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/batch", handler: (*Server).v1BatchTopics,
+		id: "batchTopics", tag: tagTopics, summary: "Apply the same change to several topics",
+		body: domain.BatchTopicRequest{}, result: []domain.BatchTopicResult{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/quarantined-topics", handler: (*Server).v1ListQuarantinedTopics,
+		id: "listQuarantinedTopics", tag: tagTopics, summary: "List the quarantined topics of a cluster",
+		result: []config.QuarantinedTopic{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}", handler: (*Server).v1GetTopic,
+		id: "getTopic", tag: tagTopics, summary: "Get a topic with its configs and partitions",
+		result: domain.TopicDetail{}},
+	{method: http.MethodDelete, pattern: "/clusters/{clusterName}/topics/{topicName}", handler: (*Server).v1DeleteTopic,
+		id: "deleteTopic", tag: tagTopics, summary: "Delete a topic",
+		description: "When the deletion checks fire the answer is 409 Conflict with the deletion check in the error details, " +
+			"until the confirmation parameter repeats the topic name.",
+		query: []apiParam{{name: "confirmation", typ: "string", description: "The topic name, to confirm the deletion of a topic in use"}}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}/deletion-check", handler: (*Server).v1TopicDeletionCheck,
+		id: "checkTopicDeletion", tag: tagTopics, summary: "Check whether a topic is in use before deleting it",
+		result: domain.TopicDeletionCheck{}},
+	{method: http.MethodPut, pattern: "/clusters/{clusterName}/topics/{topicName}/config", handler: (*Server).v1UpdateTopicConfig,
+		id: "updateTopicConfig", tag: tagTopics, summary: "Set or reset topic configs",
+		body: domain.UpdateTopicConfigRequest{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/partitions", handler: (*Server).v1IncreasePartitions,
+		id: "increasePartitions", tag: tagTopics, summary: "Increase the partitions of a topic",
+		body: domain.IncreasePartitionsRequest{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/records/preview", handler: (*Server).v1PreviewDeleteRecords, unconfirmed: true,
+		id: "previewDeleteRecords", tag: tagTopics, summary: "Preview which records a deletion would remove",
+		body: domain.DeleteRecordsRequest{}, result: []domain.DeleteRecordsResult{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/records/delete", handler: (*Server).v1DeleteRecords,
+		id: "deleteRecords", tag: tagTopics, summary: "Delete records of a topic",
+		body: domain.DeleteRecordsRequest{}, result: []domain.DeleteRecordsResult{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/quarantine", handler: (*Server).v1QuarantineTopic,
+		id: "quarantineTopic", tag: tagTopics, summary: "Block a topic and delete it after some days",
+		body: domain.QuarantineTopicRequest{}},
+	{method: http.MethodDelete, pattern: "/clusters/{clusterName}/topics/{topicName}/quarantine", handler: (*Server).v1ReleaseTopicQuarantine,
+		id: "releaseTopicQuarantine", tag: tagTopics, summary: "Release a quarantined topic"},
+	{method: http.MethodGet, pattern: "/topic-templates", handler: (*Server).v1ListTopicTemplates,
+		id: "listTopicTemplates", tag: tagTopics, summary: "List the topic templates",
+		result: []config.TopicTemplate{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}/messages", handler: (*Server).v1ConsumeMessages,
+		id: "consumeMessages", tag: tagMessages, summary: "Wait for new messages on a topic",
+		description: "Answers once limit messages arrived or the timeout passed. Keys and values are base64 encoded.",
+		query: []apiParam{
+			{name: "limit", typ: "integer", description: "Maximum number of messages, 100 by default and at most 1000"},
+			{name: "timeout", typ: "string", description: "How long to wait, such as 10s; 5s by default and at most 30s"},
+		},
+		result: []domain.Message{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/messages", handler: (*Server).v1ProduceMessage,
+		id: "produceMessage", tag: tagMessages, summary: "Produce a message to a topic",
+		body: domain.MessageRequest{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/consumer-groups", handler: (*Server).v1ListConsumerGroups,
+		id: "listConsumerGroups", tag: tagConsumerGroups, summary: "List the consumer groups of a cluster with their lag",
+		result: []domain.ConsumerGroup{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/consumer-groups/{consumerGroupName}", handler: (*Server).v1GetConsumerGroup,
+		id: "getConsumerGroup", tag: tagConsumerGroups, summary: "Get a consumer group with its members and lag",
+		result: domain.ConsumerGroup{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/consumer-groups/{consumerGroupName}/offsets/reset", handler: (*Server).v1ResetOffsets,
+		unconfirmed: true, confirm: true,
+		id: "resetConsumerGroupOffsets", tag: tagConsumerGroups, summary: "Reset the committed offsets of a consumer group",
+		description: "A dry run answers the new offsets without committing them and needs no confirmation.",
+		body: domain.ResetOffsetsRequest{}, result: []domain.OffsetReset{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}/consumer-groups", handler: (*Server).v1ListTopicConsumerGroups,
+		id: "listTopicConsumerGroups", tag: tagConsumerGroups, summary: "List the consumer groups consuming a topic",
+		result: []domain.ConsumerGroup{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/acls", handler: (*Server).v1ListACLs,
+		id: "listACLs", tag: tagACLs, summary: "List the ACLs matching a filter",
+		query: aclFilterParams, result: []domain.ACL{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/acls", handler: (*Server).v1CreateACL,
+		id: "createACL", tag: tagACLs, summary: "Create an ACL",
+		body: domain.ACL{}, status: http.StatusCreated},
+	{method: http.MethodDelete, pattern: "/clusters/{clusterName}/acls", handler: (*Server).v1DeleteACLs,
+		id: "deleteACLs", tag: tagACLs, summary: "Delete the ACLs matching a filter and answer them",
+		body: domain.ACLFilter{}, result: []domain.ACL{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}/acls", handler: (*Server).v1ListTopicACLs,
+		id: "listTopicACLs", tag: tagACLs, summary: "List the ACLs applying to a topic",
+		result: []domain.ACL{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/consumer-groups/{consumerGroupName}/acls", handler: (*Server).v1ListConsumerGroupACLs,
+		id: "listConsumerGroupACLs", tag: tagACLs, summary: "List the ACLs applying to a consumer group",
+		result: []domain.ACL{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/users", handler: (*Server).v1ListSCRAMUsers,
+		id: "listSCRAMUsers", tag: tagSCRAMUsers, summary: "List the SCRAM users of a cluster",
+		result: []domain.SCRAMUser{}},
+	{method: http.MethodPut, pattern: "/clusters/{clusterName}/users/{userName}", handler: (*Server).v1UpsertSCRAMUser,
+		id: "upsertSCRAMUser", tag: tagSCRAMUsers, summary: "Create or update a SCRAM credential",
+		body: domain.UpsertSCRAMUserRequest{}},
+	{method: http.MethodDelete, pattern: "/clusters/{clusterName}/users/{userName}", handler: (*Server).v1DeleteSCRAMUser,
+		id: "deleteSCRAMUser", tag: tagSCRAMUsers, summary: "Delete a SCRAM credential",
+		query: []apiParam{{name: "mechanism", typ: "string", description: "Delete only the SCRAM-SHA-256 or SCRAM-SHA-512 credential; every credential by default"}}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/quotas", handler: (*Server).v1ListQuotas,
+		id: "listQuotas", tag: tagQuotas, summary: "List the client quotas of a cluster",
+		result: []domain.ClientQuota{}},
+	{method: http.MethodPut, pattern: "/clusters/{clusterName}/quotas", handler: (*Server).v1AlterQuotas,
+		id: "alterQuotas", tag: tagQuotas, summary: "Set or remove the quotas of an entity",
+		body: domain.AlterClientQuotaRequest{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/transactions", handler: (*Server).v1ListTransactions,
+		id: "listTransactions", tag: tagTransactions, summary: "List the transactions of a cluster",
+		result: []domain.Transaction{}},
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/topics/{topicName}/producers", handler: (*Server).v1ListTopicProducers,
+		id: "listTopicProducers", tag: tagTransactions, summary: "List the producers writing to a topic",
+		result: []domain.ActiveProducer{}},
+	{method: http.MethodPost, pattern: "/clusters/{clusterName}/topics/{topicName}/producers/abort", handler: (*Server).v1AbortTransaction,
+		id: "abortTransaction", tag: tagTransactions, summary: "Abort the open transaction of a producer on a partition",
+		body: domain.AbortTransactionRequest{}},
+
+	{method: http.MethodGet, pattern: "/clusters/{clusterName}/export", handler: (*Server).v1ExportCluster,
+		id: "exportCluster", tag: tagTopicState, summary: "Export the topic state of a cluster",
+		query: []apiParam{
+			{name: "groups", typ: "boolean", description: "Include the committed offsets of the consumer groups"},
+			{name: "acls", typ: "boolean", description: "Include the ACLs"},
+		},
+		result: domain.DesiredTopicState{}},
+	{method: http.MethodPost, pattern: "/topics/plan", handler: (*Server).v1PlanTopics,
+		id: "planTopics", tag: tagTopicState, summary: "Plan the changes converging the clusters to a topic state",
+		query: []apiParam{deleteParam}, body: domain.DesiredTopicState{}, yamlBody: true, result: []domain.TopicPlan{}},
+	{method: http.MethodPost, pattern: "/topics/apply", handler: (*Server).v1ApplyTopics, confirm: true,
+		id: "applyTopics", tag: tagTopicState, summary: "Apply the changes converging the clusters to a topic state",
+		query: []apiParam{deleteParam}, body: domain.DesiredTopicState{}, yamlBody: true, result: []domain.TopicApplyResult{}},
+
+	{method: http.MethodGet, pattern: "/tokens", handler: (*Server).v1ListTokens,
+		id: "listTokens", tag: tagTokens, summary: "List your API tokens",
+		result: []domain.APIToken{}},
+	{method: http.MethodPost, pattern: "/tokens", handler: (*Server).v1CreateToken,
+		id: "createToken", tag: tagTokens, summary: "Create an API token",
+		body: domain.CreateTokenRequest{}, result: domain.CreatedToken{}, status: http.StatusCreated, location: true,
+		description: "The secret is only ever answered here."},
+	{method: http.MethodDelete, pattern: "/tokens/{tokenID}", handler: (*Server).v1RevokeToken,
+		id: "revokeToken", tag: tagTokens, summary: "Revoke an API token"},
+
+	{method: http.MethodGet, pattern: "/audit", handler: (*Server).v1ListAuditEvents,
+		id: "listAuditEvents", tag: tagAudit, summary: "List audit events, newest first",
+		query: []apiParam{
+			{name: "user", typ: "string"},
+			{name: "cluster", typ: "string"},
+			{name: "resource", typ: "string", description: "Part of the resource name"},
+			{name: "action", typ: "string", description: "Audited action, such as topic.create"},
+			{name: "result", typ: "string", description: "success, failure or denied"},
+			{name: "since", typ: "string", description: "RFC 3339 time or date"},
+			{name: "until", typ: "string", description: "RFC 3339 time or date, which includes the whole day"},
+			{name: "limit", typ: "integer", description: "Maximum number of events, 200 by default"},
+		},
+		result: []domain.AuditEvent{}},
+}
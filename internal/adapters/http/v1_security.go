@@ -0,0 +1,143 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) v1ListACLs(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	q := r.URL.Query()
+	filter := domain.ACLFilter{
+		Principal:    q.Get("principal"),
+		Host:         q.Get("host"),
+		ResourceType: q.Get("resource_type"),
+		ResourceName: q.Get("resource_name"),
+		PatternType:  q.Get("pattern_type"),
+		Operation:    q.Get("operation"),
+		Permission:   q.Get("permission"),
+	}
+	acls, err := application.NewACLService(s.clusters(r)).ListACLs(clusterName, filter)
+	if err != nil {
+		utils.Logger.Error("api list acls failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, acls)
+}
+
+func (s *Server) v1ListTopicACLs(w http.ResponseWriter, r *http.Request) {
+	s.v1ResourceACLs(w, r, "TOPIC", chi.URLParam(r, "topicName"))
+}
+
+func (s *Server) v1ListConsumerGroupACLs(w http.ResponseWriter, r *http.Request) {
+	s.v1ResourceACLs(w, r, "GROUP", chi.URLParam(r, "consumerGroupName"))
+}
+
+func (s *Server) v1ResourceACLs(w http.ResponseWriter, r *http.Request, resourceType, resourceName string) {
+	clusterName := chi.URLParam(r, "clusterName")
+	acls, err := application.NewACLService(s.clusters(r)).ListResourceACLs(clusterName, resourceType, resourceName)
+	if err != nil {
+		utils.Logger.Error("api list resource acls failed", "cluster", clusterName, "resource", resourceName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, acls)
+}
+
+func (s *Server) v1CreateACL(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var acl domain.ACL
+	if !decodeJSON(w, r, &acl) {
+		return
+	}
+	if err := application.NewACLService(s.clusters(r)).CreateACL(clusterName, acl); err != nil {
+		utils.Logger.Error("api create acl failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeCreated(w, r, "")
+}
+
+// v1DeleteACLs deletes the ACLs matching the filter in the body and answers the deleted ones.
+func (s *Server) v1DeleteACLs(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var filter domain.ACLFilter
+	if !decodeJSON(w, r, &filter) {
+		return
+	}
+	deleted, err := application.NewACLService(s.clusters(r)).DeleteACLs(clusterName, filter)
+	if err != nil {
+		utils.Logger.Error("api delete acls failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, deleted)
+}
+
+func (s *Server) v1ListSCRAMUsers(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	users, err := application.NewSCRAMService(s.clusters(r)).ListUsers(clusterName)
+	if err != nil {
+		utils.Logger.Error("api list scram users failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, users)
+}
+
+// v1UpsertSCRAMUser creates or updates the credential of the user named in the path.
+func (s *Server) v1UpsertSCRAMUser(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var req domain.UpsertSCRAMUserRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	req.Name = chi.URLParam(r, "userName")
+	if err := application.NewSCRAMService(s.clusters(r)).UpsertUser(clusterName, req); err != nil {
+		utils.Logger.Error("api upsert scram user failed", "cluster", clusterName, "user", req.Name, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1DeleteSCRAMUser(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	userName := chi.URLParam(r, "userName")
+	if err := application.NewSCRAMService(s.clusters(r)).DeleteUser(clusterName, userName, r.URL.Query().Get("mechanism")); err != nil {
+		utils.Logger.Error("api delete scram user failed", "cluster", clusterName, "user", userName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1ListQuotas(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	quotas, err := application.NewQuotaService(s.clusters(r)).ListQuotas(clusterName)
+	if err != nil {
+		utils.Logger.Error("api list quotas failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, quotas)
+}
+
+func (s *Server) v1AlterQuotas(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var req domain.AlterClientQuotaRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := application.NewQuotaService(s.clusters(r)).AlterQuotas(clusterName, req); err != nil {
+		utils.Logger.Error("api alter quotas failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,189 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var initI18n sync.Once
+
+type staticPasswords map[string]string
+
+func (p staticPasswords) Verify(username, password string) (domain.User, bool) {
+	if want, ok := p[username]; ok && want == password {
+		return domain.User{Name: username, Provider: "local"}, true
+	}
+	return domain.User{}, false
+}
+
+func (p staticPasswords) Lookup(username string) (domain.User, bool) {
+	if _, ok := p[username]; ok {
+		return domain.User{Name: username, Provider: "local"}, true
+	}
+	return domain.User{}, false
+}
+
+func newTestServer(t *testing.T, auth *application.AuthService) http.Handler {
+	t.Helper()
+	return newServer(t, auth).Handler()
+}
+
+func newServer(t *testing.T, auth *application.AuthService) *Server {
+	t.Helper()
+	return newServerWithRepository(t, newTestRepository(), auth)
+}
+
+func newServerWithRepository(t *testing.T, repo *testutil.FakeClusterRepository, auth *application.AuthService) *Server {
+	t.Helper()
+	utils.InitLogger()
+	initI18n.Do(config.InitI18n)
+	clusters := application.NewClusterService(repo)
+	topics := application.NewTopicService(clusters)
+	return New(clusters, topics, auth, application.NewTokenService(nil, clusters))
+}
+
+// newTestRepository returns the protected cluster dev with the topics payments and orders.
+func newTestRepository() *testutil.FakeClusterRepository {
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "dev", Brokers: []string{"b1"}, Protected: true}}
+	client := testutil.NewFakeKafkaClient()
+	client.Topics = map[string]int{"payments": 6, "orders": 3}
+	client.RecentRecords = 12
+	client.TopicDetail = &domain.TopicDetail{Name: "orders", Partitions: 3, ReplicationFactor: 1}
+	client.Internals = &domain.ClusterInternals{Mode: "kraft"}
+	repo.Clients["dev"] = client
+	return repo
+}
+
+func serve(h http.Handler, method, path, body string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeAPIError(t *testing.T, rec *httptest.ResponseRecorder) apiError {
+	t.Helper()
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var body apiErrorBody
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Error
+}
+
+func TestV1_JSONResponsesAndErrors(t *testing.T) {
+	t.Parallel()
+	h := newTestServer(t, nil)
+
+	rec := serve(h, http.MethodGet, "/api/v1/clusters/dev/topics", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var topics []domain.TopicSummary
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &topics))
+	require.Equal(t, []domain.TopicSummary{{Name: "orders", Partitions: 3}, {Name: "payments", Partitions: 6}}, topics)
+
+	// lists are empty arrays rather than null
+	rec = serve(h, http.MethodGet, "/api/v1/clusters/dev/acls", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, "[]", rec.Body.String())
+
+	rec = serve(h, http.MethodGet, "/api/v1/clusters/prod/topics", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, "not_found", decodeAPIError(t, rec).Code)
+
+	rec = serve(h, http.MethodPost, "/api/v1/clusters/dev/topics", `{"name":"audit","numPartitions":1}`, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, "invalid_request", decodeAPIError(t, rec).Code)
+
+	rec = serve(h, http.MethodPost, "/api/v1/clusters/dev/topics", `{"name":"audit","num_partitions":1,"replication_factor":1}`, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, "/api/v1/clusters/dev/topics/audit", rec.Header().Get("Location"))
+
+	// the protected cluster asks for confirmation, naming the header to send
+	rec = serve(h, http.MethodDelete, "/api/v1/clusters/dev/topics/orders?confirmation=orders", "")
+	require.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	apiErr := decodeAPIError(t, rec)
+	require.Equal(t, "confirmation_required", apiErr.Code)
+	require.Equal(t, map[string]any{"cluster": "dev", "header": confirmClusterHeader}, apiErr.Details)
+
+	// an unconfirmed topic deletion carries the deletion check
+	rec = serve(h, http.MethodDelete, "/api/v1/clusters/dev/topics/orders", "", "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusConflict, rec.Code)
+	apiErr = decodeAPIError(t, rec)
+	require.Equal(t, "conflict", apiErr.Code)
+	require.Equal(t, "orders", apiErr.Details.(map[string]any)["topic"])
+
+	// posting a cluster that exists does not replace it
+	rec = serve(h, http.MethodPost, "/api/v1/clusters", `{"name":"dev","brokers":["b2"]}`)
+	require.Equal(t, http.StatusConflict, rec.Code)
+	require.Equal(t, "conflict", decodeAPIError(t, rec).Code)
+
+	rec = serve(h, http.MethodGet, "/api/v1/brokers", "")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, "not_found", decodeAPIError(t, rec).Code)
+	rec = serve(h, http.MethodPatch, "/api/v1/clusters", "")
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	require.Equal(t, "method_not_allowed", decodeAPIError(t, rec).Code)
+
+	// fragments live under /ui
+	rec = serve(h, http.MethodGet, "/ui/clusters/dev/topics", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Header().Get("Content-Type"), "text/html")
+}
+
+func TestV1_ResetOffsets(t *testing.T) {
+	t.Parallel()
+	repo := newTestRepository()
+	client := repo.Clients["dev"].(*testutil.FakeKafkaClient)
+	client.OffsetResets = []domain.OffsetReset{{Topic: "orders", Partition: 0, From: 12, To: 0}}
+	h := newServerWithRepository(t, repo, nil).Handler()
+	path := "/api/v1/clusters/dev/consumer-groups/billing/offsets/reset"
+
+	// dry runs change nothing, so the protected cluster needs no confirmation
+	rec := serve(h, http.MethodPost, path, `{"topic":"orders","to":{"position":"earliest"},"dry_run":true}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `[{"topic":"orders","partition":0,"from":12,"to":0}]`, rec.Body.String())
+	require.Equal(t, &domain.ResetOffsetsRequest{Topic: "orders", To: domain.OffsetSpec{Position: domain.OffsetEarliest}, DryRun: true}, client.ResetRequest)
+
+	client.ResetRequest = nil
+	rec = serve(h, http.MethodPost, path, `{"topic":"orders","to":{"position":"earliest"}}`)
+	require.Equal(t, http.StatusPreconditionRequired, rec.Code)
+	require.Nil(t, client.ResetRequest)
+
+	rec = serve(h, http.MethodPost, path, `{"topic":"orders","to":{"position":"earliest"}}`, "X-Confirm-Cluster", "dev")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.False(t, client.ResetRequest.DryRun)
+}
+
+func TestV1_Unauthenticated(t *testing.T) {
+	t.Parallel()
+	h := newTestServer(t, application.NewAuthService([]domain.PasswordVerifier{staticPasswords{"alice": "s3cret"}}, nil, 0))
+
+	rec := serve(h, http.MethodGet, "/api/v1/clusters", "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	require.Equal(t, "unauthenticated", decodeAPIError(t, rec).Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	req.SetBasicAuth("alice", "s3cret")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// fragments answer 401 rather than redirecting into the page they are swapped into
+	rec = serve(h, http.MethodGet, "/ui/clusters/dev/topics", "")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = serve(h, http.MethodGet, "/clusters/dev", "")
+	require.Equal(t, http.StatusSeeOther, rec.Code)
+}
@@ -0,0 +1,77 @@
+package httpserver
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) v1ListTokens(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tokens, err := s.tokenService.ListTokens(user)
+	if err != nil {
+		utils.Logger.Error("api list tokens failed", "user", user.Name, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, tokens)
+}
+
+// v1CreateToken answers the created token with its secret, which is never shown again.
+func (s *Server) v1CreateToken(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req domain.CreateTokenRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	created, err := s.tokenService.CreateToken(user, req)
+	if err != nil {
+		utils.Logger.Error("api create token failed", "user", user.Name, "name", req.Name, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", mid.URL(r.Context(), apiV1Prefix+"/tokens/"+url.PathEscape(created.Token.ID)))
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) v1RevokeToken(w http.ResponseWriter, r *http.Request) {
+	user, err := s.tokenUser(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tokenID := chi.URLParam(r, "tokenID")
+	if err := s.tokenService.RevokeToken(user, tokenID); err != nil {
+		utils.Logger.Error("api revoke token failed", "user", user.Name, "id", tokenID, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseAuditFilter(r)
+	if err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	events, err := s.clusters(r).QueryAudit(filter)
+	if err != nil {
+		utils.Logger.Error("api list audit events failed", "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, events)
+}
@@ -0,0 +1,347 @@
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// maxTopicStateSize bounds the size of a desired topic state document
+const maxTopicStateSize = 1 << 20
+
+const (
+	defaultConsumeLimit   = 100
+	maxConsumeLimit       = 1000
+	defaultConsumeTimeout = 5 * time.Second
+	maxConsumeTimeout     = 30 * time.Second
+)
+
+func (s *Server) v1ListTopics(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "clusterName")
+	topics, err := s.topics(r).ListTopics(name, r.URL.Query().Get("internal") == "true")
+	if err != nil {
+		utils.Logger.Error("api list topics failed", "cluster", name, "err", err)
+		writeError(w, err)
+		return
+	}
+	out := make([]domain.TopicSummary, 0, len(topics))
+	for topic, partitions := range topics {
+		out = append(out, domain.TopicSummary{Name: topic, Partitions: partitions})
+	}
+	slices.SortFunc(out, func(a, b domain.TopicSummary) int { return strings.Compare(a.Name, b.Name) })
+	writeList(w, out)
+}
+
+func (s *Server) v1GetTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	detail, err := s.topics(r).GetTopicDetail(clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("api get topic detail failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, detail)
+}
+
+func (s *Server) v1CreateTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var req domain.CreateTopicRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := s.topics(r).CreateTopic(clusterName, req); err != nil {
+		utils.Logger.Error("api create topic failed", "cluster", clusterName, "topic", req.Name, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeCreated(w, r, apiV1Prefix+"/clusters/"+url.PathEscape(clusterName)+"/topics/"+url.PathEscape(req.Name))
+}
+
+// v1DeleteTopic deletes a topic once the confirmation query parameter repeats its name. Without it the
+// answer is 409 Conflict, with the deletion check in the error details.
+func (s *Server) v1DeleteTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+
+	req := domain.DeleteTopicRequest{Confirmation: r.URL.Query().Get("confirmation")}
+	if err := s.topics(r).DeleteTopic(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("api delete topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		var unconfirmed *application.TopicDeletionError
+		if errors.As(err, &unconfirmed) {
+			writeErrorDetails(w, err, unconfirmed.Check)
+			return
+		}
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1TopicDeletionCheck(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	check, err := s.topics(r).CheckTopicDeletion(clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("api topic deletion check failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, check)
+}
+
+func (s *Server) v1UpdateTopicConfig(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.UpdateTopicConfigRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := s.topics(r).UpdateTopicConfig(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("api update topic config failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1IncreasePartitions(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.IncreasePartitionsRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := s.topics(r).IncreasePartitions(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("api increase partitions failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1BatchTopics(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	var req domain.BatchTopicRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	results, err := s.topics(r).BatchTopics(clusterName, req)
+	if err != nil {
+		utils.Logger.Error("api batch topics failed", "cluster", clusterName, "action", req.Action, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, results)
+}
+
+func (s *Server) v1PreviewDeleteRecords(w http.ResponseWriter, r *http.Request) {
+	s.v1RecordsDeletion(w, r, (*application.TopicService).PreviewDeleteRecords)
+}
+
+func (s *Server) v1DeleteRecords(w http.ResponseWriter, r *http.Request) {
+	s.v1RecordsDeletion(w, r, (*application.TopicService).DeleteRecords)
+}
+
+func (s *Server) v1RecordsDeletion(w http.ResponseWriter, r *http.Request, run func(*application.TopicService, string, string, domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error)) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.DeleteRecordsRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	results, err := run(s.topics(r), clusterName, topicName, req)
+	if err != nil {
+		utils.Logger.Error("api delete records failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, results)
+}
+
+func (s *Server) v1ListQuarantinedTopics(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topics, err := s.topics(r).ListQuarantinedTopics(clusterName)
+	if err != nil {
+		utils.Logger.Error("api list quarantined topics failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, topics)
+}
+
+func (s *Server) v1QuarantineTopic(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.QuarantineTopicRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := s.topics(r).QuarantineTopic(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("api quarantine topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1ReleaseTopicQuarantine(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	if err := s.topics(r).ReleaseTopicQuarantine(clusterName, topicName); err != nil {
+		utils.Logger.Error("api release topic quarantine failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) v1ProduceMessage(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.MessageRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	m := domain.Message{Key: []byte(req.Key), Value: []byte(req.Value)}
+	if err := s.topics(r).WriteMessage(clusterName, topicName, m); err != nil {
+		utils.Logger.Error("api write message failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// v1ConsumeMessages waits for new messages on a topic and answers once limit messages arrived or
+// the timeout passed. Keys and values are base64 encoded.
+func (s *Server) v1ConsumeMessages(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	limit, timeout, err := parseConsumeParams(r)
+	if err != nil {
+		writeErrorStatus(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), timeout)
+	defer cancel()
+	msgs := make(chan domain.Message, limit)
+	done := make(chan error, 1)
+	go func() { done <- s.topics(r).StreamMessages(ctx, clusterName, topicName, msgs) }()
+
+	out := make([]domain.Message, 0, limit)
+collect:
+	for len(out) < limit {
+		select {
+		case m := <-msgs:
+			out = append(out, m)
+		case err := <-done:
+			if err != nil {
+				utils.Logger.Error("api consume messages failed", "cluster", clusterName, "topic", topicName, "err", err)
+				writeError(w, err)
+				return
+			}
+			out = drainMessages(out, msgs, limit)
+			break collect
+		case <-ctx.Done():
+			break collect
+		case <-s.streams.done:
+			break collect
+		}
+	}
+	writeList(w, out)
+}
+
+// drainMessages appends the messages already received once the stream ended.
+func drainMessages(out []domain.Message, msgs <-chan domain.Message, limit int) []domain.Message {
+	for len(out) < limit {
+		select {
+		case m := <-msgs:
+			out = append(out, m)
+		default:
+			return out
+		}
+	}
+	return out
+}
+
+func parseConsumeParams(r *http.Request) (int, time.Duration, error) {
+	q := r.URL.Query()
+	limit, timeout := defaultConsumeLimit, defaultConsumeTimeout
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return 0, 0, errors.New("limit must be a positive number")
+		}
+		limit = min(n, maxConsumeLimit)
+	}
+	if v := q.Get("timeout"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return 0, 0, errors.New("timeout must be a positive duration such as 5s")
+		}
+		timeout = min(d, maxConsumeTimeout)
+	}
+	return limit, timeout, nil
+}
+
+func (s *Server) v1ListTopicTemplates(w http.ResponseWriter, r *http.Request) {
+	writeList(w, s.topics(r).ListTopicTemplates())
+}
+
+func (s *Server) v1PlanTopics(w http.ResponseWriter, r *http.Request) {
+	state, err := readDesiredTopicState(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	plans, err := application.NewTopicPlanService(s.clusters(r), s.topics(r)).Plan(state, r.URL.Query().Get("delete") == "true")
+	if err != nil {
+		utils.Logger.Error("api plan topics failed", "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, plans)
+}
+
+func (s *Server) v1ApplyTopics(w http.ResponseWriter, r *http.Request) {
+	state, err := readDesiredTopicState(w, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	for clusterName := range state.Clusters {
+		if !s.clusterConfirmed(r, clusterName) {
+			writeConfirmationRequired(w, r, clusterName)
+			return
+		}
+	}
+	results, err := application.NewTopicPlanService(s.clusters(r), s.topics(r)).Apply(state, r.URL.Query().Get("delete") == "true")
+	if err != nil {
+		utils.Logger.Error("api apply topics failed", "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, results)
+}
+
+// readDesiredTopicState reads a desired topic state document, in YAML or JSON, from the request body.
+func readDesiredTopicState(w http.ResponseWriter, r *http.Request) (domain.DesiredTopicState, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTopicStateSize))
+	if err != nil {
+		return domain.DesiredTopicState{}, fmt.Errorf("%w: %v", application.ErrInvalidTopicState, err)
+	}
+	return application.ParseDesiredTopicState(body)
+}
@@ -0,0 +1,48 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+func (s *Server) v1ListTransactions(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	txns, err := application.NewTransactionService(s.clusters(r)).ListTransactions(clusterName)
+	if err != nil {
+		utils.Logger.Error("api list transactions failed", "cluster", clusterName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, txns)
+}
+
+func (s *Server) v1ListTopicProducers(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	producers, err := application.NewTransactionService(s.clusters(r)).ListProducers(clusterName, topicName)
+	if err != nil {
+		utils.Logger.Error("api list topic producers failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	writeList(w, producers)
+}
+
+func (s *Server) v1AbortTransaction(w http.ResponseWriter, r *http.Request) {
+	clusterName := chi.URLParam(r, "clusterName")
+	topicName := chi.URLParam(r, "topicName")
+	var req domain.AbortTransactionRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	if err := application.NewTransactionService(s.clusters(r)).AbortTransaction(clusterName, topicName, req); err != nil {
+		utils.Logger.Error("api abort transaction failed", "cluster", clusterName, "topic", topicName, "err", err)
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"context"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/OliveiraNt/maned-scout/internal/adapters/http/mid"
 	"github.com/OliveiraNt/maned-scout/internal/adapters/http/ui/templates/pages"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
@@ -12,9 +16,20 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-var wsUpgrader = websocket.Upgrader{
-	// TODO: tighten CORS/origin as needed. For now allow all to simplify local usage.
-	CheckOrigin: func(_ *http.Request) bool { return true },
+var wsUpgrader = websocket.Upgrader{CheckOrigin: sameOrigin}
+
+// sameOrigin refuses WebSocket connections opened by pages of other sites, which the browser would send the
+// session cookie with. Clients other than browsers send no Origin and are let through.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, requestHost(r))
 }
 
 // wsStreamTopic upgrades to WebSocket and streams Kafka messages from the given topic to the client.
@@ -22,6 +37,10 @@ var wsUpgrader = websocket.Upgrader{
 func (s *Server) wsStreamTopic(w http.ResponseWriter, r *http.Request) {
 	clusterName := chi.URLParam(r, "clusterName")
 	topicName := chi.URLParam(r, "topicName")
+	if !mid.Can(r.Context(), domain.ActionConsume, clusterName, domain.TopicResource(topicName)) {
+		http.Error(w, "permission denied", http.StatusForbidden)
+		return
+	}
 
 	conn, err := wsUpgrader.Upgrade(w, r, nil)
 	if err != nil {
@@ -29,6 +48,12 @@ func (s *Server) wsStreamTopic(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "websocket upgrade failed", http.StatusBadRequest)
 		return
 	}
+	if !s.streams.add() {
+		closeGoingAway(conn)
+		_ = conn.Close()
+		return
+	}
+	defer s.streams.release()
 	defer func(conn *websocket.Conn) {
 		err := conn.Close()
 		if err != nil {
@@ -52,7 +77,7 @@ func (s *Server) wsStreamTopic(w http.ResponseWriter, r *http.Request) {
 			utils.Logger.Info("consumer goroutine stopping", "cluster", clusterName, "topic", topicName)
 			cancel()
 		}()
-		if err := s.topicService.StreamMessages(ctx, clusterName, topicName, msgs); err != nil {
+		if err := s.topics(r).StreamMessages(ctx, clusterName, topicName, msgs); err != nil {
 			utils.Logger.Error("stream messages failed", "cluster", clusterName, "topic", topicName, "err", err)
 		}
 		utils.Logger.Info("stream stopped", "cluster", clusterName, "topic", topicName)
@@ -77,6 +102,10 @@ func (s *Server) wsStreamTopic(w http.ResponseWriter, r *http.Request) {
 		select {
 		case <-ctx.Done():
 			return
+		case <-s.streams.done:
+			utils.Logger.Info("closing websocket stream for shutdown", "cluster", clusterName, "topic", topicName)
+			closeGoingAway(conn)
+			return
 		case m, ok := <-msgs:
 			if !ok {
 				utils.Logger.Info("message channel closed", "cluster", clusterName, "topic", topicName)
@@ -96,3 +125,11 @@ func (s *Server) wsStreamTopic(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// closeGoingAway tells the client the server is going away, so it can reconnect to another instance.
+func closeGoingAway(conn *websocket.Conn) {
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	if err := conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second)); err != nil {
+		utils.Logger.Debug("websocket close frame failed", "err", err)
+	}
+}
@@ -0,0 +1,378 @@
+package tui
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// clustersScreen lists the configured clusters with their status and statistics.
+type clustersScreen struct {
+	svc  services
+	list *table
+}
+
+func newClustersScreen(svc services) *clustersScreen {
+	s := &clustersScreen{svc: svc, list: newTable("NAME", "STATUS", "MODE", "TOPICS", "PARTITIONS", "GROUPS", "BROKERS")}
+	s.list.styles = func(row []string, col int) lipgloss.Style {
+		if col == 1 {
+			return statusStyle(row[1])
+		}
+		return plainStyle
+	}
+	return s
+}
+
+func (s *clustersScreen) title() string { return "clusters" }
+func (s *clustersScreen) table() *table { return s.list }
+func (s *clustersScreen) help() string  { return "enter open" }
+
+func (s *clustersScreen) load() tea.Cmd {
+	return fetch(s, func() (func(), error) {
+		var rows [][]string
+		for _, cfg := range s.svc.clusters.ListClusters() {
+			cluster, stats, err := s.svc.clusters.GetClusterInfo(cfg.Name)
+			if err != nil {
+				continue
+			}
+			status, mode := "offline", "read-write"
+			if cluster.IsOnline {
+				status = "online"
+			}
+			switch {
+			case cluster.ReadOnly:
+				mode = "read-only"
+			case cluster.Protected:
+				mode = "protected"
+			}
+			topics, partitions, groups := "-", "-", "-"
+			if stats != nil {
+				topics = strconv.Itoa(stats.TotalTopics)
+				partitions = strconv.Itoa(stats.TotalPartitions)
+				groups = strconv.Itoa(stats.TotalConsumerGroups)
+			}
+			rows = append(rows, []string{cluster.Name, status, mode, topics, partitions, groups, strings.Join(cluster.Brokers, ",")})
+		}
+		return func() { s.list.setRows(rows) }, nil
+	})
+}
+
+func (s *clustersScreen) update(key string) tea.Cmd {
+	if row := s.list.selected(); key == "enter" && row != nil {
+		return push(newClusterScreen(s.svc, row[0]))
+	}
+	return nil
+}
+
+func (s *clustersScreen) view(width, height int) string {
+	return s.list.view(width, height)
+}
+
+// clusterScreen lists the topics and the consumer groups of a cluster, in two tabs.
+type clusterScreen struct {
+	svc      services
+	cluster  string
+	tab      int
+	internal bool
+	topics   *table
+	groups   *table
+}
+
+func newClusterScreen(svc services, cluster string) *clusterScreen {
+	return &clusterScreen{
+		svc:     svc,
+		cluster: cluster,
+		topics:  newTable("TOPIC", "PARTITIONS"),
+		groups:  newGroupsTable(),
+	}
+}
+
+func (s *clusterScreen) title() string { return s.cluster }
+
+func (s *clusterScreen) table() *table {
+	if s.tab == 0 {
+		return s.topics
+	}
+	return s.groups
+}
+
+func (s *clusterScreen) help() string {
+	return "enter open  tab switch  i internal topics"
+}
+
+func (s *clusterScreen) load() tea.Cmd {
+	return tea.Batch(
+		fetch(s, func() (func(), error) {
+			topics, err := s.svc.topics.ListTopics(s.cluster, s.internal)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, 0, len(topics))
+			for name, partitions := range topics {
+				rows = append(rows, []string{name, strconv.Itoa(partitions)})
+			}
+			slices.SortFunc(rows, func(a, b []string) int { return cmp.Compare(a[0], b[0]) })
+			return func() { s.topics.setRows(rows) }, nil
+		}),
+		fetchGroups(s, s.svc, s.cluster, "", s.groups),
+	)
+}
+
+func (s *clusterScreen) update(key string) tea.Cmd {
+	switch key {
+	case "tab", "shift+tab", "left", "right", "h", "l":
+		s.tab = 1 - s.tab
+	case "i":
+		s.internal = !s.internal
+		return s.load()
+	case "enter":
+		row := s.table().selected()
+		if row == nil {
+			return nil
+		}
+		if s.tab == 0 {
+			return push(newTopicScreen(s.svc, s.cluster, row[0]))
+		}
+		return push(newGroupScreen(s.svc, s.cluster, row[0]))
+	}
+	return nil
+}
+
+func (s *clusterScreen) view(width, height int) string {
+	return tabs([]string{fmt.Sprintf("Topics (%d)", len(s.topics.rows)), fmt.Sprintf("Consumer groups (%d)", len(s.groups.rows))}, s.tab) +
+		"\n" + s.table().view(width, height-1)
+}
+
+// topicScreen shows the partitions of a topic and the consumer groups reading it.
+type topicScreen struct {
+	svc        services
+	cluster    string
+	topic      string
+	tab        int
+	detail     *domain.TopicDetail
+	partitions *table
+	groups     *table
+}
+
+func newTopicScreen(svc services, cluster, topic string) *topicScreen {
+	s := &topicScreen{
+		svc:        svc,
+		cluster:    cluster,
+		topic:      topic,
+		partitions: newTable("PARTITION", "LEADER", "REPLICAS", "ISR", "STATUS"),
+		groups:     newGroupsTable(),
+	}
+	s.partitions.styles = func(row []string, col int) lipgloss.Style {
+		if col == 4 {
+			return statusStyle(row[4])
+		}
+		return plainStyle
+	}
+	return s
+}
+
+func (s *topicScreen) title() string { return s.topic }
+
+func (s *topicScreen) table() *table {
+	if s.tab == 0 {
+		return s.partitions
+	}
+	return s.groups
+}
+
+func (s *topicScreen) help() string { return "t tail messages  tab switch  enter open group" }
+
+func (s *topicScreen) load() tea.Cmd {
+	return tea.Batch(
+		fetch(s, func() (func(), error) {
+			detail, err := s.svc.topics.GetTopicDetail(s.cluster, s.topic)
+			if err != nil {
+				return nil, err
+			}
+			rows := make([][]string, 0, len(detail.PartitionDetails))
+			for _, p := range detail.PartitionDetails {
+				status := "online"
+				switch {
+				case p.Offline:
+					status = "offline"
+				case len(p.ISR) < len(p.Replicas):
+					status = "under-replicated"
+				}
+				rows = append(rows, []string{strconv.Itoa(int(p.Partition)), strconv.Itoa(int(p.Leader)), joinIDs(p.Replicas), joinIDs(p.ISR), status})
+			}
+			return func() { s.detail = detail; s.partitions.setRows(rows) }, nil
+		}),
+		fetchGroups(s, s.svc, s.cluster, s.topic, s.groups),
+	)
+}
+
+func (s *topicScreen) update(key string) tea.Cmd {
+	switch key {
+	case "tab", "shift+tab", "left", "right", "h", "l":
+		s.tab = 1 - s.tab
+	case "t":
+		return push(newTailScreen(s.svc, s.cluster, s.topic))
+	case "enter":
+		if row := s.groups.selected(); s.tab == 1 && row != nil {
+			return push(newGroupScreen(s.svc, s.cluster, row[0]))
+		}
+	}
+	return nil
+}
+
+func (s *topicScreen) view(width, height int) string {
+	summary := dimStyle.Render("loading…")
+	if s.detail != nil {
+		summary = fmt.Sprintf("%d partitions, replication factor %d", s.detail.Partitions, s.detail.ReplicationFactor)
+	}
+	return summary + "\n" +
+		tabs([]string{"Partitions", fmt.Sprintf("Consumer groups (%d)", len(s.groups.rows))}, s.tab) + "\n" +
+		s.table().view(width, height-2)
+}
+
+// groupScreen shows the members of a consumer group and its lag on every partition.
+type groupScreen struct {
+	svc     services
+	cluster string
+	name    string
+	group   *domain.ConsumerGroup
+	lag     *table
+}
+
+func newGroupScreen(svc services, cluster, group string) *groupScreen {
+	s := &groupScreen{svc: svc, cluster: cluster, name: group, lag: newTable("TOPIC", "PARTITION", "COMMITTED", "END", "LAG", "MEMBER")}
+	s.lag.styles = func(row []string, col int) lipgloss.Style {
+		if col == 4 {
+			return lagStyle(row[4])
+		}
+		return plainStyle
+	}
+	return s
+}
+
+func (s *groupScreen) title() string         { return s.name }
+func (s *groupScreen) table() *table         { return s.lag }
+func (s *groupScreen) help() string          { return "" }
+func (s *groupScreen) update(string) tea.Cmd { return nil }
+
+func (s *groupScreen) load() tea.Cmd {
+	return fetch(s, func() (func(), error) {
+		lag, err := s.svc.groups.FetchConsumerGroupWithLag(context.Background(), s.cluster, s.name)
+		if err != nil {
+			return nil, err
+		}
+		if lag.Group == "" {
+			return nil, errors.New("consumer group " + s.name + " not found")
+		}
+		group := domain.NewConsumerGroup(lag)
+		rows := make([][]string, 0, len(group.Lag))
+		for _, l := range group.Lag {
+			rows = append(rows, []string{l.Topic, strconv.Itoa(int(l.Partition)), offset(l.CommittedOffset), offset(l.EndOffset), offset(l.Lag), l.MemberID})
+		}
+		return func() { s.group = &group; s.lag.setRows(rows) }, nil
+	})
+}
+
+func (s *groupScreen) view(width, height int) string {
+	if s.group == nil {
+		return dimStyle.Render("loading…")
+	}
+	g := s.group
+	var b strings.Builder
+	fmt.Fprintf(&b, "state %s  protocol %s  coordinator %d  total lag %s\n", statusStyle(g.State).Render(g.State), cmp.Or(g.Protocol, "-"), g.Coordinator, lagStyle(offset(g.TotalLag)).Render(offset(g.TotalLag)))
+	lines := 1
+	if len(g.Members) == 0 {
+		b.WriteString(dimStyle.Render("no active members") + "\n")
+		lines++
+	}
+	for _, m := range g.Members {
+		fmt.Fprintf(&b, "%s %s %s\n", dimStyle.Render("member"), m.ClientID, dimStyle.Render(m.ClientHost))
+		lines++
+	}
+	return b.String() + s.lag.view(width, height-lines)
+}
+
+func newGroupsTable() *table {
+	t := newTable("GROUP", "STATE", "MEMBERS", "LAG")
+	t.styles = func(row []string, col int) lipgloss.Style {
+		switch col {
+		case 1:
+			return statusStyle(row[1])
+		case 3:
+			return lagStyle(row[3])
+		}
+		return plainStyle
+	}
+	return t
+}
+
+// fetchGroups loads into list the consumer groups of a cluster, or only those reading topic with their lag on it.
+func fetchGroups(target screen, svc services, cluster, topic string, list *table) tea.Cmd {
+	return fetch(target, func() (func(), error) {
+		lags, err := svc.groups.ListConsumerGroupsWithLagFromTopic(context.Background(), cluster, topic)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([][]string, 0, len(lags))
+		for _, l := range lags {
+			g := domain.NewConsumerGroup(l)
+			lag := g.TotalLag
+			if topic != "" {
+				lag = 0
+				for _, p := range g.Lag {
+					if p.Topic == topic && p.Lag > 0 {
+						lag += p.Lag
+					}
+				}
+			}
+			rows = append(rows, []string{g.GroupID, g.State, strconv.Itoa(len(g.Members)), offset(lag)})
+		}
+		slices.SortFunc(rows, func(a, b []string) int { return cmp.Compare(a[0], b[0]) })
+		return func() { list.setRows(rows) }, nil
+	})
+}
+
+// statusStyle colors a cluster, partition or group state by its health.
+func statusStyle(status string) lipgloss.Style {
+	switch strings.ToLower(status) {
+	case "online", "stable":
+		return okStyle
+	case "offline", "dead":
+		return errorStyle
+	case "under-replicated", "preparingrebalance", "completingrebalance":
+		return warnStyle
+	}
+	return plainStyle
+}
+
+// lagStyle highlights a positive lag.
+func lagStyle(lag string) lipgloss.Style {
+	if n, err := strconv.ParseInt(lag, 10, 64); err == nil && n > 0 {
+		return warnStyle
+	}
+	return plainStyle
+}
+
+// offset formats an offset or a lag, which are -1 when unknown.
+func offset(n int64) string {
+	if n < 0 {
+		return "-"
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+func joinIDs(ids []int32) string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = strconv.Itoa(int(id))
+	}
+	return strings.Join(out, ",")
+}
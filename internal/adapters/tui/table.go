@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// table is a scrollable list of rows with a cursor, narrowed down by a filter typed after /.
+type table struct {
+	headers []string
+	rows    [][]string
+	// styles colors single cells; it may be nil.
+	styles func(row []string, col int) lipgloss.Style
+	cursor int
+	offset int
+	filter string
+}
+
+func newTable(headers ...string) *table {
+	return &table{headers: headers}
+}
+
+// setRows replaces the rows, keeping the cursor on the same first cell when it is still listed.
+func (t *table) setRows(rows [][]string) {
+	selected := t.selected()
+	t.rows = rows
+	t.cursor = 0
+	if selected == nil {
+		return
+	}
+	for i, r := range t.visible() {
+		if r[0] == selected[0] {
+			t.cursor = i
+			return
+		}
+	}
+}
+
+// visible returns the rows matching the filter.
+func (t *table) visible() [][]string {
+	if t.filter == "" {
+		return t.rows
+	}
+	needle := strings.ToLower(t.filter)
+	var out [][]string
+	for _, r := range t.rows {
+		if strings.Contains(strings.ToLower(strings.Join(r, " ")), needle) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// selected returns the row under the cursor, or nil when no row is listed.
+func (t *table) selected() []string {
+	rows := t.visible()
+	if t.cursor < 0 || t.cursor >= len(rows) {
+		return nil
+	}
+	return rows[t.cursor]
+}
+
+// setFilter narrows the rows down and moves the cursor back to the first one.
+func (t *table) setFilter(filter string) {
+	t.filter = filter
+	t.cursor, t.offset = 0, 0
+}
+
+// move handles the navigation keys and reports whether key was one of them.
+func (t *table) move(key string, height int) bool {
+	n := len(t.visible())
+	switch key {
+	case "up", "k":
+		t.cursor--
+	case "down", "j":
+		t.cursor++
+	case "pgup":
+		t.cursor -= max(height-1, 1)
+	case "pgdown":
+		t.cursor += max(height-1, 1)
+	case "home", "g":
+		t.cursor = 0
+	case "end", "G":
+		t.cursor = n - 1
+	default:
+		return false
+	}
+	t.cursor = max(min(t.cursor, n-1), 0)
+	return true
+}
+
+// view renders the header and as many rows as fit in height lines, scrolled to keep the cursor visible.
+func (t *table) view(width, height int) string {
+	rows := t.visible()
+	widths := make([]int, len(t.headers))
+	for i, h := range t.headers {
+		widths[i] = lipgloss.Width(h)
+	}
+	for _, r := range rows {
+		for i := range min(len(r), len(widths)) {
+			widths[i] = max(widths[i], lipgloss.Width(r[i]))
+		}
+	}
+
+	page := max(height-1, 1)
+	if t.cursor < t.offset {
+		t.offset = t.cursor
+	}
+	if t.cursor >= t.offset+page {
+		t.offset = t.cursor - page + 1
+	}
+	t.offset = max(min(t.offset, len(rows)-page), 0)
+
+	var b strings.Builder
+	b.WriteString(headerStyle.Render(ansi.Truncate(t.line(t.headers, widths, nil), width, "…")))
+	if len(rows) == 0 {
+		b.WriteString("\n" + dimStyle.Render("  nothing to show"))
+	}
+	for i := t.offset; i < min(t.offset+page, len(rows)); i++ {
+		line := t.line(rows[i], widths, t.styles)
+		if i == t.cursor {
+			line = selectedStyle.Render(ansi.Strip(line) + strings.Repeat(" ", max(width-lipgloss.Width(line), 0)))
+		}
+		b.WriteString("\n" + ansi.Truncate(line, width, "…"))
+	}
+	return b.String()
+}
+
+func (t *table) line(cells []string, widths []int, styles func([]string, int) lipgloss.Style) string {
+	var b strings.Builder
+	b.WriteString("  ")
+	for i, w := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		padded := cell + strings.Repeat(" ", w-lipgloss.Width(cell))
+		if styles != nil {
+			padded = styles(cells, i).Render(padded)
+		}
+		b.WriteString(padded)
+		if i < len(widths)-1 {
+			b.WriteString("  ")
+		}
+	}
+	return b.String()
+}
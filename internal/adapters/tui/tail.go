@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// tailLimit is the number of messages a tail keeps on screen.
+const tailLimit = 1000
+
+// tailMsg carries a message read by a tail, or the error that stopped it.
+type tailMsg struct {
+	target  *tailScreen
+	message domain.Message
+	err     error
+	done    bool
+}
+
+// tailScreen shows the messages produced to a topic from the moment it is opened.
+type tailScreen struct {
+	svc      services
+	cluster  string
+	topic    string
+	messages []domain.Message
+	paused   bool
+	stopped  error
+	started  bool
+	cancel   context.CancelFunc
+	msgs     chan domain.Message
+	done     chan error
+}
+
+func newTailScreen(svc services, cluster, topic string) *tailScreen {
+	return &tailScreen{svc: svc, cluster: cluster, topic: topic}
+}
+
+func (s *tailScreen) title() string { return "tail" }
+func (s *tailScreen) table() *table { return nil }
+
+func (s *tailScreen) help() string {
+	if s.paused {
+		return "space resume  c clear"
+	}
+	return "space pause  c clear"
+}
+
+// load starts reading the topic the first time the screen is shown.
+func (s *tailScreen) load() tea.Cmd {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.msgs = make(chan domain.Message)
+	s.done = make(chan error, 1)
+	req := domain.ConsumeRequest{From: domain.OffsetSpec{Position: domain.OffsetLatest}, Partition: -1}
+	go func() {
+		s.done <- s.svc.topics.ConsumeMessages(ctx, s.cluster, s.topic, req, s.msgs)
+	}()
+	return s.next()
+}
+
+// next waits for the following message.
+func (s *tailScreen) next() tea.Cmd {
+	msgs, done := s.msgs, s.done
+	return func() tea.Msg {
+		select {
+		case m := <-msgs:
+			return tailMsg{target: s, message: m}
+		case err := <-done:
+			return tailMsg{target: s, err: err, done: true}
+		}
+	}
+}
+
+// receive stores a message read by the tail and waits for the next one.
+func (s *tailScreen) receive(msg tailMsg) tea.Cmd {
+	if msg.done {
+		s.stopped = msg.err
+		if s.stopped == nil {
+			s.stopped = context.Canceled
+		}
+		return nil
+	}
+	if !s.paused {
+		s.messages = append(s.messages, msg.message)
+		if over := len(s.messages) - tailLimit; over > 0 {
+			s.messages = s.messages[over:]
+		}
+	}
+	return s.next()
+}
+
+func (s *tailScreen) close() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+func (s *tailScreen) update(key string) tea.Cmd {
+	switch key {
+	case " ", "space", "p":
+		s.paused = !s.paused
+	case "c":
+		s.messages = nil
+	}
+	return nil
+}
+
+func (s *tailScreen) view(width, height int) string {
+	status := okStyle.Render("following " + s.topic)
+	switch {
+	case s.stopped != nil:
+		status = errorStyle.Render("stopped: " + s.stopped.Error())
+	case s.paused:
+		status = "paused"
+	}
+	lines := []string{fmt.Sprintf("%s  %s", status, dimStyle.Render(fmt.Sprintf("%d messages", len(s.messages))))}
+
+	shown := s.messages[max(len(s.messages)-(height-1), 0):]
+	if len(shown) == 0 {
+		lines = append(lines, dimStyle.Render("  waiting for new messages…"))
+	}
+	for _, m := range shown {
+		key := string(m.Key)
+		if key == "" {
+			key = "-"
+		}
+		line := fmt.Sprintf("%s %s %s %s",
+			dimStyle.Render(m.Timestamp.Format(time.TimeOnly)),
+			dimStyle.Render(fmt.Sprintf("p%d@%d", m.Partition, m.Offset)),
+			warnStyle.Render(oneLine(key)),
+			oneLine(string(m.Value)))
+		lines = append(lines, ansi.Truncate(line, width, "…"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// oneLine keeps a key or value on a single line of the screen.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,277 @@
+// Package tui provides a full-screen terminal interface to browse the clusters, topics and consumer groups,
+// follow their lag and tail messages, for hosts where no browser is at hand. It goes through the same
+// application services as the web interface.
+package tui
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// refreshInterval is how often the screen on top reloads its data, so lag and statistics stay current.
+const refreshInterval = 5 * time.Second
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("230")).Background(lipgloss.Color("62")).Padding(0, 1)
+	tabStyle      = lipgloss.NewStyle().Padding(0, 1).Foreground(lipgloss.Color("245"))
+	activeTab     = lipgloss.NewStyle().Padding(0, 1).Bold(true).Underline(true)
+	headerStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("245"))
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	dimStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+	okStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	warnStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("203"))
+	plainStyle    = lipgloss.NewStyle()
+)
+
+// screen is one page of the interface. Screens are stacked: enter pushes one, esc goes back to the previous one.
+type screen interface {
+	// title names the screen in the breadcrumb.
+	title() string
+	// load fetches the data of the screen; it is called when the screen is shown and on every refresh.
+	load() tea.Cmd
+	// update handles the keys the interface does not handle itself.
+	update(key string) tea.Cmd
+	// view renders the screen in the given size.
+	view(width, height int) string
+	// help lists the keys of the screen for the footer.
+	help() string
+	// table returns the table the filter applies to, if any.
+	table() *table
+}
+
+// closer is implemented by screens holding resources to release when they are left.
+type closer interface {
+	close()
+}
+
+// pushMsg shows a new screen on top of the current one.
+type pushMsg struct{ screen screen }
+
+// loadedMsg carries data fetched for a screen; apply stores it in the screen.
+type loadedMsg struct {
+	target screen
+	apply  func()
+	err    error
+}
+
+type tickMsg struct{}
+
+func push(s screen) tea.Cmd {
+	return func() tea.Msg { return pushMsg{s} }
+}
+
+// fetch runs f outside of the update loop and applies its result to target once done.
+func fetch(target screen, f func() (func(), error)) tea.Cmd {
+	return func() tea.Msg {
+		apply, err := f()
+		return loadedMsg{target: target, apply: apply, err: err}
+	}
+}
+
+// Model is the state of the terminal interface.
+type Model struct {
+	services  services
+	stack     []screen
+	width     int
+	height    int
+	filtering bool
+	err       error
+	refresh   time.Duration
+}
+
+// services are the application services the screens go through.
+type services struct {
+	clusters *application.ClusterService
+	topics   *application.TopicService
+	groups   *application.ConsumerGroupsService
+}
+
+// New creates the terminal interface, starting on the list of clusters.
+func New(clusterService *application.ClusterService) *Model {
+	svc := services{
+		clusters: clusterService,
+		topics:   application.NewTopicService(clusterService),
+		groups:   application.NewConsumerGroupsService(clusterService),
+	}
+	return &Model{services: svc, stack: []screen{newClustersScreen(svc)}, width: 80, height: 24, refresh: refreshInterval}
+}
+
+// Run shows the terminal interface until the user quits.
+func Run(clusterService *application.ClusterService) error {
+	_, err := tea.NewProgram(New(clusterService), tea.WithAltScreen()).Run()
+	return err
+}
+
+func (m *Model) top() screen {
+	return m.stack[len(m.stack)-1]
+}
+
+func (m *Model) tick() tea.Cmd {
+	if m.refresh <= 0 {
+		return nil
+	}
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return tickMsg{} })
+}
+
+// Init loads the first screen and starts the refresh timer.
+func (m *Model) Init() tea.Cmd {
+	return tea.Batch(m.top().load(), m.tick())
+}
+
+// Update handles a message.
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+	case tickMsg:
+		return m, tea.Batch(m.top().load(), m.tick())
+	case pushMsg:
+		m.stack = append(m.stack, msg.screen)
+		m.err = nil
+		return m, msg.screen.load()
+	case loadedMsg:
+		if !slices.Contains(m.stack, msg.target) {
+			return m, nil
+		}
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		if msg.target == m.top() {
+			m.err = nil
+		}
+		msg.apply()
+	case tailMsg:
+		if !slices.Contains(m.stack, screen(msg.target)) {
+			return m, nil
+		}
+		return m, msg.target.receive(msg)
+	case tea.KeyMsg:
+		return m, m.key(msg.String())
+	}
+	return m, nil
+}
+
+func (m *Model) key(key string) tea.Cmd {
+	if key == "ctrl+c" {
+		m.closeAll()
+		return tea.Quit
+	}
+	if t := m.top().table(); m.filtering && t != nil {
+		switch key {
+		case "enter":
+			m.filtering = false
+		case "esc":
+			m.filtering = false
+			t.setFilter("")
+		case "backspace":
+			if r := []rune(t.filter); len(r) > 0 {
+				t.setFilter(string(r[:len(r)-1]))
+			}
+		default:
+			if r := []rune(key); len(r) == 1 {
+				t.setFilter(t.filter + key)
+			}
+		}
+		return nil
+	}
+
+	switch key {
+	case "q":
+		m.closeAll()
+		return tea.Quit
+	case "esc", "backspace":
+		if t := m.top().table(); t != nil && t.filter != "" {
+			t.setFilter("")
+			return nil
+		}
+		if len(m.stack) > 1 {
+			if c, ok := m.top().(closer); ok {
+				c.close()
+			}
+			m.stack = m.stack[:len(m.stack)-1]
+			m.err = nil
+			return m.top().load()
+		}
+		return nil
+	case "r":
+		return m.top().load()
+	case "/":
+		if m.top().table() != nil {
+			m.filtering = true
+		}
+		return nil
+	}
+	if t := m.top().table(); t != nil && t.move(key, m.bodyHeight()) {
+		return nil
+	}
+	return m.top().update(key)
+}
+
+func (m *Model) closeAll() {
+	for _, s := range m.stack {
+		if c, ok := s.(closer); ok {
+			c.close()
+		}
+	}
+}
+
+// bodyHeight is the number of lines left to the screen between the title and the footer.
+func (m *Model) bodyHeight() int {
+	return max(m.height-3, 1)
+}
+
+// View renders the interface.
+func (m *Model) View() string {
+	titles := make([]string, len(m.stack))
+	for i, s := range m.stack {
+		titles[i] = s.title()
+	}
+	header := titleStyle.Render("maned-scout") + " " + strings.Join(titles, dimStyle.Render(" › "))
+
+	body := m.top().view(m.width, m.bodyHeight())
+	lines := strings.Split(body, "\n")
+	if len(lines) > m.bodyHeight() {
+		lines = lines[:m.bodyHeight()]
+	}
+	for len(lines) < m.bodyHeight() {
+		lines = append(lines, "")
+	}
+
+	t := m.top().table()
+	keys := []string{m.top().help()}
+	if t != nil {
+		keys = append(keys, "/ filter")
+	}
+	keys = append(keys, "r refresh", "esc back", "q quit")
+	footer := dimStyle.Render(strings.Join(slices.DeleteFunc(keys, func(k string) bool { return k == "" }), "  "))
+	switch {
+	case m.err != nil:
+		footer = errorStyle.Render("error: " + m.err.Error())
+	case t != nil && (m.filtering || t.filter != ""):
+		footer = "/" + t.filter
+		if m.filtering {
+			footer += "█"
+		}
+	}
+	return header + "\n" + strings.Join(lines, "\n") + "\n" + footer
+}
+
+// tabs renders the tab names with the active one highlighted.
+func tabs(names []string, active int) string {
+	out := make([]string, len(names))
+	for i, n := range names {
+		if i == active {
+			out[i] = activeTab.Render(n)
+		} else {
+			out[i] = tabStyle.Render(n)
+		}
+	}
+	return strings.Join(out, "")
+}
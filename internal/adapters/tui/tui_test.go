@@ -0,0 +1,164 @@
+//go:build testing
+
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/application"
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+func newTestModel(t *testing.T) (*Model, *testutil.FakeKafkaClient) {
+	t.Helper()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "dev", Brokers: []string{"b1"}}, {Name: "prod", Brokers: []string{"b2"}, Protected: true}}
+	client := testutil.NewFakeKafkaClient()
+	client.Stats = &domain.ClusterStats{TotalTopics: 2, TotalPartitions: 4, TotalConsumerGroups: 1}
+	client.Topics = map[string]int{"orders": 3, "payments": 1}
+	client.TopicDetail = &domain.TopicDetail{Name: "orders", Partitions: 3, ReplicationFactor: 2, PartitionDetails: []domain.PartitionDetail{
+		{Partition: 0, Leader: 1, Replicas: []int32{1, 2}, ISR: []int32{1, 2}},
+		{Partition: 1, Leader: 2, Replicas: []int32{2, 1}, ISR: []int32{2}},
+	}}
+	client.Lags = kadm.DescribedGroupLags{"billing": kadm.DescribedGroupLag{Group: "billing", State: "Stable"}}
+	repo.Clients["dev"] = client
+	repo.Clients["prod"] = client
+
+	m := New(application.NewClusterService(repo))
+	m.refresh = 0
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	run(m, m.Init())
+	return m, client
+}
+
+// run runs cmd and the commands its messages lead to, like the program loop does.
+func run(m *Model, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			run(m, c)
+		}
+	case tea.QuitMsg:
+	default:
+		_, next := m.Update(msg)
+		run(m, next)
+	}
+}
+
+func press(m *Model, keys ...string) {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		case "up":
+			msg = tea.KeyMsg{Type: tea.KeyUp}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		_, cmd := m.Update(msg)
+		run(m, cmd)
+	}
+}
+
+func screenText(m *Model) string {
+	return ansi.Strip(m.View())
+}
+
+func TestModel_BrowsesClustersTopicsAndGroups(t *testing.T) {
+	m, _ := newTestModel(t)
+
+	view := screenText(m)
+	require.Contains(t, view, "dev")
+	require.Contains(t, view, "protected")
+	require.Contains(t, view, "online")
+
+	press(m, "enter")
+	require.Equal(t, "dev", m.top().title())
+	require.Contains(t, screenText(m), "Topics (2)")
+	require.Contains(t, screenText(m), "payments")
+
+	press(m, "tab")
+	require.Contains(t, screenText(m), "billing")
+	press(m, "tab", "down", "enter")
+	require.Equal(t, "payments", m.top().title())
+
+	// going back keeps the cursor where it was
+	press(m, "esc", "up", "enter")
+	view = screenText(m)
+	require.Contains(t, view, "clusters › dev › orders")
+	require.Contains(t, view, "3 partitions, replication factor 2")
+	require.Contains(t, view, "under-replicated")
+
+	press(m, "esc", "tab", "enter")
+	require.Equal(t, "billing", m.top().title())
+	require.Contains(t, screenText(m), "state Stable")
+
+	press(m, "esc", "esc", "esc", "esc")
+	require.Len(t, m.stack, 1)
+}
+
+func TestModel_FiltersTheCurrentTable(t *testing.T) {
+	m, _ := newTestModel(t)
+	press(m, "enter", "/", "p", "a", "y")
+
+	view := screenText(m)
+	require.Contains(t, view, "payments")
+	require.NotContains(t, view, "orders")
+	require.Contains(t, view, "/pay")
+
+	// enter keeps the filter and opens the only topic left
+	press(m, "enter", "enter")
+	require.Equal(t, "payments", m.top().title())
+
+	press(m, "esc", "esc")
+	require.Contains(t, screenText(m), "orders")
+}
+
+func TestModel_TailsMessages(t *testing.T) {
+	m, client := newTestModel(t)
+	client.Messages = []domain.Message{
+		{Partition: 0, Offset: 7, Key: []byte("k1"), Value: []byte("{\n\"id\": 1}"), Timestamp: time.Now()},
+		{Partition: 1, Offset: 3, Value: []byte("second")},
+	}
+
+	press(m, "enter", "enter")
+	client.Err = errors.New("broker gone")
+	press(m, "t")
+	view := screenText(m)
+	require.Contains(t, view, `p0@7 k1 { "id": 1}`)
+	require.Contains(t, view, "p1@3 - second")
+	require.Contains(t, view, "stopped: broker gone")
+	require.Contains(t, view, "2 messages")
+
+	press(m, "c")
+	require.Contains(t, screenText(m), "waiting for new messages")
+}
+
+func TestModel_ShowsLoadErrors(t *testing.T) {
+	m, client := newTestModel(t)
+	client.Err = errors.New("timed out")
+
+	press(m, "enter")
+	require.True(t, strings.HasSuffix(screenText(m), "error: timed out"))
+}
@@ -0,0 +1,187 @@
+package application
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// impliedActions lists, for each action a role grants, the actions it allows.
+var impliedActions = map[domain.Action][]domain.Action{
+	domain.ActionView:         {domain.ActionView},
+	domain.ActionProduce:      {domain.ActionProduce, domain.ActionView},
+	domain.ActionConsume:      {domain.ActionConsume, domain.ActionView},
+	domain.ActionTopicAdmin:   {domain.ActionTopicAdmin, domain.ActionProduce, domain.ActionConsume, domain.ActionView},
+	domain.ActionGroupAdmin:   {domain.ActionGroupAdmin, domain.ActionView},
+	domain.ActionClusterAdmin: domain.Actions,
+}
+
+// As returns a copy of the service, and of every service built from it, acting on behalf of user.
+// Its operations are then limited to what the RBAC rules grant the user.
+func (s *ClusterService) As(user domain.User) *ClusterService {
+	scoped := *s
+	scoped.user = &user
+	return &scoped
+}
+
+// As returns a copy of the topic service acting on behalf of user.
+func (s *TopicService) As(user domain.User) *TopicService {
+	return NewTopicService(s.clusterService.As(user))
+}
+
+// Allowed reports whether the service's user may perform the action on a resource of the cluster.
+// Services not acting on behalf of a user, such as the CLI and background jobs, may do anything,
+// as may every user when no RBAC rules are configured. Users authenticated with an API token are
+// further limited to the token scopes.
+func (s *ClusterService) Allowed(action domain.Action, cluster string, res domain.Resource) bool {
+	if s.user == nil {
+		return true
+	}
+	if s.user.ViaToken() && !scopesAllow(s.user.Scopes, action) {
+		return false
+	}
+	rbac := s.repo.FindRBAC()
+	if rbac == nil {
+		return true
+	}
+	return rbacAllows(rbac, *s.user, action, cluster, res)
+}
+
+// authorize returns an error matching ErrForbidden when the action is not allowed.
+func (s *ClusterService) authorize(action domain.Action, cluster string, res domain.Resource) error {
+	if s.Allowed(action, cluster, res) {
+		return nil
+	}
+	target := "cluster " + cluster
+	if res.Kind != domain.ResourceCluster && res.Name != "" {
+		target = fmt.Sprintf("%s %s on cluster %s", res.Kind, res.Name, cluster)
+	}
+	return fmt.Errorf("%w: %s may not %s %s", ErrForbidden, s.user.Name, action, target)
+}
+
+// filterAllowed keeps the names of the resources of the given kind the user may view.
+func filterAllowed[V any](s *ClusterService, cluster, kind string, items map[string]V) map[string]V {
+	if s.user == nil {
+		return items
+	}
+	out := make(map[string]V, len(items))
+	for name, v := range items {
+		if s.Allowed(domain.ActionView, cluster, domain.Resource{Kind: kind, Name: name}) {
+			out[name] = v
+		}
+	}
+	return out
+}
+
+// filterGroups keeps the consumer groups the user may view.
+func (s *ClusterService) filterGroups(cluster string, groups []domain.ConsumerGroupSummary) []domain.ConsumerGroupSummary {
+	if s.user == nil {
+		return groups
+	}
+	out := make([]domain.ConsumerGroupSummary, 0, len(groups))
+	for _, g := range groups {
+		if s.Allowed(domain.ActionView, cluster, domain.GroupResource(g.GroupID)) {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+func scopesAllow(scopes []domain.Action, action domain.Action) bool {
+	for _, scope := range scopes {
+		if slices.Contains(impliedActions[scope], action) {
+			return true
+		}
+	}
+	return false
+}
+
+func rbacAllows(rbac *config.RBACConfig, user domain.User, action domain.Action, cluster string, res domain.Resource) bool {
+	for _, binding := range rbac.Bindings {
+		if !bindingMatches(binding, user) {
+			continue
+		}
+		for _, role := range rbac.Roles {
+			if role.Name != binding.Role {
+				continue
+			}
+			for _, perm := range role.Permissions {
+				if permissionAllows(perm, action, cluster, res) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func bindingMatches(binding config.RoleBinding, user domain.User) bool {
+	if slices.Contains(binding.Users, user.Name) || slices.Contains(binding.Users, "*") {
+		return true
+	}
+	for _, group := range user.Groups {
+		if slices.Contains(binding.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionAllows matches a permission against an action on a resource. Cluster-admin covers the whole
+// cluster; other actions are limited by the topic or group patterns, and any permission on the cluster
+// lets the user view the cluster itself. An empty resource name matches when the permission covers any
+// resource of the kind.
+func permissionAllows(perm config.Permission, action domain.Action, cluster string, res domain.Resource) bool {
+	if !globsMatch(perm.Clusters, cluster) {
+		return false
+	}
+	for _, a := range perm.Actions {
+		granted := domain.Action(a)
+		if !slices.Contains(impliedActions[granted], action) {
+			continue
+		}
+		if granted == domain.ActionClusterAdmin {
+			return true
+		}
+		switch res.Kind {
+		case domain.ResourceCluster:
+			if action == domain.ActionView {
+				return true
+			}
+		case domain.ResourceTopic:
+			if scopeMatches(perm.Topics, perm.Groups, res.Name) {
+				return true
+			}
+		case domain.ResourceGroup:
+			if scopeMatches(perm.Groups, perm.Topics, res.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scopeMatches matches a topic or group name against the patterns of its kind. A permission that only
+// lists patterns of the other kind does not apply, so a role scoped to some groups grants no topics.
+func scopeMatches(patterns, otherPatterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return len(otherPatterns) == 0
+	}
+	return name == "" || globsMatch(patterns, name)
+}
+
+// globsMatch reports whether name matches one of the patterns; no patterns match every name.
+func globsMatch(patterns []string, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
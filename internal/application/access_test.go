@@ -0,0 +1,135 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// teamRBAC gives the orders team its topic prefix on the shared cluster, the payments team
+// read access to it, and platform engineers every cluster.
+func teamRBAC() *config.RBACConfig {
+	return &config.RBACConfig{
+		Roles: []config.Role{
+			{Name: "orders-owner", Permissions: []config.Permission{
+				{Actions: []string{"topic-admin"}, Clusters: []string{"shared-*"}, Topics: []string{"orders.*"}},
+				{Actions: []string{"group-admin"}, Clusters: []string{"shared-*"}, Groups: []string{"orders-*"}},
+			}},
+			{Name: "orders-reader", Permissions: []config.Permission{
+				{Actions: []string{"consume"}, Clusters: []string{"shared-prod"}, Topics: []string{"orders.*"}},
+			}},
+			{Name: "platform", Permissions: []config.Permission{{Actions: []string{"cluster-admin"}}}},
+		},
+		Bindings: []config.RoleBinding{
+			{Role: "orders-owner", Groups: []string{"orders-team"}},
+			{Role: "orders-reader", Users: []string{"paula"}},
+			{Role: "platform", Users: []string{"root"}, Groups: []string{"platform"}},
+		},
+	}
+}
+
+func TestClusterService_Allowed(t *testing.T) {
+	t.Parallel()
+	repo := testutil.NewFakeClusterRepository()
+	svc := NewClusterService(repo)
+	orders := svc.As(domain.User{Name: "olga", Groups: []string{"orders-team"}})
+
+	// without rules every user may do everything
+	require.True(t, orders.Allowed(domain.ActionClusterAdmin, "shared-prod", domain.ClusterResource))
+
+	repo.RBAC = teamRBAC()
+	tests := []struct {
+		name    string
+		user    domain.User
+		action  domain.Action
+		cluster string
+		res     domain.Resource
+		want    bool
+	}{
+		{"owner creates own topic", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionTopicAdmin, "shared-prod", domain.TopicResource("orders.created"), true},
+		{"owner produces to own topic", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionProduce, "shared-prod", domain.TopicResource("orders.created"), true},
+		{"owner views own cluster", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionView, "shared-prod", domain.ClusterResource, true},
+		{"owner cannot touch other team topic", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionView, "shared-prod", domain.TopicResource("payments.settled"), false},
+		{"owner cannot use other cluster", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionView, "billing", domain.ClusterResource, false},
+		{"owner cannot administer cluster", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionClusterAdmin, "shared-prod", domain.ClusterResource, false},
+		{"owner views own group", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionView, "shared-prod", domain.GroupResource("orders-billing"), true},
+		{"owner may create some topic", domain.User{Name: "olga", Groups: []string{"orders-team"}}, domain.ActionTopicAdmin, "shared-dev", domain.AnyTopic, true},
+		{"reader consumes", domain.User{Name: "paula"}, domain.ActionConsume, "shared-prod", domain.TopicResource("orders.created"), true},
+		{"reader cannot produce", domain.User{Name: "paula"}, domain.ActionProduce, "shared-prod", domain.TopicResource("orders.created"), false},
+		{"reader is limited to prod", domain.User{Name: "paula"}, domain.ActionConsume, "shared-dev", domain.TopicResource("orders.created"), false},
+		{"platform administers any cluster", domain.User{Name: "pete", Groups: []string{"platform"}}, domain.ActionClusterAdmin, "billing", domain.ClusterResource, true},
+		{"platform writes any topic", domain.User{Name: "root"}, domain.ActionProduce, "billing", domain.TopicResource("invoices"), true},
+		{"unbound user gets nothing", domain.User{Name: "mallory"}, domain.ActionView, "shared-prod", domain.ClusterResource, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, svc.As(tt.user).Allowed(tt.action, tt.cluster, tt.res))
+		})
+	}
+
+	// services not acting for a user are not limited
+	require.True(t, svc.Allowed(domain.ActionClusterAdmin, "billing", domain.ClusterResource))
+
+	// a binding to every user
+	repo.RBAC.Bindings = append(repo.RBAC.Bindings, config.RoleBinding{Role: "orders-reader", Users: []string{"*"}})
+	require.True(t, svc.As(domain.User{Name: "mallory"}).Allowed(domain.ActionView, "shared-prod", domain.TopicResource("orders.created")))
+}
+
+func TestAccess_ServicesEnforceRBAC(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "shared-prod", Brokers: []string{"b1"}}, {Name: "billing", Brokers: []string{"b2"}}}
+	client := &testutil.FakeKafkaClient{
+		Healthy: true,
+		Topics:  map[string]int{"orders.created": 3, "payments.settled": 6},
+		ConsumerGroups: []domain.ConsumerGroupSummary{
+			{GroupID: "orders-billing", State: "Stable"},
+			{GroupID: "payments-ledger", State: "Stable"},
+		},
+	}
+	repo.Clients["shared-prod"] = client
+	repo.Clients["billing"] = testutil.NewFakeKafkaClient()
+	repo.RBAC = teamRBAC()
+
+	cs := NewClusterService(repo).As(domain.User{Name: "olga", Groups: []string{"orders-team"}})
+	topics := NewTopicService(cs)
+
+	clusters := cs.ListClusters()
+	require.Len(t, clusters, 1)
+	require.Equal(t, "shared-prod", clusters[0].Name)
+
+	_, _, err := cs.GetClusterInfo("billing")
+	require.ErrorIs(t, err, ErrForbidden)
+	require.ErrorIs(t, cs.DeleteCluster("shared-prod"), ErrForbidden)
+
+	list, err := topics.ListTopics("shared-prod", false)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"orders.created": 3}, list)
+
+	_, detailTopics, _, _, groups, err := cs.GetClusterDetail("shared-prod")
+	require.NoError(t, err)
+	require.Len(t, detailTopics, 1)
+	require.Len(t, groups, 1)
+	require.Equal(t, "orders-billing", groups[0].GroupID)
+
+	require.NoError(t, topics.CreateTopic("shared-prod", domain.CreateTopicRequest{Name: "orders.shipped", NumPartitions: 1, ReplicationFactor: 1}))
+	err = topics.CreateTopic("shared-prod", domain.CreateTopicRequest{Name: "payments.refunded", NumPartitions: 1, ReplicationFactor: 1})
+	require.ErrorIs(t, err, ErrForbidden)
+	require.ErrorIs(t, topics.WriteMessage("shared-prod", "payments.settled", domain.Message{Value: []byte("v")}), ErrForbidden)
+	_, err = topics.GetTopicDetail("shared-prod", "payments.settled")
+	require.ErrorIs(t, err, ErrForbidden)
+
+	_, err = NewACLService(cs).ListACLs("billing", domain.ACLFilter{})
+	require.ErrorIs(t, err, ErrForbidden)
+	require.ErrorIs(t, NewQuotaService(cs).AlterQuotas("shared-prod", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{{Type: domain.QuotaEntityClientID, Name: "orders-api"}},
+		Set:    map[string]float64{domain.QuotaKeys[0]: 1024},
+	}), ErrForbidden)
+	_, err = NewExportService(cs).Export("shared-prod", ExportOptions{})
+	require.ErrorIs(t, err, ErrForbidden)
+}
@@ -0,0 +1,197 @@
+package application
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// ACLService provides operations related to Kafka ACLs.
+type ACLService struct {
+	clusterService *ClusterService
+	repo           domain.ClusterRepository
+}
+
+// NewACLService creates a new ACL service.
+func NewACLService(clusterService *ClusterService) *ACLService {
+	return &ACLService{
+		clusterService: clusterService,
+		repo:           clusterService.getRepo(),
+	}
+}
+
+// ListACLs returns the ACLs of a cluster matching the given filter.
+func (s *ACLService) ListACLs(clusterName string, filter domain.ACLFilter) ([]domain.ACL, error) {
+	filter, err := normalizeACLFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	acls, err := client.DescribeACLs(filter)
+	if err != nil {
+		utils.Logger.Error("describe acls failed", "cluster", clusterName, "err", err)
+		return nil, err
+	}
+	return acls, nil
+}
+
+// ListResourceACLs returns every ACL that applies to a resource, including wildcard and prefixed rules.
+func (s *ACLService) ListResourceACLs(clusterName, resourceType, resourceName string) ([]domain.ACL, error) {
+	return s.ListACLs(clusterName, domain.ACLFilter{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		PatternType:  "MATCH",
+	})
+}
+
+// CreateACL creates a new ACL in the cluster.
+func (s *ACLService) CreateACL(clusterName string, acl domain.ACL) (err error) {
+	audit := s.clusterService.audit(domain.AuditACLCreate, clusterName, domain.ClusterResource)
+	audit.after = acl
+	defer func() { audit.done(err) }()
+
+	acl, err = normalizeACL(acl)
+	if err != nil {
+		return err
+	}
+	audit.after = acl
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CreateACL(acl); err != nil {
+		utils.Logger.Error("create acl failed", "cluster", clusterName, "principal", acl.Principal, "resource", acl.ResourceName, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("acl created", "cluster", clusterName, "principal", acl.Principal, "resource_type", acl.ResourceType,
+		"resource", acl.ResourceName, "operation", acl.Operation, "permission", acl.Permission)
+	return nil
+}
+
+// DeleteACLs deletes the ACLs matching the given filter. The filter must select at least a principal or a resource.
+func (s *ACLService) DeleteACLs(clusterName string, filter domain.ACLFilter) (_ []domain.ACL, err error) {
+	audit := s.clusterService.audit(domain.AuditACLDelete, clusterName, domain.ClusterResource)
+	defer func() { audit.done(err) }()
+
+	filter, err = normalizeACLFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter.Principal == "" && filter.ResourceName == "" && filter.ResourceType != "CLUSTER" {
+		return nil, ErrInvalidACLFilter
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return nil, err
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := client.DeleteACLs(filter)
+	if err != nil {
+		utils.Logger.Error("delete acls failed", "cluster", clusterName, "err", err)
+		return nil, err
+	}
+
+	audit.before = deleted
+	utils.Logger.Info("acls deleted", "cluster", clusterName, "count", len(deleted))
+	return deleted, nil
+}
+
+func (s *ACLService) client(clusterName string) (domain.KafkaClient, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("acl client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+	return client, nil
+}
+
+// normalizeACL upper-cases enum fields, fills defaults and validates the entry.
+func normalizeACL(acl domain.ACL) (domain.ACL, error) {
+	acl.Principal = normalizePrincipal(acl.Principal)
+	acl.Host = strings.TrimSpace(acl.Host)
+	acl.ResourceType = strings.ToUpper(strings.TrimSpace(acl.ResourceType))
+	acl.ResourceName = strings.TrimSpace(acl.ResourceName)
+	acl.PatternType = strings.ToUpper(strings.TrimSpace(acl.PatternType))
+	acl.Operation = strings.ToUpper(strings.TrimSpace(acl.Operation))
+	acl.Permission = strings.ToUpper(strings.TrimSpace(acl.Permission))
+
+	if acl.Host == "" {
+		acl.Host = "*"
+	}
+	if acl.PatternType == "" {
+		acl.PatternType = "LITERAL"
+	}
+	if acl.ResourceType == "CLUSTER" {
+		acl.ResourceName = "kafka-cluster"
+	}
+
+	if acl.Principal == "" || acl.ResourceName == "" ||
+		!slices.Contains(domain.ACLResourceTypes, acl.ResourceType) ||
+		!slices.Contains(domain.ACLPatternTypes, acl.PatternType) ||
+		!slices.Contains(domain.ACLOperations, acl.Operation) ||
+		!slices.Contains(domain.ACLPermissions, acl.Permission) {
+		return acl, ErrInvalidACL
+	}
+	return acl, nil
+}
+
+// normalizeACLFilter upper-cases enum fields and validates the values that are set.
+func normalizeACLFilter(filter domain.ACLFilter) (domain.ACLFilter, error) {
+	filter.Principal = normalizePrincipal(filter.Principal)
+	filter.Host = strings.TrimSpace(filter.Host)
+	filter.ResourceType = strings.ToUpper(strings.TrimSpace(filter.ResourceType))
+	filter.ResourceName = strings.TrimSpace(filter.ResourceName)
+	filter.PatternType = strings.ToUpper(strings.TrimSpace(filter.PatternType))
+	filter.Operation = strings.ToUpper(strings.TrimSpace(filter.Operation))
+	filter.Permission = strings.ToUpper(strings.TrimSpace(filter.Permission))
+
+	if filter.ResourceType != "" && filter.ResourceType != "ANY" && !slices.Contains(domain.ACLResourceTypes, filter.ResourceType) ||
+		filter.PatternType != "" && filter.PatternType != "ANY" && filter.PatternType != "MATCH" && !slices.Contains(domain.ACLPatternTypes, filter.PatternType) ||
+		filter.Operation != "" && filter.Operation != "ANY" && !slices.Contains(domain.ACLOperations, filter.Operation) ||
+		filter.Permission != "" && !slices.Contains(domain.ACLPermissions, filter.Permission) {
+		return filter, ErrInvalidACLFilter
+	}
+	return filter, nil
+}
+
+// normalizePrincipal adds the "User:" prefix to bare principal names.
+func normalizePrincipal(principal string) string {
+	principal = strings.TrimSpace(principal)
+	if principal != "" && !strings.Contains(principal, ":") {
+		principal = "User:" + principal
+	}
+	return principal
+}
@@ -0,0 +1,80 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACLService_CreateAndList(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewACLService(cs)
+
+	// missing cluster
+	_, err := svc.ListACLs("unknown", domain.ACLFilter{})
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	// invalid acl
+	err = svc.CreateACL("c1", domain.ACL{Principal: "alice", ResourceType: "topic", ResourceName: "t", Operation: "fly", Permission: "allow"})
+	require.ErrorIs(t, err, ErrInvalidACL)
+
+	// invalid filter
+	_, err = svc.ListACLs("c1", domain.ACLFilter{ResourceType: "planet"})
+	require.ErrorIs(t, err, ErrInvalidACLFilter)
+
+	// success normalizes principal, enums and defaults
+	err = svc.CreateACL("c1", domain.ACL{Principal: "alice", ResourceType: "topic", ResourceName: "t", Operation: "write", Permission: "allow"})
+	require.NoError(t, err)
+	require.Equal(t, domain.ACL{
+		Principal:    "User:alice",
+		Host:         "*",
+		ResourceType: "TOPIC",
+		ResourceName: "t",
+		PatternType:  "LITERAL",
+		Operation:    "WRITE",
+		Permission:   "ALLOW",
+	}, fake.ACLs[0])
+
+	acls, err := svc.ListResourceACLs("c1", "TOPIC", "t")
+	require.NoError(t, err)
+	require.Len(t, acls, 1)
+}
+
+func TestACLService_DeleteACLs(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.ACLs = []domain.ACL{{Principal: "User:alice", ResourceType: "TOPIC", ResourceName: "t"}}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewACLService(cs)
+
+	// an empty filter would match every ACL in the cluster
+	_, err := svc.DeleteACLs("c1", domain.ACLFilter{})
+	require.ErrorIs(t, err, ErrInvalidACLFilter)
+
+	// missing client
+	delete(repo.Clients, "c1")
+	_, err = svc.DeleteACLs("c1", domain.ACLFilter{Principal: "alice"})
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	// success
+	repo.Clients["c1"] = fake
+	deleted, err := svc.DeleteACLs("c1", domain.ACLFilter{Principal: "alice"})
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+}
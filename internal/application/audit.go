@@ -0,0 +1,185 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// WithAuditLog returns a copy of the service, and of every service built from it, recording its changes in log.
+func (s *ClusterService) WithAuditLog(log domain.AuditLog) *ClusterService {
+	audited := *s
+	audited.auditLog = log
+	return &audited
+}
+
+// From returns a copy of the service whose audit events carry the given request.
+func (s *ClusterService) From(req domain.RequestInfo) *ClusterService {
+	scoped := *s
+	scoped.request = req
+	return &scoped
+}
+
+// From returns a copy of the topic service whose audit events carry the given request.
+func (s *TopicService) From(req domain.RequestInfo) *TopicService {
+	return NewTopicService(s.clusterService.From(req))
+}
+
+// AuditEnabled reports whether changes are recorded.
+func (s *ClusterService) AuditEnabled() bool {
+	return s.auditLog != nil
+}
+
+// QueryAudit returns the audit events matching the filter that concern resources the user may view, newest first.
+func (s *ClusterService) QueryAudit(filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	if s.auditLog == nil {
+		return nil, ErrAuditDisabled
+	}
+	limit := filter.Limit
+	if s.user != nil {
+		// Events are filtered after reading, so the limit is applied here instead.
+		filter.Limit = 0
+	}
+	events, err := s.auditLog.Query(filter)
+	if err != nil {
+		utils.Logger.Error("query audit log failed", "err", err)
+		return nil, err
+	}
+	if s.user == nil {
+		return events, nil
+	}
+	out := make([]domain.AuditEvent, 0, len(events))
+	for _, e := range events {
+		if limit > 0 && len(out) == limit {
+			break
+		}
+		if s.Allowed(domain.ActionView, e.Cluster, e.Resource()) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// auditedChange is the audit event of a change in progress. Before and after are set by the change
+// as it learns them and the event is written by done.
+type auditedChange struct {
+	s             *ClusterService
+	event         domain.AuditEvent
+	before, after any
+}
+
+// audit starts the audit event of a change to a resource of a cluster. Callers defer done with the
+// error the change returns.
+func (s *ClusterService) audit(action, cluster string, res domain.Resource) *auditedChange {
+	return &auditedChange{s: s, event: domain.AuditEvent{
+		Cluster:      cluster,
+		ResourceType: res.Kind,
+		ResourceName: res.Name,
+		Action:       action,
+		SourceIP:     s.request.SourceIP,
+		RequestID:    s.request.RequestID,
+	}}
+}
+
+func (c *auditedChange) done(err error) {
+	if c.s.auditLog == nil {
+		return
+	}
+	e := c.event
+	e.Time = time.Now().UTC()
+	if c.s.user != nil {
+		e.User = c.s.user.Name
+		e.Provider = c.s.user.Provider
+	} else if c.s.request.OSUser != "" {
+		e.User = c.s.request.OSUser
+		e.Provider = "os"
+	}
+	e.Before = auditValue(c.before)
+	e.After = auditValue(c.after)
+	switch {
+	case err == nil:
+		e.Result = domain.AuditSuccess
+	case errors.Is(err, ErrForbidden), errors.Is(err, ErrReadOnly):
+		e.Result = domain.AuditDenied
+		e.Error = err.Error()
+	default:
+		e.Result = domain.AuditFailure
+		e.Error = err.Error()
+	}
+	if err := c.s.auditLog.Record(e); err != nil {
+		utils.Logger.Error("write audit event failed", "action", e.Action, "cluster", e.Cluster, "resource", e.ResourceName, "err", err)
+	}
+}
+
+func auditValue(v any) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		utils.Logger.Warn("encode audit value failed", "err", err)
+		return nil
+	}
+	return b
+}
+
+// auditClusterConfig drops the credentials of a cluster configuration before it is recorded.
+func auditClusterConfig(cfg config.ClusterConfig) config.ClusterConfig {
+	if cfg.SASL != nil && cfg.SASL.Password != "" {
+		sasl := *cfg.SASL
+		sasl.Password = "********"
+		cfg.SASL = &sasl
+	}
+	return cfg
+}
+
+// auditTopicConfigs returns the current values of the given configs of a topic, nil when the topic
+// cannot be described.
+func auditTopicConfigs(client domain.KafkaClient, topicName string, keys map[string]*string) map[string]string {
+	detail, err := client.GetTopicDetail(topicName)
+	if err != nil || detail == nil {
+		return nil
+	}
+	out := make(map[string]string, len(keys))
+	for _, e := range detail.ConfigEntries {
+		if _, ok := keys[e.Name]; ok {
+			if e.Sensitive {
+				out[e.Name] = "********"
+				continue
+			}
+			out[e.Name] = e.Value
+		}
+	}
+	return out
+}
+
+// topicAudit is the recorded state of a topic.
+type topicAudit struct {
+	Partitions        int               `json:"partitions"`
+	ReplicationFactor int               `json:"replication_factor"`
+	Configs           map[string]string `json:"configs,omitempty"`
+}
+
+// auditTopic returns the partitions, replication factor and overridden configs of a topic, nil when
+// the topic cannot be described.
+func auditTopic(client domain.KafkaClient, topicName string) *topicAudit {
+	detail, err := client.GetTopicDetail(topicName)
+	if err != nil || detail == nil {
+		return nil
+	}
+	t := &topicAudit{Partitions: detail.Partitions, ReplicationFactor: detail.ReplicationFactor}
+	for _, e := range detail.ConfigEntries {
+		if !e.IsOverridden() || e.Sensitive {
+			continue
+		}
+		if t.Configs == nil {
+			t.Configs = make(map[string]string)
+		}
+		t.Configs[e.Name] = e.Value
+	}
+	return t
+}
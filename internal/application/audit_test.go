@@ -0,0 +1,189 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuditLog struct {
+	events []domain.AuditEvent
+}
+
+func (f *fakeAuditLog) Record(event domain.AuditEvent) error {
+	f.events = append(f.events, event)
+	return nil
+}
+
+func (f *fakeAuditLog) Query(filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	var out []domain.AuditEvent
+	for i := len(f.events) - 1; i >= 0; i-- {
+		if filter.Matches(f.events[i]) {
+			out = append(out, f.events[i])
+		}
+		if filter.Limit > 0 && len(out) == filter.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func TestAudit_RecordsChanges(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "shared-prod", Brokers: []string{"b1"}}}
+	client := testutil.NewFakeKafkaClient()
+	client.TopicDetail = &domain.TopicDetail{
+		Name:              "orders.created",
+		Partitions:        3,
+		ReplicationFactor: 3,
+		ConfigEntries: []domain.TopicConfigEntry{
+			{Name: "retention.ms", Value: "604800000", Source: domain.ConfigSourceTopic},
+			{Name: "cleanup.policy", Value: "delete"},
+		},
+	}
+	repo.Clients["shared-prod"] = client
+	repo.RBAC = teamRBAC()
+	log := &fakeAuditLog{}
+
+	cs := NewClusterService(repo).WithAuditLog(log).
+		From(domain.RequestInfo{SourceIP: "10.0.0.7", RequestID: "req-1"}).
+		As(domain.User{Name: "olga", Provider: "oidc", Groups: []string{"orders-team"}})
+	topics := NewTopicService(cs)
+
+	retention := "86400000"
+	require.NoError(t, topics.UpdateTopicConfig("shared-prod", "orders.created", domain.UpdateTopicConfigRequest{
+		Configs: map[string]*string{"retention.ms": &retention},
+	}))
+	require.Len(t, log.events, 1)
+	e := log.events[0]
+	require.Equal(t, "olga", e.User)
+	require.Equal(t, "oidc", e.Provider)
+	require.Equal(t, "10.0.0.7", e.SourceIP)
+	require.Equal(t, "req-1", e.RequestID)
+	require.Equal(t, "shared-prod", e.Cluster)
+	require.Equal(t, domain.TopicResource("orders.created"), e.Resource())
+	require.Equal(t, domain.AuditTopicUpdateConfig, e.Action)
+	require.Equal(t, domain.AuditSuccess, e.Result)
+	require.JSONEq(t, `{"retention.ms":"604800000"}`, string(e.Before))
+	require.JSONEq(t, `{"retention.ms":"86400000"}`, string(e.After))
+	require.False(t, e.Time.IsZero())
+
+	// refused changes are recorded too
+	require.ErrorIs(t, topics.DeleteTopic("shared-prod", "payments.settled", domain.DeleteTopicRequest{}), ErrForbidden)
+	e = log.events[1]
+	require.Equal(t, domain.AuditTopicDelete, e.Action)
+	require.Equal(t, domain.AuditDenied, e.Result)
+	require.Contains(t, e.Error, "permission denied")
+
+	require.NoError(t, topics.IncreasePartitions("shared-prod", "orders.created", domain.IncreasePartitionsRequest{TotalPartitions: 6}))
+	e = log.events[2]
+	require.JSONEq(t, `{"partitions":3}`, string(e.Before))
+	require.JSONEq(t, `{"partitions":6}`, string(e.After))
+
+	require.NoError(t, topics.WriteMessage("shared-prod", "orders.created", domain.Message{Key: []byte("k1"), Value: []byte("secret payload")}))
+	e = log.events[3]
+	require.Equal(t, domain.AuditMessageProduce, e.Action)
+	require.NotContains(t, string(e.After), "secret payload")
+
+	// credentials never reach the log
+	admin := NewClusterService(repo).WithAuditLog(log).As(domain.User{Name: "root"})
+	require.NoError(t, NewSCRAMService(admin).UpsertUser("shared-prod", domain.UpsertSCRAMUserRequest{
+		Name: "orders-api", Mechanism: "SCRAM-SHA-512", Password: "hunter2",
+	}))
+	require.NotContains(t, string(log.events[4].After), "hunter2")
+	require.Equal(t, domain.Resource{Kind: domain.ResourceCluster, Name: "orders-api"}, log.events[4].Resource())
+	require.NoError(t, admin.UpdateCluster("shared-prod", config.ClusterConfig{
+		Brokers: []string{"b1"},
+		SASL:    &config.SASLConfig{Mechanism: "PLAIN", Username: "scout", Password: "hunter2"},
+	}))
+	require.NotContains(t, string(log.events[5].After), "hunter2")
+	var after config.ClusterConfig
+	require.NoError(t, json.Unmarshal(log.events[5].After, &after))
+	require.Equal(t, "scout", after.SASL.Username)
+
+	// cluster-wide changes are recorded against the user or entity they change
+	require.NoError(t, NewSCRAMService(admin).DeleteUser("shared-prod", " orders-api ", ""))
+	require.Equal(t, domain.Resource{Kind: domain.ResourceCluster, Name: "orders-api"}, log.events[6].Resource())
+	require.NoError(t, NewQuotaService(admin).AlterQuotas("shared-prod", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{{Type: domain.QuotaEntityUser, Name: "orders-api"}, {Type: domain.QuotaEntityClientID, Default: true}},
+		Set:    map[string]float64{"producer_byte_rate": 1024},
+	}))
+	require.Equal(t, domain.Resource{Kind: domain.ResourceCluster, Name: "user=orders-api,client-id=<default>"}, log.events[7].Resource())
+	matched, err := admin.QueryAudit(domain.AuditFilter{Resource: "orders-api"})
+	require.NoError(t, err)
+	require.Len(t, matched, 3)
+
+	// services without an audit log record nothing
+	require.NoError(t, NewTopicService(NewClusterService(repo)).CreateTopic("shared-prod", domain.CreateTopicRequest{Name: "orders.shipped", NumPartitions: 1, ReplicationFactor: 1}))
+	require.Len(t, log.events, 8)
+}
+
+func TestAudit_RecordsTheOSUserOfCommands(t *testing.T) {
+	t.Parallel()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "shared-prod", Brokers: []string{"b1"}}}
+	repo.Clients["shared-prod"] = testutil.NewFakeKafkaClient()
+	log := &fakeAuditLog{}
+
+	topics := NewTopicService(NewClusterService(repo).WithAuditLog(log).From(domain.RequestInfo{OSUser: "ops"}))
+	require.NoError(t, topics.WriteMessage("shared-prod", "orders.created", domain.Message{Value: []byte("v")}))
+	require.Equal(t, "ops", log.events[0].User)
+	require.Equal(t, "os", log.events[0].Provider)
+}
+
+func TestAudit_RecordsRejectedWrites(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "shared-prod", Brokers: []string{"b1"}}}
+	client := testutil.NewFakeKafkaClient()
+	client.Err = errors.New("NOT_ENOUGH_REPLICAS")
+	repo.Clients["shared-prod"] = client
+	log := &fakeAuditLog{}
+
+	topics := NewTopicService(NewClusterService(repo).WithAuditLog(log))
+	require.Error(t, topics.WriteMessage("shared-prod", "orders.created", domain.Message{Value: []byte("v")}))
+	require.Len(t, log.events, 1)
+	require.Equal(t, domain.AuditMessageProduce, log.events[0].Action)
+	require.Equal(t, domain.AuditFailure, log.events[0].Result)
+	require.Contains(t, log.events[0].Error, "NOT_ENOUGH_REPLICAS")
+}
+
+func TestAudit_QueryFollowsRBAC(t *testing.T) {
+	t.Parallel()
+	repo := testutil.NewFakeClusterRepository()
+	repo.RBAC = teamRBAC()
+	log := &fakeAuditLog{events: []domain.AuditEvent{
+		{Cluster: "shared-prod", ResourceType: domain.ResourceTopic, ResourceName: "orders.created", Action: domain.AuditTopicUpdateConfig},
+		{Cluster: "shared-prod", ResourceType: domain.ResourceTopic, ResourceName: "payments.settled", Action: domain.AuditTopicUpdateConfig},
+		{Cluster: "shared-prod", ResourceType: domain.ResourceTopic, ResourceName: "orders.shipped", Action: domain.AuditTopicCreate},
+		{Cluster: "billing", ResourceType: domain.ResourceCluster, Action: domain.AuditACLCreate},
+	}}
+
+	_, err := NewClusterService(repo).QueryAudit(domain.AuditFilter{})
+	require.ErrorIs(t, err, ErrAuditDisabled)
+
+	cs := NewClusterService(repo).WithAuditLog(log)
+	events, err := cs.QueryAudit(domain.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	orders := cs.As(domain.User{Name: "olga", Groups: []string{"orders-team"}})
+	events, err = orders.QueryAudit(domain.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, "orders.shipped", events[0].ResourceName)
+
+	events, err = orders.QueryAudit(domain.AuditFilter{Action: domain.AuditTopicUpdateConfig, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "orders.created", events[0].ResourceName)
+}
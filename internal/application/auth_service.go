@@ -0,0 +1,180 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// DefaultSessionTTL is how long a session lasts when the configuration does not say.
+const DefaultSessionTTL = 12 * time.Hour
+
+// AuthService signs users in with passwords or OpenID Connect and keeps their sessions in memory.
+// With no user source configured it is disabled and every request is let through.
+type AuthService struct {
+	passwords []domain.PasswordVerifier
+	oidc      domain.IdentityProvider
+	ttl       time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]domain.Session
+	now      func() time.Time
+}
+
+// NewAuthService creates an auth service checking the password sources in order. oidc may be nil.
+func NewAuthService(passwords []domain.PasswordVerifier, oidc domain.IdentityProvider, ttl time.Duration) *AuthService {
+	if ttl <= 0 {
+		ttl = DefaultSessionTTL
+	}
+	return &AuthService{
+		passwords: passwords,
+		oidc:      oidc,
+		ttl:       ttl,
+		sessions:  make(map[string]domain.Session),
+		now:       time.Now,
+	}
+}
+
+// Enabled reports whether sign-in is required.
+func (s *AuthService) Enabled() bool {
+	return s != nil && (s.PasswordEnabled() || s.OIDCEnabled())
+}
+
+// PasswordEnabled reports whether users can sign in with a username and password.
+func (s *AuthService) PasswordEnabled() bool {
+	return s != nil && len(s.passwords) > 0
+}
+
+// OIDCEnabled reports whether users can sign in with the OpenID Connect provider.
+func (s *AuthService) OIDCEnabled() bool {
+	return s != nil && s.oidc != nil
+}
+
+// Authenticate checks the credentials against each password source.
+func (s *AuthService) Authenticate(username, password string) (domain.User, error) {
+	if username == "" || password == "" {
+		return domain.User{}, ErrInvalidCredentials
+	}
+	for _, p := range s.passwords {
+		if user, ok := p.Verify(username, password); ok {
+			return user, nil
+		}
+	}
+	return domain.User{}, ErrInvalidCredentials
+}
+
+// Login checks the credentials and opens a session for the user.
+func (s *AuthService) Login(username, password string) (domain.Session, error) {
+	user, err := s.Authenticate(username, password)
+	if err != nil {
+		return domain.Session{}, err
+	}
+	return s.openSession(user)
+}
+
+// BeginOIDC starts a sign-in with the OpenID Connect provider. It returns the provider URL to send the
+// browser to, along with the state and nonce the callback must be checked against.
+func (s *AuthService) BeginOIDC() (authURL, state, nonce string, err error) {
+	if !s.OIDCEnabled() {
+		return "", "", "", ErrOIDCDisabled
+	}
+	if state, err = randomToken(32); err != nil {
+		return "", "", "", err
+	}
+	if nonce, err = randomToken(32); err != nil {
+		return "", "", "", err
+	}
+	return s.oidc.AuthCodeURL(state, nonce), state, nonce, nil
+}
+
+// LookupUser returns the current identity of a user who signed in with a password, with the groups
+// the password sources now give it, and false once no source holds the user anymore. Users of the
+// OpenID Connect provider are only known at sign-in and are returned as they are while it is configured.
+func (s *AuthService) LookupUser(user domain.User) (domain.User, bool) {
+	for _, p := range s.passwords {
+		if current, ok := p.Lookup(user.Name); ok && current.Provider == user.Provider {
+			return current, true
+		}
+	}
+	if s.OIDCEnabled() && user.Provider == domain.ProviderOIDC {
+		return user, true
+	}
+	return domain.User{}, false
+}
+
+// LoginOIDC redeems the authorization code returned by the provider and opens a session for the user.
+func (s *AuthService) LoginOIDC(ctx context.Context, code, nonce string) (domain.Session, error) {
+	if !s.OIDCEnabled() {
+		return domain.Session{}, ErrOIDCDisabled
+	}
+	user, err := s.oidc.Exchange(ctx, code, nonce)
+	if err != nil {
+		return domain.Session{}, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return s.openSession(user)
+}
+
+// Session returns the session with the given ID while it has not expired. The user is looked up
+// again, so the session carries the groups the user has now and ends once the user is removed.
+func (s *AuthService) Session(id string) (domain.Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return domain.Session{}, false
+	}
+	if !s.now().Before(session.ExpiresAt) {
+		delete(s.sessions, id)
+		return domain.Session{}, false
+	}
+	user, ok := s.LookupUser(session.User)
+	if !ok {
+		delete(s.sessions, id)
+		return domain.Session{}, false
+	}
+	session.User = user
+	return session, true
+}
+
+// Logout ends the session with the given ID.
+func (s *AuthService) Logout(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, id)
+}
+
+func (s *AuthService) openSession(user domain.User) (domain.Session, error) {
+	id, err := randomToken(32)
+	if err != nil {
+		return domain.Session{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for sid, session := range s.sessions {
+		if !now.Before(session.ExpiresAt) {
+			delete(s.sessions, sid)
+		}
+	}
+	session := domain.Session{ID: id, User: user, ExpiresAt: now.Add(s.ttl)}
+	s.sessions[id] = session
+	return session, nil
+}
+
+// randomToken returns n random bytes encoded for use in cookies, URLs and API tokens.
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePasswords map[string]string
+
+func (f fakePasswords) Verify(username, password string) (domain.User, bool) {
+	if p, ok := f[username]; ok && p == password {
+		return domain.User{Name: username, Provider: "local"}, true
+	}
+	return domain.User{}, false
+}
+
+func (f fakePasswords) Lookup(username string) (domain.User, bool) {
+	if _, ok := f[username]; ok {
+		return domain.User{Name: username, Provider: "local"}, true
+	}
+	return domain.User{}, false
+}
+
+type fakeIdentityProvider struct {
+	nonce string
+}
+
+func (f *fakeIdentityProvider) AuthCodeURL(state, nonce string) string {
+	f.nonce = nonce
+	return "https://idp.example.com/authorize?state=" + state
+}
+
+func (f *fakeIdentityProvider) Exchange(_ context.Context, code, nonce string) (domain.User, error) {
+	if code != "valid" || nonce != f.nonce {
+		return domain.User{}, errors.New("invalid_grant")
+	}
+	return domain.User{Name: "bob", Provider: "oidc"}, nil
+}
+
+func TestAuthService_Disabled(t *testing.T) {
+	t.Parallel()
+	svc := NewAuthService(nil, nil, 0)
+	require.False(t, svc.Enabled())
+
+	_, _, _, err := svc.BeginOIDC()
+	require.ErrorIs(t, err, ErrOIDCDisabled)
+	_, err = svc.Login("alice", "s3cret")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthService_PasswordSessions(t *testing.T) {
+	t.Parallel()
+	svc := NewAuthService([]domain.PasswordVerifier{
+		fakePasswords{"alice": "s3cret"},
+		fakePasswords{"carol": "hunter2"},
+	}, nil, time.Hour)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	require.True(t, svc.Enabled())
+	require.True(t, svc.PasswordEnabled())
+	require.False(t, svc.OIDCEnabled())
+
+	_, err := svc.Login("alice", "wrong")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = svc.Login("", "")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	// every password source is checked
+	user, err := svc.Authenticate("carol", "hunter2")
+	require.NoError(t, err)
+	require.Equal(t, "carol", user.Name)
+
+	session, err := svc.Login("alice", "s3cret")
+	require.NoError(t, err)
+	require.NotEmpty(t, session.ID)
+	require.Equal(t, now.Add(time.Hour), session.ExpiresAt)
+
+	got, ok := svc.Session(session.ID)
+	require.True(t, ok)
+	require.Equal(t, "alice", got.User.Name)
+
+	svc.Logout(session.ID)
+	_, ok = svc.Session(session.ID)
+	require.False(t, ok)
+
+	// sessions expire after the ttl
+	session, err = svc.Login("alice", "s3cret")
+	require.NoError(t, err)
+	now = now.Add(time.Hour)
+	_, ok = svc.Session(session.ID)
+	require.False(t, ok)
+}
+
+func TestAuthService_SessionsFollowTheUser(t *testing.T) {
+	t.Parallel()
+	users := groupedPasswords{"olga": {"orders-team"}}
+	svc := NewAuthService([]domain.PasswordVerifier{users}, nil, time.Hour)
+
+	session, err := svc.Login("olga", "any")
+	require.NoError(t, err)
+	got, ok := svc.Session(session.ID)
+	require.True(t, ok)
+	require.Equal(t, []string{"orders-team"}, got.User.Groups)
+
+	// a session acts with the groups the user has now
+	users["olga"] = []string{"billing-team"}
+	got, ok = svc.Session(session.ID)
+	require.True(t, ok)
+	require.Equal(t, []string{"billing-team"}, got.User.Groups)
+
+	// and ends once the user is removed, even after the user comes back
+	delete(users, "olga")
+	_, ok = svc.Session(session.ID)
+	require.False(t, ok)
+	users["olga"] = []string{"orders-team"}
+	_, ok = svc.Session(session.ID)
+	require.False(t, ok)
+}
+
+func TestAuthService_OIDC(t *testing.T) {
+	t.Parallel()
+	idp := &fakeIdentityProvider{}
+	svc := NewAuthService(nil, idp, 0)
+	require.True(t, svc.Enabled())
+	require.False(t, svc.PasswordEnabled())
+
+	authURL, state, nonce, err := svc.BeginOIDC()
+	require.NoError(t, err)
+	require.Contains(t, authURL, state)
+	require.NotEqual(t, state, nonce)
+
+	_, err = svc.LoginOIDC(context.Background(), "valid", "replayed")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+
+	session, err := svc.LoginOIDC(context.Background(), "valid", nonce)
+	require.NoError(t, err)
+	require.Equal(t, "bob", session.User.Name)
+	require.WithinDuration(t, time.Now().Add(DefaultSessionTTL), session.ExpiresAt, time.Minute)
+}
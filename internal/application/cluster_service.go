@@ -4,14 +4,21 @@
 package application
 
 import (
+	"fmt"
+
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
 )
 
 // ClusterService provides operations related to cluster management.
+// user is set on copies made by As and limits what the service, and the services built from it, may do.
+// Changes are recorded in auditLog, if set, along with the request they were made in.
 type ClusterService struct {
-	repo domain.ClusterRepository
+	repo     domain.ClusterRepository
+	user     *domain.User
+	auditLog domain.AuditLog
+	request  domain.RequestInfo
 }
 
 // NewClusterService creates a new cluster service.
@@ -23,9 +30,16 @@ func (s *ClusterService) getRepo() domain.ClusterRepository {
 	return s.repo
 }
 
-// ListClusters lists all clusters.
+// ListClusters lists all clusters the user may view.
 func (s *ClusterService) ListClusters() []config.ClusterConfig {
-	return s.repo.FindAll()
+	all := s.repo.FindAll()
+	out := make([]config.ClusterConfig, 0, len(all))
+	for _, cfg := range all {
+		if s.Allowed(domain.ActionView, cfg.Name, domain.ClusterResource) {
+			out = append(out, cfg)
+		}
+	}
+	return out
 }
 
 // GetCluster retrieves a cluster configuration by name.
@@ -33,22 +47,90 @@ func (s *ClusterService) GetCluster(name string) (config.ClusterConfig, bool) {
 	return s.repo.FindByName(name)
 }
 
-// AddCluster adds a new cluster configuration.
-func (s *ClusterService) AddCluster(cfg config.ClusterConfig) error {
+// IsReadOnly reports whether the global read-only mode is enabled.
+func (s *ClusterService) IsReadOnly() bool {
+	return s.repo.IsReadOnly()
+}
+
+// CheckWritable returns an error matching ErrReadOnly when changes to the cluster are disabled,
+// either by the global read-only mode or by the read_only flag of the cluster.
+func (s *ClusterService) CheckWritable(name string) error {
+	cfg, ok := s.repo.FindByName(name)
+	if !ok {
+		return ErrClusterNotFound
+	}
+	return s.checkWritable(cfg)
+}
+
+func (s *ClusterService) checkWritable(cfg config.ClusterConfig) error {
+	if s.repo.IsReadOnly() {
+		return fmt.Errorf("%w: maned scout runs in read-only mode", ErrReadOnly)
+	}
+	if cfg.ReadOnly {
+		return fmt.Errorf("%w: cluster %s is read-only", ErrReadOnly, cfg.Name)
+	}
+	return nil
+}
+
+// AddCluster adds a new cluster configuration. An existing cluster is changed through UpdateCluster,
+// which keeps a read-only cluster from being replaced.
+func (s *ClusterService) AddCluster(cfg config.ClusterConfig) (err error) {
+	audit := s.audit(domain.AuditClusterAdd, cfg.Name, domain.ClusterResource)
+	audit.after = auditClusterConfig(cfg)
+	defer func() { audit.done(err) }()
+
 	if cfg.Name == "" || len(cfg.Brokers) == 0 {
 		return ErrInvalidClusterConfig
 	}
+	if err := s.authorize(domain.ActionClusterAdmin, cfg.Name, domain.ClusterResource); err != nil {
+		return err
+	}
+	if s.repo.IsReadOnly() {
+		return fmt.Errorf("%w: maned scout runs in read-only mode", ErrReadOnly)
+	}
+	if _, ok := s.repo.FindByName(cfg.Name); ok {
+		return fmt.Errorf("%w: %s", ErrClusterExists, cfg.Name)
+	}
 	return s.repo.Save(cfg)
 }
 
 // UpdateCluster updates an existing cluster configuration.
-func (s *ClusterService) UpdateCluster(name string, cfg config.ClusterConfig) error {
+// A read-only cluster can only be changed through the configuration file.
+func (s *ClusterService) UpdateCluster(name string, cfg config.ClusterConfig) (err error) {
 	cfg.Name = name
+	audit := s.audit(domain.AuditClusterUpdate, name, domain.ClusterResource)
+	audit.after = auditClusterConfig(cfg)
+	defer func() { audit.done(err) }()
+
+	if err := s.authorize(domain.ActionClusterAdmin, name, domain.ClusterResource); err != nil {
+		return err
+	}
+	current, ok := s.repo.FindByName(name)
+	if ok {
+		audit.before = auditClusterConfig(current)
+	} else {
+		current = config.ClusterConfig{Name: name}
+	}
+	if err := s.checkWritable(current); err != nil {
+		return err
+	}
 	return s.repo.Save(cfg)
 }
 
 // DeleteCluster removes a cluster configuration.
-func (s *ClusterService) DeleteCluster(name string) error {
+func (s *ClusterService) DeleteCluster(name string) (err error) {
+	audit := s.audit(domain.AuditClusterDelete, name, domain.ClusterResource)
+	defer func() { audit.done(err) }()
+
+	if err := s.authorize(domain.ActionClusterAdmin, name, domain.ClusterResource); err != nil {
+		return err
+	}
+	if cfg, ok := s.repo.FindByName(name); ok {
+		audit.before = auditClusterConfig(cfg)
+		if err := s.checkWritable(cfg); err != nil {
+			return err
+		}
+	}
 	return s.repo.Delete(name)
 }
 
@@ -58,12 +140,17 @@ func (s *ClusterService) GetClusterInfo(name string) (*domain.Cluster, *domain.C
 	if !ok {
 		return nil, nil, ErrClusterNotFound
 	}
+	if err := s.authorize(domain.ActionView, name, domain.ClusterResource); err != nil {
+		return nil, nil, err
+	}
 
 	cluster := &domain.Cluster{
-		ID:       cfg.Name,
-		Name:     cfg.Name,
-		Brokers:  cfg.Brokers,
-		AuthType: cfg.GetAuthType(),
+		ID:        cfg.Name,
+		Name:      cfg.Name,
+		Brokers:   cfg.Brokers,
+		AuthType:  cfg.GetAuthType(),
+		ReadOnly:  cfg.ReadOnly || s.repo.IsReadOnly(),
+		Protected: cfg.Protected,
 	}
 	if cfg.HasCertificate() {
 		if certInfo, err := cfg.GetCertificateInfo(); err == nil {
@@ -98,12 +185,17 @@ func (s *ClusterService) GetClusterDetail(name string) (*domain.Cluster, map[str
 	if !ok {
 		return nil, nil, nil, nil, nil, ErrClusterNotFound
 	}
+	if err := s.authorize(domain.ActionView, name, domain.ClusterResource); err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
 
 	cluster := &domain.Cluster{
-		ID:       cfg.Name,
-		Name:     cfg.Name,
-		Brokers:  cfg.Brokers,
-		AuthType: cfg.GetAuthType(),
+		ID:        cfg.Name,
+		Name:      cfg.Name,
+		Brokers:   cfg.Brokers,
+		AuthType:  cfg.GetAuthType(),
+		ReadOnly:  cfg.ReadOnly || s.repo.IsReadOnly(),
+		Protected: cfg.Protected,
 	}
 	if cfg.HasCertificate() {
 		if certInfo, err := cfg.GetCertificateInfo(); err == nil {
@@ -127,7 +219,7 @@ func (s *ClusterService) GetClusterDetail(name string) (*domain.Cluster, map[str
 	cluster.IsOnline = client.IsHealthy()
 	if cluster.IsOnline {
 		if tl, err := client.ListTopics(false); err == nil {
-			topics = tl
+			topics = filterAllowed(s, name, domain.ResourceTopic, tl)
 		} else {
 			utils.Logger.Error("list topics failed", "cluster", name, "err", err)
 		}
@@ -142,7 +234,7 @@ func (s *ClusterService) GetClusterDetail(name string) (*domain.Cluster, map[str
 			utils.Logger.Error("get broker details failed", "cluster", name, "err", err)
 		}
 		if cg, err := client.ListConsumerGroups(); err == nil {
-			consumerGroups = cg
+			consumerGroups = s.filterGroups(name, cg)
 		} else {
 			utils.Logger.Error("list consumer groups failed", "cluster", name, "err", err)
 		}
@@ -150,3 +242,26 @@ func (s *ClusterService) GetClusterDetail(name string) (*domain.Cluster, map[str
 
 	return cluster, topics, stats, brokerDetails, consumerGroups, nil
 }
+
+// GetClusterInternals returns the metadata quorum, feature levels and broker API versions of a cluster.
+func (s *ClusterService) GetClusterInternals(name string) (*domain.ClusterInternals, error) {
+	if _, ok := s.repo.FindByName(name); !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.authorize(domain.ActionView, name, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(name)
+	if !ok {
+		utils.Logger.Warn("get cluster internals client not found", "cluster", name)
+		return nil, ErrClusterNotFound
+	}
+
+	internals, err := client.GetClusterInternals()
+	if err != nil {
+		utils.Logger.Error("get cluster internals failed", "cluster", name, "err", err)
+		return nil, err
+	}
+	return internals, nil
+}
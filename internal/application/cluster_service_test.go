@@ -6,6 +6,7 @@ import (
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,6 +30,10 @@ func TestClusterService_ListAndGetAndAdd(t *testing.T) {
 	// add valid
 	err = svc.AddCluster(config.ClusterConfig{Name: "c2", Brokers: []string{"b2"}})
 	require.NoError(t, err)
+
+	// adding does not replace an existing cluster
+	err = svc.AddCluster(config.ClusterConfig{Name: "c1", Brokers: []string{"b3"}})
+	require.ErrorIs(t, err, ErrClusterExists)
 }
 
 func TestClusterService_GetClusterInfo(t *testing.T) {
@@ -63,3 +68,61 @@ func TestClusterService_GetClusterDetail_Offline(t *testing.T) {
 	require.Empty(t, brokers)
 	require.Empty(t, cgs)
 }
+
+func TestClusterService_GetClusterInternals(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	repo.Clients["c1"] = &testutil.FakeKafkaClient{Healthy: true, Internals: &domain.ClusterInternals{
+		Mode:     domain.ClusterModeKRaft,
+		Features: []domain.Feature{{Name: domain.MetadataVersionFeature, Finalized: true, FinalizedMax: 20}},
+	}}
+
+	svc := NewClusterService(repo)
+	_, err := svc.GetClusterInternals("unknown")
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	internals, err := svc.GetClusterInternals("c1")
+	require.NoError(t, err)
+	require.Equal(t, domain.ClusterModeKRaft, internals.Mode)
+	level, ok := internals.MetadataVersion()
+	require.True(t, ok)
+	require.Equal(t, int16(20), level)
+}
+
+func TestClusterService_ReadOnly(t *testing.T) {
+	t.Parallel()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{
+		{Name: "c1", Brokers: []string{"b1"}, ReadOnly: true},
+		{Name: "c2", Brokers: []string{"b2"}, Protected: true},
+	}
+	repo.Clients["c1"] = &testutil.FakeKafkaClient{Topics: map[string]int{"t": 1}}
+	repo.Clients["c2"] = &testutil.FakeKafkaClient{Topics: map[string]int{"t": 1}}
+	svc := NewClusterService(repo)
+	topics := NewTopicService(svc)
+
+	require.ErrorIs(t, svc.CheckWritable("c1"), ErrReadOnly)
+	require.NoError(t, svc.CheckWritable("c2"))
+	require.ErrorIs(t, svc.CheckWritable("missing"), ErrClusterNotFound)
+	require.ErrorIs(t, svc.DeleteCluster("c1"), ErrReadOnly)
+	require.ErrorIs(t, svc.AddCluster(config.ClusterConfig{Name: "c1", Brokers: []string{"b3"}}), ErrClusterExists)
+	cfg, _ := repo.FindByName("c1")
+	require.True(t, cfg.ReadOnly)
+	require.ErrorIs(t, topics.CreateTopic("c1", domain.CreateTopicRequest{Name: "new", NumPartitions: 1, ReplicationFactor: 1}), ErrReadOnly)
+	require.ErrorIs(t, topics.DeleteTopic("c1", "t", domain.DeleteTopicRequest{Confirmation: "t"}), ErrReadOnly)
+	require.ErrorIs(t, topics.WriteMessage("c1", "t", domain.Message{Value: []byte("v")}), ErrReadOnly)
+
+	cluster, _, err := svc.GetClusterInfo("c2")
+	require.NoError(t, err)
+	require.True(t, cluster.Protected)
+	require.False(t, cluster.ReadOnly)
+
+	// the global flag covers every cluster
+	repo.ReadOnly = true
+	require.True(t, svc.IsReadOnly())
+	require.ErrorIs(t, svc.CheckWritable("c2"), ErrReadOnly)
+	require.ErrorIs(t, topics.IncreasePartitions("c2", "t", domain.IncreasePartitionsRequest{TotalPartitions: 2}), ErrReadOnly)
+	require.ErrorIs(t, svc.AddCluster(config.ClusterConfig{Name: "c3", Brokers: []string{"b3"}}), ErrReadOnly)
+}
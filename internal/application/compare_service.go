@@ -0,0 +1,191 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// compareConcurrency bounds the topics described at the same time by a cluster comparison.
+const compareConcurrency = 8
+
+// CompareService finds drift between the topics of two configured clusters.
+type CompareService struct {
+	topicService *TopicService
+}
+
+// NewCompareService creates a new compare service.
+func NewCompareService(topicService *TopicService) *CompareService {
+	return &CompareService{topicService: topicService}
+}
+
+// CompareClusters compares every non-internal topic of the source cluster with the topic of the same name in the target.
+func (s *CompareService) CompareClusters(source, target string) (domain.TopicComparison, error) {
+	cmp := domain.TopicComparison{SourceCluster: source, TargetCluster: target}
+	if strings.TrimSpace(source) == "" || strings.TrimSpace(target) == "" {
+		return cmp, ErrInvalidComparison
+	}
+
+	sourceTopics, err := s.topicService.ListTopics(source, false)
+	if err != nil {
+		return cmp, err
+	}
+	targetTopics, err := s.topicService.ListTopics(target, false)
+	if err != nil {
+		return cmp, err
+	}
+
+	var common []string
+	for name := range sourceTopics {
+		if _, ok := targetTopics[name]; ok {
+			common = append(common, name)
+		} else {
+			cmp.OnlyInSource = append(cmp.OnlyInSource, name)
+		}
+	}
+	for name := range targetTopics {
+		if _, ok := sourceTopics[name]; !ok {
+			cmp.OnlyInTarget = append(cmp.OnlyInTarget, name)
+		}
+	}
+	sort.Strings(common)
+	sort.Strings(cmp.OnlyInSource)
+	sort.Strings(cmp.OnlyInTarget)
+
+	// Topics are described concurrently and compared in name order.
+	type described struct {
+		src, dst *domain.TopicDetail
+		err      error
+	}
+	details := make([]described, len(common))
+	sem := make(chan struct{}, compareConcurrency)
+	var wg sync.WaitGroup
+	for i, name := range common {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			details[i].src, details[i].dst, details[i].err = s.describeTopics(source, name, target, name)
+		}()
+	}
+	wg.Wait()
+
+	for i, name := range common {
+		if details[i].err != nil {
+			return cmp, details[i].err
+		}
+		recordComparison(&cmp, details[i].src, details[i].dst, name, name)
+	}
+	return cmp, nil
+}
+
+// CompareTopics compares a single topic of the source cluster with a topic of the target cluster.
+// Both clusters may be the same, and the target topic defaults to the source topic name.
+func (s *CompareService) CompareTopics(source, sourceTopic, target, targetTopic string) (domain.TopicComparison, error) {
+	cmp := domain.TopicComparison{SourceCluster: source, TargetCluster: target}
+	if targetTopic == "" {
+		targetTopic = sourceTopic
+	}
+	if strings.TrimSpace(source) == "" || strings.TrimSpace(target) == "" || strings.TrimSpace(sourceTopic) == "" {
+		return cmp, ErrInvalidComparison
+	}
+
+	sourceTopics, err := s.topicService.ListTopics(source, true)
+	if err != nil {
+		return cmp, err
+	}
+	targetTopics, err := s.topicService.ListTopics(target, true)
+	if err != nil {
+		return cmp, err
+	}
+	_, inSource := sourceTopics[sourceTopic]
+	_, inTarget := targetTopics[targetTopic]
+	if !inSource || !inTarget {
+		if inSource {
+			cmp.OnlyInSource = append(cmp.OnlyInSource, sourceTopic)
+		}
+		if inTarget {
+			cmp.OnlyInTarget = append(cmp.OnlyInTarget, targetTopic)
+		}
+		return cmp, nil
+	}
+
+	if err := s.compareTopic(&cmp, sourceTopic, targetTopic); err != nil {
+		return cmp, err
+	}
+	return cmp, nil
+}
+
+func (s *CompareService) compareTopic(cmp *domain.TopicComparison, sourceTopic, targetTopic string) error {
+	src, dst, err := s.describeTopics(cmp.SourceCluster, sourceTopic, cmp.TargetCluster, targetTopic)
+	if err != nil {
+		return err
+	}
+	recordComparison(cmp, src, dst, sourceTopic, targetTopic)
+	return nil
+}
+
+// describeTopics describes the source topic and the target topic being compared.
+func (s *CompareService) describeTopics(source, sourceTopic, target, targetTopic string) (src, dst *domain.TopicDetail, err error) {
+	src, err = s.topicService.GetTopicDetail(source, sourceTopic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("describe %s on %s: %w", sourceTopic, source, err)
+	}
+	dst, err = s.topicService.GetTopicDetail(target, targetTopic)
+	if err != nil {
+		return nil, nil, fmt.Errorf("describe %s on %s: %w", targetTopic, target, err)
+	}
+	return src, dst, nil
+}
+
+// recordComparison adds the outcome of comparing two described topics to cmp.
+func recordComparison(cmp *domain.TopicComparison, src, dst *domain.TopicDetail, sourceTopic, targetTopic string) {
+	if src == nil || dst == nil {
+		// The topic was deleted between listing and describing it.
+		if src != nil {
+			cmp.OnlyInSource = append(cmp.OnlyInSource, sourceTopic)
+		}
+		if dst != nil {
+			cmp.OnlyInTarget = append(cmp.OnlyInTarget, targetTopic)
+		}
+		return
+	}
+
+	if diff, ok := diffTopicDetails(src, dst, sourceTopic, targetTopic); ok {
+		cmp.Differences = append(cmp.Differences, diff)
+	} else {
+		cmp.Matching++
+	}
+}
+
+// diffTopicDetails returns the differences between two topics and whether there are any.
+func diffTopicDetails(src, dst *domain.TopicDetail, sourceTopic, targetTopic string) (domain.TopicDifference, bool) {
+	diff := domain.TopicDifference{
+		SourceTopic:             sourceTopic,
+		TargetTopic:             targetTopic,
+		SourcePartitions:        src.Partitions,
+		TargetPartitions:        dst.Partitions,
+		SourceReplicationFactor: src.ReplicationFactor,
+		TargetReplicationFactor: dst.ReplicationFactor,
+	}
+
+	keys := make(map[string]struct{}, len(src.Configs))
+	for k := range src.Configs {
+		keys[k] = struct{}{}
+	}
+	for k := range dst.Configs {
+		keys[k] = struct{}{}
+	}
+	for k := range keys {
+		if src.Configs[k] != dst.Configs[k] {
+			diff.Configs = append(diff.Configs, domain.ConfigDifference{Key: k, Source: src.Configs[k], Target: dst.Configs[k]})
+		}
+	}
+	sort.Slice(diff.Configs, func(i, j int) bool { return diff.Configs[i].Key < diff.Configs[j].Key })
+
+	return diff, diff.PartitionsDiffer() || diff.ReplicationDiffers() || len(diff.Configs) > 0
+}
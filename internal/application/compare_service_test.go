@@ -0,0 +1,131 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func newCompareFixture(t *testing.T) *CompareService {
+	t.Helper()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "staging", Brokers: []string{"b1"}}, {Name: "prod", Brokers: []string{"b2"}}}
+
+	staging := testutil.NewFakeKafkaClient()
+	staging.Topics = map[string]int{"orders": 3, "payments": 1, "scratch": 1}
+	staging.TopicDetail = &domain.TopicDetail{
+		Name:              "orders",
+		Partitions:        3,
+		ReplicationFactor: 2,
+		Configs:           map[string]string{"retention.ms": "1000", "cleanup.policy": "delete"},
+	}
+	prod := testutil.NewFakeKafkaClient()
+	prod.Topics = map[string]int{"orders": 6, "payments": 1, "audit": 1}
+	prod.TopicDetail = &domain.TopicDetail{
+		Name:              "orders",
+		Partitions:        6,
+		ReplicationFactor: 3,
+		Configs:           map[string]string{"retention.ms": "2000", "min.insync.replicas": "2", "cleanup.policy": "delete"},
+	}
+	repo.Clients["staging"] = staging
+	repo.Clients["prod"] = prod
+
+	return NewCompareService(NewTopicService(NewClusterService(repo)))
+}
+
+func TestCompareService_CompareClusters(t *testing.T) {
+	t.Parallel()
+	svc := newCompareFixture(t)
+
+	cmp, err := svc.CompareClusters("staging", "prod")
+	require.NoError(t, err)
+	require.False(t, cmp.InSync())
+	require.Equal(t, []string{"scratch"}, cmp.OnlyInSource)
+	require.Equal(t, []string{"audit"}, cmp.OnlyInTarget)
+	require.Len(t, cmp.Differences, 2)
+
+	diff := cmp.Differences[0]
+	require.Equal(t, "orders", diff.SourceTopic)
+	require.True(t, diff.PartitionsDiffer())
+	require.True(t, diff.ReplicationDiffers())
+	require.Equal(t, []domain.ConfigDifference{
+		{Key: "min.insync.replicas", Target: "2"},
+		{Key: "retention.ms", Source: "1000", Target: "2000"},
+	}, diff.Configs)
+
+	_, err = svc.CompareClusters("staging", "missing")
+	require.ErrorIs(t, err, ErrClusterNotFound)
+	_, err = svc.CompareClusters("", "prod")
+	require.ErrorIs(t, err, ErrInvalidComparison)
+}
+
+func TestCompareService_CompareTopics(t *testing.T) {
+	t.Parallel()
+	svc := newCompareFixture(t)
+
+	cmp, err := svc.CompareTopics("staging", "orders", "staging", "payments")
+	require.NoError(t, err)
+	require.True(t, cmp.InSync())
+	require.Equal(t, 1, cmp.Matching)
+
+	cmp, err = svc.CompareTopics("staging", "scratch", "prod", "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"scratch"}, cmp.OnlyInSource)
+	require.Empty(t, cmp.Differences)
+
+	_, err = svc.CompareTopics("staging", "", "prod", "orders")
+	require.ErrorIs(t, err, ErrInvalidComparison)
+}
+
+// describeCounter tracks how many topics are described at the same time.
+type describeCounter struct {
+	*testutil.FakeKafkaClient
+	inFlight, peak atomic.Int32
+	err            error
+}
+
+func (c *describeCounter) GetTopicDetail(name string) (*domain.TopicDetail, error) {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for p := c.peak.Load(); n > p && !c.peak.CompareAndSwap(p, n); p = c.peak.Load() {
+	}
+	time.Sleep(5 * time.Millisecond)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.FakeKafkaClient.GetTopicDetail(name)
+}
+
+func TestCompareService_CompareClustersDescribesConcurrently(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "staging", Brokers: []string{"b1"}}, {Name: "prod", Brokers: []string{"b2"}}}
+	client := &describeCounter{FakeKafkaClient: testutil.NewFakeKafkaClient()}
+	client.TopicDetail = &domain.TopicDetail{Partitions: 3, ReplicationFactor: 3}
+	for i := range 40 {
+		client.Topics[fmt.Sprintf("topic-%02d", i)] = 3
+	}
+	repo.Clients["staging"] = client
+	repo.Clients["prod"] = client
+	svc := NewCompareService(NewTopicService(NewClusterService(repo)))
+
+	cmp, err := svc.CompareClusters("staging", "prod")
+	require.NoError(t, err)
+	require.Equal(t, 40, cmp.Matching)
+	require.Greater(t, client.peak.Load(), int32(1))
+	require.LessOrEqual(t, client.peak.Load(), int32(compareConcurrency))
+
+	client.err = errors.New("boom")
+	_, err = svc.CompareClusters("staging", "prod")
+	require.ErrorContains(t, err, "describe topic-00 on staging: boom")
+}
@@ -0,0 +1,183 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// saslMechanisms are the SASL mechanisms the Kafka clients support.
+var saslMechanisms = []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA256", "SCRAM-SHA-512", "SCRAM-SHA512"}
+
+// ValidateConfig reports every problem of a configuration file the server would only run into later,
+// such as a cluster without brokers, a missing certificate file or a role binding to an unknown role.
+// The problems are joined into one error, one per line.
+func ValidateConfig(cfg config.FileConfig) error {
+	var errs []error
+	add := func(format string, args ...any) { errs = append(errs, fmt.Errorf(format, args...)) }
+
+	seen := map[string]bool{}
+	for i, c := range cfg.Clusters {
+		where := fmt.Sprintf("clusters[%d]", i)
+		if c.Name == "" {
+			add("%s: name is required", where)
+		} else {
+			where = fmt.Sprintf("cluster %s", c.Name)
+			if seen[c.Name] {
+				add("%s: declared more than once", where)
+			}
+			seen[c.Name] = true
+		}
+		if len(c.Brokers) == 0 {
+			add("%s: at least one broker is required", where)
+		}
+		if c.TLS != nil && c.TLS.Enabled {
+			for _, f := range []string{c.TLS.CAFile, c.TLS.CertFile, c.TLS.KeyFile} {
+				if err := checkFile(f); err != nil {
+					add("%s: tls: %w", where, err)
+				}
+			}
+			if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+				add("%s: tls: cert_file and key_file must be set together", where)
+			}
+		}
+		if c.SASL != nil && c.SASL.Mechanism != "" {
+			if !slices.Contains(saslMechanisms, strings.ToUpper(c.SASL.Mechanism)) {
+				add("%s: sasl: unknown mechanism %q", where, c.SASL.Mechanism)
+			}
+			if c.SASL.Username == "" && c.SASL.UsernameEnv == "" {
+				add("%s: sasl: username or username_env is required", where)
+			}
+		}
+		if c.ReadOnly && c.Protected {
+			add("%s: read_only and protected are exclusive", where)
+		}
+		if p := c.TopicPolicy; p != nil {
+			if _, err := regexp.Compile(p.NamePattern); err != nil {
+				add("%s: topic_policy: invalid name_pattern: %w", where, err)
+			}
+			if p.MaxPartitions > 0 && p.MinPartitions > p.MaxPartitions {
+				add("%s: topic_policy: min_partitions is above max_partitions", where)
+			}
+		}
+	}
+
+	if sv := cfg.Server; sv != nil {
+		if sv.BasePath != "" && (!strings.HasPrefix(sv.BasePath, "/") || strings.ContainsAny(sv.BasePath, "?#")) {
+			add("server: base_path must be a path starting with /")
+		}
+		timeouts := [][2]string{{"read_timeout", sv.ReadTimeout}, {"write_timeout", sv.WriteTimeout}, {"idle_timeout", sv.IdleTimeout}, {"shutdown_timeout", sv.ShutdownTimeout}}
+		for _, t := range timeouts {
+			if t[1] == "" {
+				continue
+			}
+			if d, err := time.ParseDuration(t[1]); err != nil {
+				add("server: %s: %w", t[0], err)
+			} else if d < 0 {
+				add("server: %s must not be negative", t[0])
+			}
+		}
+		if sv.MaxBodyBytes < 0 {
+			add("server: max_body_bytes must not be negative")
+		}
+		for _, p := range sv.TrustedProxies {
+			if _, err := netip.ParsePrefix(p); err != nil {
+				if _, err := netip.ParseAddr(p); err != nil {
+					add("server: trusted_proxies: %q is neither an address nor a CIDR range", p)
+				}
+			}
+		}
+		if t := sv.TLS; t != nil {
+			if t.CertFile == "" || t.KeyFile == "" {
+				add("server: tls: cert_file and key_file are required")
+			}
+			for _, f := range []string{t.CertFile, t.KeyFile} {
+				if err := checkFile(f); err != nil {
+					add("server: tls: %w", err)
+				}
+			}
+		}
+	}
+
+	templates := map[string]bool{}
+	for i, t := range cfg.TopicTemplates {
+		if t.Name == "" {
+			add("topic_templates[%d]: name is required", i)
+		} else if templates[t.Name] {
+			add("topic template %s: declared more than once", t.Name)
+		}
+		templates[t.Name] = true
+	}
+
+	if a := cfg.Auth; a != nil {
+		for _, f := range []string{a.UsersFile, a.HtpasswdFile} {
+			if err := checkFile(f); err != nil {
+				add("auth: %w", err)
+			}
+		}
+		if a.SessionTTL != "" {
+			if _, err := time.ParseDuration(a.SessionTTL); err != nil {
+				add("auth: session_ttl: %w", err)
+			}
+		}
+		if o := a.OIDC; o != nil && (o.Issuer == "" || o.ClientID == "" || o.RedirectURL == "") {
+			add("auth: oidc: issuer, client_id and redirect_url are required")
+		}
+		if a.TokensFile != "" && !a.Enabled() {
+			add("auth: tokens_file needs a users_file, htpasswd_file or oidc to sign in with")
+		}
+	}
+
+	if r := cfg.RBAC; r != nil {
+		roles := map[string]bool{}
+		for _, role := range r.Roles {
+			roles[role.Name] = true
+			for _, p := range role.Permissions {
+				for _, a := range p.Actions {
+					if !slices.Contains(domain.Actions, domain.Action(a)) {
+						add("rbac: role %s: unknown action %q", role.Name, a)
+					}
+				}
+			}
+		}
+		for _, b := range r.Bindings {
+			if !roles[b.Role] {
+				add("rbac: binding to unknown role %q", b.Role)
+			}
+		}
+		if !cfg.Auth.Enabled() {
+			add("rbac: roles need auth to sign users in")
+		}
+	}
+
+	if cfg.Audit != nil && cfg.Audit.File == "" {
+		add("audit: file is required")
+	}
+
+	for _, q := range cfg.QuarantinedTopics {
+		if !seen[q.Cluster] {
+			add("quarantined topic %s: unknown cluster %q", q.Topic, q.Cluster)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkFile reports whether a configured file can be read. Unset files are fine.
+func checkFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
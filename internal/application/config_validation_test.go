@@ -0,0 +1,72 @@
+package application
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+	valid := config.FileConfig{
+		Clusters: []config.ClusterConfig{{Name: "dev", Brokers: []string{"localhost:9092"}, SASL: &config.SASLConfig{Mechanism: "scram-sha-512", Username: "admin"}}},
+		Auth:     &config.AuthConfig{OIDC: &config.OIDCConfig{Issuer: "https://idp", ClientID: "scout", RedirectURL: "https://scout/callback"}},
+		RBAC: &config.RBACConfig{
+			Roles:    []config.Role{{Name: "viewer", Permissions: []config.Permission{{Actions: []string{"view"}}}}},
+			Bindings: []config.RoleBinding{{Role: "viewer", Users: []string{"*"}}},
+		},
+	}
+	valid.Server = &config.ServerConfig{BasePath: "/tools/kafka", ReadTimeout: "30s", TrustedProxies: []string{"10.0.0.0/8", "::1"}}
+	require.NoError(t, ValidateConfig(valid))
+	require.NoError(t, ValidateConfig(config.FileConfig{}))
+
+	missing := filepath.Join(t.TempDir(), "missing.pem")
+	invalid := config.FileConfig{
+		Clusters: []config.ClusterConfig{
+			{Name: "dev", Brokers: []string{"localhost:9092"}, TLS: &config.TLSConfig{Enabled: true, CAFile: missing}},
+			{Name: "dev", SASL: &config.SASLConfig{Mechanism: "GSSAPI"}, TopicPolicy: &config.TopicPolicy{NamePattern: "(", MinPartitions: 6, MaxPartitions: 3}},
+		},
+		Auth: &config.AuthConfig{SessionTTL: "a day", TokensFile: "tokens.yml"},
+		RBAC: &config.RBACConfig{
+			Roles:    []config.Role{{Name: "viewer", Permissions: []config.Permission{{Actions: []string{"read"}}}}},
+			Bindings: []config.RoleBinding{{Role: "admin"}},
+		},
+		QuarantinedTopics: []config.QuarantinedTopic{{Cluster: "prod", Topic: "orders"}},
+		Server: &config.ServerConfig{
+			BasePath:       "tools/kafka",
+			ReadTimeout:    "soon",
+			TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1", "proxy"},
+			TLS:            &config.ServerTLSConfig{CertFile: missing},
+		},
+	}
+	err := ValidateConfig(invalid)
+	require.Error(t, err)
+	lines := strings.Split(err.Error(), "\n")
+	for _, want := range []string{
+		"cluster dev: tls: open " + missing,
+		"cluster dev: declared more than once",
+		"cluster dev: at least one broker is required",
+		`cluster dev: sasl: unknown mechanism "GSSAPI"`,
+		"cluster dev: sasl: username or username_env is required",
+		"cluster dev: topic_policy: invalid name_pattern",
+		"cluster dev: topic_policy: min_partitions is above max_partitions",
+		"auth: session_ttl",
+		"auth: tokens_file needs",
+		`rbac: role viewer: unknown action "read"`,
+		`rbac: binding to unknown role "admin"`,
+		"rbac: roles need auth",
+		`quarantined topic orders: unknown cluster "prod"`,
+		"server: base_path must be a path starting with /",
+		"server: read_timeout",
+		`server: trusted_proxies: "proxy" is neither`,
+		"server: tls: cert_file and key_file are required",
+		"server: tls: open " + missing,
+	} {
+		require.True(t, slices.ContainsFunc(lines, func(l string) bool { return strings.HasPrefix(l, want) }), "missing %q in\n%s", want, err)
+	}
+	require.Len(t, lines, 18)
+}
@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"strings"
 
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
@@ -25,12 +26,19 @@ func NewConsumerGroupsService(clusterService *ClusterService) *ConsumerGroupsSer
 	}
 }
 
-// ListConsumerGroupsWithLagFromTopic returns the lag for all consumer groups from a specific topic.
+// ListConsumerGroupsWithLagFromTopic returns the lag for the consumer groups of a specific topic the user may view.
 func (s *ConsumerGroupsService) ListConsumerGroupsWithLagFromTopic(ctx context.Context, clusterName, topicName string) (kadm.DescribedGroupLags, error) {
 	_, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return nil, ErrClusterNotFound
 	}
+	res := domain.TopicResource(topicName)
+	if topicName == "" {
+		res = domain.ClusterResource
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, res); err != nil {
+		return nil, err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -38,7 +46,11 @@ func (s *ConsumerGroupsService) ListConsumerGroupsWithLagFromTopic(ctx context.C
 		return nil, ErrClusterNotFound
 	}
 
-	return client.ListConsumerGroupsWithLagFromTopic(ctx, nil, topicName)
+	lags, err := client.ListConsumerGroupsWithLagFromTopic(ctx, nil, topicName)
+	if err != nil {
+		return nil, err
+	}
+	return filterAllowed(s.clusterService, clusterName, domain.ResourceGroup, lags), nil
 }
 
 // FetchConsumerGroupWithLag returns the lag for a specific consumer group.
@@ -47,6 +59,9 @@ func (s *ConsumerGroupsService) FetchConsumerGroupWithLag(ctx context.Context, c
 	if !ok {
 		return kadm.DescribedGroupLag{}, ErrClusterNotFound
 	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.GroupResource(groupName)); err != nil {
+		return kadm.DescribedGroupLag{}, err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -66,6 +81,49 @@ func (s *ConsumerGroupsService) FetchConsumerGroupWithLag(ctx context.Context, c
 	return kadm.DescribedGroupLag{}, nil
 }
 
+// ResetOffsets moves the committed offsets of a consumer group. A dry run only reports the new offsets,
+// so it needs no more than the permission to view the group.
+func (s *ConsumerGroupsService) ResetOffsets(ctx context.Context, clusterName, groupName string, req domain.ResetOffsetsRequest) (_ []domain.OffsetReset, err error) {
+	if !req.DryRun {
+		audit := s.clusterService.audit(domain.AuditGroupResetOffsets, clusterName, domain.GroupResource(groupName))
+		audit.after = req
+		defer func() { audit.done(err) }()
+	}
+
+	if strings.TrimSpace(groupName) == "" {
+		return nil, ErrInvalidOffsetReset
+	}
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	action := domain.ActionView
+	if !req.DryRun {
+		if err := s.clusterService.CheckWritable(clusterName); err != nil {
+			return nil, err
+		}
+		action = domain.ActionGroupAdmin
+	}
+	if err := s.clusterService.authorize(action, clusterName, domain.GroupResource(groupName)); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("reset offsets client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+	resets, err := client.ResetConsumerGroupOffsets(ctx, groupName, req)
+	if err != nil {
+		utils.Logger.Error("reset consumer group offsets failed", "cluster", clusterName, "group", groupName, "err", err)
+		return nil, err
+	}
+	if !req.DryRun {
+		utils.Logger.Info("consumer group offsets reset", "cluster", clusterName, "group", groupName, "topic", req.Topic, "to", req.To.String(), "partitions", len(resets))
+	}
+	return resets, nil
+}
+
 // GetTopicsLags calculates and returns the total lag for each topic within a consumer group.
 func (s *ConsumerGroupsService) GetTopicsLags(group kadm.GroupLag) kadm.GroupTopicsLag {
 	return group.TotalByTopic()
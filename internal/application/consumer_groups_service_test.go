@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/testutil"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
 	"github.com/stretchr/testify/require"
@@ -39,3 +40,31 @@ func TestConsumerGroupsService_ListConsumerGroupsWithLagFromTopic(t *testing.T)
 	require.NoError(t, err)
 	require.Equal(t, lagMap, lags)
 }
+
+func TestConsumerGroupsService_ResetOffsets(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}, ReadOnly: true}}
+	client := testutil.NewFakeKafkaClient()
+	client.OffsetResets = []domain.OffsetReset{{Topic: "orders", Partition: 0, From: 10, To: 0}}
+	repo.Clients["c1"] = client
+	svc := NewConsumerGroupsService(NewClusterService(repo))
+	req := domain.ResetOffsetsRequest{Topic: "orders", To: domain.OffsetSpec{Position: domain.OffsetEarliest}}
+
+	_, err := svc.ResetOffsets(context.Background(), "c1", "", req)
+	require.ErrorIs(t, err, ErrInvalidOffsetReset)
+	_, err = svc.ResetOffsets(context.Background(), "unknown", "g1", req)
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	// read-only clusters refuse the reset but still answer a dry run
+	_, err = svc.ResetOffsets(context.Background(), "c1", "g1", req)
+	require.ErrorIs(t, err, ErrReadOnly)
+	require.Nil(t, client.ResetRequest)
+
+	req.DryRun = true
+	resets, err := svc.ResetOffsets(context.Background(), "c1", "g1", req)
+	require.NoError(t, err)
+	require.Equal(t, client.OffsetResets, resets)
+	require.Equal(t, &req, client.ResetRequest)
+}
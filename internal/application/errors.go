@@ -5,9 +5,35 @@ import "errors"
 // Application-level error constants for common use cases
 var (
 	ErrClusterNotFound          = errors.New("cluster not found")
+	ErrClusterExists            = errors.New("cluster already exists")
 	ErrInvalidClusterConfig     = errors.New("invalid cluster configuration")
 	ErrInvalidTopicName         = errors.New("topic name is required")
 	ErrInvalidPartitionCount    = errors.New("partition count must be greater than 0")
 	ErrInvalidReplicationFactor = errors.New("replication factor must be greater than 0")
 	ErrInvalidTopicConfig       = errors.New("topic configs are required")
+	ErrInvalidDeleteRecords     = errors.New("exactly one of offsets, timestamp or all is required")
+	ErrInvalidACL               = errors.New("principal, resource, operation and permission are required")
+	ErrInvalidACLFilter         = errors.New("invalid acl filter")
+	ErrInvalidSCRAMUser         = errors.New("user name, password and a SCRAM-SHA-256 or SCRAM-SHA-512 mechanism are required")
+	ErrInvalidSCRAMIterations   = errors.New("scram iterations must be between 4096 and 16384")
+	ErrInvalidQuotaEntity       = errors.New("quota entity must have a user and/or client-id component")
+	ErrInvalidQuota             = errors.New("unknown quota key or negative quota value")
+	ErrInvalidAbortTransaction  = errors.New("topic, partition and producer id are required")
+	ErrInvalidOffsetReset       = errors.New("consumer group name is required")
+	ErrInvalidTopicState        = errors.New("invalid desired topic state")
+	ErrInvalidComparison        = errors.New("source and target clusters are required")
+	ErrTopicTemplateNotFound    = errors.New("topic template not found")
+	ErrTopicPolicyViolation     = errors.New("topic policy violation")
+	ErrInvalidBatchTopics       = errors.New("invalid batch topic operation")
+	ErrTopicDeletionUnconfirmed = errors.New("topic deletion needs a typed confirmation")
+	ErrInvalidQuarantine        = errors.New("invalid topic quarantine")
+	ErrReadOnly                 = errors.New("changes are disabled")
+	ErrInvalidCredentials       = errors.New("invalid username or password")
+	ErrForbidden                = errors.New("permission denied")
+	ErrOIDCDisabled             = errors.New("single sign-on is not configured")
+	ErrTokensDisabled           = errors.New("api tokens are not configured")
+	ErrInvalidToken             = errors.New("invalid api token")
+	ErrInvalidTokenRequest      = errors.New("invalid api token request")
+	ErrTokenNotFound            = errors.New("api token not found")
+	ErrAuditDisabled            = errors.New("audit log is not configured")
 )
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"bytes"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// ExportOptions selects the optional sections of a cluster export.
+type ExportOptions struct {
+	ConsumerGroups bool
+	ACLs           bool
+}
+
+// ExportService dumps the live state of a cluster as a declarative document.
+type ExportService struct {
+	clusterService *ClusterService
+	repo           domain.ClusterRepository
+}
+
+// NewExportService creates a new export service.
+func NewExportService(clusterService *ClusterService) *ExportService {
+	return &ExportService{
+		clusterService: clusterService,
+		repo:           clusterService.getRepo(),
+	}
+}
+
+// Export returns the topics of a cluster, and optionally its consumer group offsets and ACLs,
+// in the same shape the topic plan accepts.
+func (s *ExportService) Export(clusterName string, opts ExportOptions) (domain.DesiredTopicState, error) {
+	var state domain.DesiredTopicState
+	if _, ok := s.clusterService.GetCluster(clusterName); !ok {
+		return state, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return state, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("export client not found", "cluster", clusterName)
+		return state, ErrClusterNotFound
+	}
+
+	var cluster domain.DesiredClusterTopics
+	topics, err := client.ExportTopics()
+	if err != nil {
+		utils.Logger.Error("export topics failed", "cluster", clusterName, "err", err)
+		return state, err
+	}
+	cluster.Topics = topics
+
+	if opts.ConsumerGroups {
+		groups, err := client.ListConsumerGroupOffsets()
+		if err != nil {
+			utils.Logger.Error("export consumer group offsets failed", "cluster", clusterName, "err", err)
+			return state, err
+		}
+		cluster.ConsumerGroups = groups
+	}
+
+	if opts.ACLs {
+		acls, err := client.DescribeACLs(domain.ACLFilter{})
+		if err != nil {
+			utils.Logger.Error("export acls failed", "cluster", clusterName, "err", err)
+			return state, err
+		}
+		cluster.ACLs = acls
+	}
+
+	state.Clusters = map[string]domain.DesiredClusterTopics{clusterName: cluster}
+	utils.Logger.Info("cluster exported", "cluster", clusterName, "topics", len(cluster.Topics))
+	return state, nil
+}
+
+// MarshalDesiredTopicState encodes a desired topic state as YAML.
+func MarshalDesiredTopicState(state domain.DesiredTopicState) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(state); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
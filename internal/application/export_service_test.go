@@ -0,0 +1,47 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportService_Export(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.Exported = []domain.DesiredTopic{{Name: "orders", Partitions: 3, ReplicationFactor: 2, Configs: map[string]string{"retention.ms": "1000"}}}
+	fake.GroupOffsets = []domain.ConsumerGroupOffsets{{Group: "billing", Offsets: []domain.PartitionOffset{{Topic: "orders", Partition: 0, Offset: 42}}}}
+	fake.ACLs = []domain.ACL{{Principal: "User:alice", Host: "*", ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL", Operation: "READ", Permission: "ALLOW"}}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewExportService(cs)
+
+	_, err := svc.Export("unknown", ExportOptions{})
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	state, err := svc.Export("c1", ExportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, fake.Exported, state.Clusters["c1"].Topics)
+	require.Empty(t, state.Clusters["c1"].ConsumerGroups)
+	require.Empty(t, state.Clusters["c1"].ACLs)
+
+	state, err = svc.Export("c1", ExportOptions{ConsumerGroups: true, ACLs: true})
+	require.NoError(t, err)
+	require.Equal(t, fake.GroupOffsets, state.Clusters["c1"].ConsumerGroups)
+	require.Equal(t, fake.ACLs, state.Clusters["c1"].ACLs)
+
+	// the export must round-trip through the plan parser
+	data, err := MarshalDesiredTopicState(state)
+	require.NoError(t, err)
+	parsed, err := ParseDesiredTopicState(data)
+	require.NoError(t, err)
+	require.Equal(t, state, parsed)
+}
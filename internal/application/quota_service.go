@@ -0,0 +1,195 @@
+package application
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// QuotaService provides operations related to client quotas.
+type QuotaService struct {
+	clusterService *ClusterService
+	repo           domain.ClusterRepository
+}
+
+// NewQuotaService creates a new quota service.
+func NewQuotaService(clusterService *ClusterService) *QuotaService {
+	return &QuotaService{
+		clusterService: clusterService,
+		repo:           clusterService.getRepo(),
+	}
+}
+
+// ListQuotas returns every client quota configured in a cluster.
+func (s *QuotaService) ListQuotas(clusterName string) ([]domain.ClientQuota, error) {
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := client.DescribeClientQuotas()
+	if err != nil {
+		utils.Logger.Error("describe client quotas failed", "cluster", clusterName, "err", err)
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// AlterQuotas sets and removes quotas of a user, client-id or user and client-id entity, including defaults.
+func (s *QuotaService) AlterQuotas(clusterName string, req domain.AlterClientQuotaRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditQuotaAlter, clusterName, quotaEntityResource(req.Entity))
+	audit.after = req
+	defer func() { audit.done(err) }()
+
+	if err := validateQuotaEntity(req.Entity); err != nil {
+		return err
+	}
+	if len(req.Set) == 0 && len(req.Remove) == 0 {
+		return ErrInvalidQuota
+	}
+	for key, value := range req.Set {
+		if !slices.Contains(domain.QuotaKeys, key) || value < 0 {
+			return ErrInvalidQuota
+		}
+	}
+	for _, key := range req.Remove {
+		if !slices.Contains(domain.QuotaKeys, key) {
+			return ErrInvalidQuota
+		}
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if quotas, err := client.DescribeClientQuotas(); err == nil {
+		for _, q := range quotas {
+			if sameQuotaEntity(q.Entity, req.Entity) {
+				audit.before = q
+			}
+		}
+	}
+	if err := client.AlterClientQuotas(req); err != nil {
+		utils.Logger.Error("alter client quotas failed", "cluster", clusterName, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("client quotas altered", "cluster", clusterName, "entity", req.Entity, "set", req.Set, "remove", req.Remove)
+	return nil
+}
+
+// EffectiveClientIDQuotas returns the quotas that apply to each client ID when the user principal is unknown,
+// resolving a client-id specific quota before the client-id default.
+func (s *QuotaService) EffectiveClientIDQuotas(clusterName string, clientIDs []string) (map[string]map[string]float64, error) {
+	quotas, err := s.ListQuotas(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]map[string]float64, len(clientIDs))
+	for _, id := range clientIDs {
+		if values := effectiveClientIDQuota(quotas, id); len(values) > 0 {
+			out[id] = values
+		}
+	}
+	return out, nil
+}
+
+func sameQuotaEntity(a, b []domain.QuotaEntityComponent) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, c := range a {
+		if !slices.Contains(b, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// quotaEntityResource is the cluster-wide resource a quota change is recorded against, named after
+// the entity, e.g. user=alice,client-id=<default>.
+func quotaEntityResource(entity []domain.QuotaEntityComponent) domain.Resource {
+	parts := make([]string, 0, len(entity))
+	for _, c := range entity {
+		name := c.Name
+		if c.Default {
+			name = "<default>"
+		}
+		parts = append(parts, c.Type+"="+name)
+	}
+	return domain.Resource{Kind: domain.ResourceCluster, Name: strings.Join(parts, ",")}
+}
+
+func (s *QuotaService) client(clusterName string) (domain.KafkaClient, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("quota client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+	return client, nil
+}
+
+// effectiveClientIDQuota merges the client-id default quota with the quota of the given client ID.
+func effectiveClientIDQuota(quotas []domain.ClientQuota, clientID string) map[string]float64 {
+	var defaults, exact map[string]float64
+	for _, q := range quotas {
+		if len(q.Entity) != 1 || q.Entity[0].Type != domain.QuotaEntityClientID {
+			continue
+		}
+		switch {
+		case q.Entity[0].Default:
+			defaults = q.Values
+		case q.Entity[0].Name == clientID:
+			exact = q.Values
+		}
+	}
+
+	values := make(map[string]float64)
+	for k, v := range defaults {
+		values[k] = v
+	}
+	for k, v := range exact {
+		values[k] = v
+	}
+	return values
+}
+
+// validateQuotaEntity ensures the entity has one user and/or one client-id component, each named or default.
+func validateQuotaEntity(entity []domain.QuotaEntityComponent) error {
+	if len(entity) == 0 || len(entity) > 2 {
+		return ErrInvalidQuotaEntity
+	}
+	seen := map[string]bool{}
+	for i, c := range entity {
+		c.Type = strings.TrimSpace(c.Type)
+		c.Name = strings.TrimSpace(c.Name)
+		if c.Type != domain.QuotaEntityUser && c.Type != domain.QuotaEntityClientID || seen[c.Type] {
+			return ErrInvalidQuotaEntity
+		}
+		if c.Name == "" && !c.Default || c.Name != "" && c.Default {
+			return ErrInvalidQuotaEntity
+		}
+		seen[c.Type] = true
+		entity[i] = c
+	}
+	return nil
+}
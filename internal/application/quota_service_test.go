@@ -0,0 +1,94 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaService_AlterQuotas(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	repo.Clients["c1"] = testutil.NewFakeKafkaClient()
+
+	cs := NewClusterService(repo)
+	svc := NewQuotaService(cs)
+
+	set := map[string]float64{"producer_byte_rate": 1024}
+	user := domain.QuotaEntityComponent{Type: "user", Name: "alice"}
+
+	// invalid entities
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{Set: set}), ErrInvalidQuotaEntity)
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{{Type: "ip", Name: "10.0.0.1"}}, Set: set,
+	}), ErrInvalidQuotaEntity)
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{{Type: "user"}}, Set: set,
+	}), ErrInvalidQuotaEntity)
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user, user}, Set: set,
+	}), ErrInvalidQuotaEntity)
+
+	// invalid quotas
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user},
+	}), ErrInvalidQuota)
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user}, Set: map[string]float64{"fetch_rate": 1},
+	}), ErrInvalidQuota)
+	require.ErrorIs(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user}, Set: map[string]float64{"producer_byte_rate": -1},
+	}), ErrInvalidQuota)
+
+	// missing cluster
+	require.ErrorIs(t, svc.AlterQuotas("unknown", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user}, Set: set,
+	}), ErrClusterNotFound)
+
+	// success with a user and default client-id combination
+	require.NoError(t, svc.AlterQuotas("c1", domain.AlterClientQuotaRequest{
+		Entity: []domain.QuotaEntityComponent{user, {Type: "client-id", Default: true}},
+		Set:    set,
+		Remove: []string{"consumer_byte_rate"},
+	}))
+}
+
+func TestQuotaService_EffectiveClientIDQuotas(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.Quotas = []domain.ClientQuota{
+		{
+			Entity: []domain.QuotaEntityComponent{{Type: "client-id", Default: true}},
+			Values: map[string]float64{"producer_byte_rate": 100, "consumer_byte_rate": 200},
+		},
+		{
+			Entity: []domain.QuotaEntityComponent{{Type: "client-id", Name: "billing"}},
+			Values: map[string]float64{"consumer_byte_rate": 500},
+		},
+		{
+			Entity: []domain.QuotaEntityComponent{{Type: "user", Name: "alice"}, {Type: "client-id", Name: "billing"}},
+			Values: map[string]float64{"request_percentage": 10},
+		},
+	}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewQuotaService(cs)
+
+	quotas, err := svc.EffectiveClientIDQuotas("c1", []string{"billing", "other"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]float64{"producer_byte_rate": 100, "consumer_byte_rate": 500}, quotas["billing"])
+	require.Equal(t, map[string]float64{"producer_byte_rate": 100, "consumer_byte_rate": 200}, quotas["other"])
+
+	_, err = svc.EffectiveClientIDQuotas("unknown", nil)
+	require.ErrorIs(t, err, ErrClusterNotFound)
+}
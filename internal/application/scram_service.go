@@ -0,0 +1,138 @@
+package application
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// SCRAMService provides operations related to SCRAM user credentials.
+type SCRAMService struct {
+	clusterService *ClusterService
+	repo           domain.ClusterRepository
+}
+
+// NewSCRAMService creates a new SCRAM service.
+func NewSCRAMService(clusterService *ClusterService) *SCRAMService {
+	return &SCRAMService{
+		clusterService: clusterService,
+		repo:           clusterService.getRepo(),
+	}
+}
+
+// ListUsers returns the users that have SCRAM credentials in a cluster.
+func (s *SCRAMService) ListUsers(clusterName string) ([]domain.SCRAMUser, error) {
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := client.ListSCRAMUsers()
+	if err != nil {
+		utils.Logger.Error("list scram users failed", "cluster", clusterName, "err", err)
+		return nil, err
+	}
+	return users, nil
+}
+
+// UpsertUser creates or updates a SCRAM credential. Iterations default to the broker minimum when not set.
+func (s *SCRAMService) UpsertUser(clusterName string, req domain.UpsertSCRAMUserRequest) (err error) {
+	req.Name = strings.TrimSpace(req.Name)
+	audit := s.clusterService.audit(domain.AuditSCRAMUpsert, clusterName, scramUserResource(req.Name))
+	defer func() {
+		// The password is never recorded.
+		audit.after = map[string]any{"user": req.Name, "mechanism": req.Mechanism, "iterations": req.Iterations}
+		audit.done(err)
+	}()
+
+	req.Mechanism = strings.ToUpper(strings.TrimSpace(req.Mechanism))
+	if req.Iterations == 0 {
+		req.Iterations = domain.MinSCRAMIterations
+	}
+	if req.Name == "" || req.Password == "" || !slices.Contains(domain.SCRAMMechanisms, req.Mechanism) {
+		return ErrInvalidSCRAMUser
+	}
+	if req.Iterations < domain.MinSCRAMIterations || req.Iterations > domain.MaxSCRAMIterations {
+		return ErrInvalidSCRAMIterations
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpsertSCRAMUser(req); err != nil {
+		utils.Logger.Error("upsert scram user failed", "cluster", clusterName, "user", req.Name, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("scram user upserted", "cluster", clusterName, "user", req.Name, "mechanism", req.Mechanism, "iterations", req.Iterations)
+	return nil
+}
+
+// DeleteUser deletes the SCRAM credential of a user for a mechanism, or all of them when mechanism is empty.
+func (s *SCRAMService) DeleteUser(clusterName, name, mechanism string) (err error) {
+	name = strings.TrimSpace(name)
+	audit := s.clusterService.audit(domain.AuditSCRAMDelete, clusterName, scramUserResource(name))
+	defer func() {
+		audit.before = map[string]string{"user": name, "mechanism": mechanism}
+		audit.done(err)
+	}()
+
+	mechanism = strings.ToUpper(strings.TrimSpace(mechanism))
+	if name == "" || mechanism != "" && !slices.Contains(domain.SCRAMMechanisms, mechanism) {
+		return ErrInvalidSCRAMUser
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionClusterAdmin, clusterName, domain.ClusterResource); err != nil {
+		return err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteSCRAMUser(name, mechanism); err != nil {
+		utils.Logger.Error("delete scram user failed", "cluster", clusterName, "user", name, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("scram user deleted", "cluster", clusterName, "user", name, "mechanism", mechanism)
+	return nil
+}
+
+func (s *SCRAMService) client(clusterName string) (domain.KafkaClient, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("scram client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+	return client, nil
+}
+
+// scramUserResource is the cluster-wide resource a change to the credentials of a user is recorded
+// against, named after the user so the audit log can be searched by it.
+func scramUserResource(name string) domain.Resource {
+	return domain.Resource{Kind: domain.ResourceCluster, Name: name}
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSCRAMService_UpsertAndList(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewSCRAMService(cs)
+
+	// missing cluster
+	_, err := svc.ListUsers("unknown")
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	// missing password
+	err = svc.UpsertUser("c1", domain.UpsertSCRAMUserRequest{Name: "alice", Mechanism: "SCRAM-SHA-256"})
+	require.ErrorIs(t, err, ErrInvalidSCRAMUser)
+
+	// unknown mechanism
+	err = svc.UpsertUser("c1", domain.UpsertSCRAMUserRequest{Name: "alice", Mechanism: "PLAIN", Password: "secret"})
+	require.ErrorIs(t, err, ErrInvalidSCRAMUser)
+
+	// iterations out of range
+	err = svc.UpsertUser("c1", domain.UpsertSCRAMUserRequest{Name: "alice", Mechanism: "SCRAM-SHA-256", Password: "secret", Iterations: 100})
+	require.ErrorIs(t, err, ErrInvalidSCRAMIterations)
+
+	// success with default iterations
+	err = svc.UpsertUser("c1", domain.UpsertSCRAMUserRequest{Name: "alice", Mechanism: "scram-sha-512", Password: "secret"})
+	require.NoError(t, err)
+
+	users, err := svc.ListUsers("c1")
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, domain.SCRAMCredential{Mechanism: "SCRAM-SHA-512", Iterations: 4096}, users[0].Credentials[0])
+}
+
+func TestSCRAMService_DeleteUser(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	repo.Clients["c1"] = testutil.NewFakeKafkaClient()
+
+	cs := NewClusterService(repo)
+	svc := NewSCRAMService(cs)
+
+	require.ErrorIs(t, svc.DeleteUser("c1", "", ""), ErrInvalidSCRAMUser)
+	require.ErrorIs(t, svc.DeleteUser("c1", "alice", "MD5"), ErrInvalidSCRAMUser)
+	require.ErrorIs(t, svc.DeleteUser("unknown", "alice", ""), ErrClusterNotFound)
+	require.NoError(t, svc.DeleteUser("c1", "alice", ""))
+	require.NoError(t, svc.DeleteUser("c1", "alice", "SCRAM-SHA-256"))
+}
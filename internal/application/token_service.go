@@ -0,0 +1,239 @@
+package application
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+const (
+	// tokenPrefix starts every token secret, so leaked tokens are easy to recognize and scan for.
+	tokenPrefix = "mst_"
+	// maxTokenDays caps the expiry of new tokens.
+	maxTokenDays = 3650
+	// lastUsedResolution is how often the last-used timestamp of a token is written.
+	lastUsedResolution = time.Minute
+)
+
+var tokenNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
+// TokenService issues, lists, revokes and checks API tokens. It is disabled without a token store.
+type TokenService struct {
+	store          domain.TokenStore
+	clusterService *ClusterService
+	users          *AuthService
+
+	mu  sync.Mutex
+	now func() time.Time
+}
+
+// NewTokenService creates a token service keeping tokens in store, which may be nil to disable tokens.
+func NewTokenService(store domain.TokenStore, clusterService *ClusterService) *TokenService {
+	return &TokenService{
+		store:          store,
+		clusterService: clusterService,
+		now:            time.Now,
+	}
+}
+
+// WithUsers checks the owner of personal tokens against the user sources of the auth service on each use,
+// so tokens act with the current groups of their owner and stop working once the owner is removed.
+func (s *TokenService) WithUsers(users *AuthService) *TokenService {
+	s.users = users
+	return s
+}
+
+// Enabled reports whether API tokens can be used.
+func (s *TokenService) Enabled() bool {
+	return s != nil && s.store != nil
+}
+
+// ManagesServiceTokens reports whether the user may create and revoke service tokens. As they are
+// shared by a team rather than owned by a person, this takes cluster-admin on every cluster.
+func (s *TokenService) ManagesServiceTokens(user domain.User) bool {
+	if user.ViaToken() {
+		return false
+	}
+	scoped := s.clusterService.As(user)
+	for _, c := range s.clusterService.ListClusters() {
+		if !scoped.Allowed(domain.ActionClusterAdmin, c.Name, domain.ClusterResource) {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateToken issues a token for the user. The secret is returned once and only its hash is stored.
+func (s *TokenService) CreateToken(user domain.User, req domain.CreateTokenRequest) (domain.CreatedToken, error) {
+	if !s.Enabled() {
+		return domain.CreatedToken{}, ErrTokensDisabled
+	}
+	if user.ViaToken() {
+		return domain.CreatedToken{}, fmt.Errorf("%w: tokens cannot create tokens", ErrForbidden)
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Kind == "" {
+		req.Kind = domain.TokenPersonal
+	}
+	if err := validateTokenRequest(req); err != nil {
+		return domain.CreatedToken{}, err
+	}
+	if req.Kind == domain.TokenService && !s.ManagesServiceTokens(user) {
+		return domain.CreatedToken{}, fmt.Errorf("%w: %s may not create service tokens", ErrForbidden, user.Name)
+	}
+	for _, t := range s.store.List() {
+		if t.Kind == req.Kind && t.Name == req.Name && (t.Kind == domain.TokenService || t.Owner.Name == user.Name && t.Owner.Provider == user.Provider) {
+			return domain.CreatedToken{}, fmt.Errorf("%w: a %s token named %s already exists", ErrInvalidTokenRequest, req.Kind, req.Name)
+		}
+	}
+
+	id, err := randomToken(9)
+	if err != nil {
+		return domain.CreatedToken{}, err
+	}
+	key, err := randomToken(32)
+	if err != nil {
+		return domain.CreatedToken{}, err
+	}
+	secret := tokenPrefix + id + "_" + key
+
+	now := s.now()
+	token := domain.APIToken{
+		ID:        id,
+		Name:      req.Name,
+		Kind:      req.Kind,
+		Owner:     domain.User{Name: user.Name, Provider: user.Provider, Groups: user.Groups},
+		Scopes:    req.Scopes,
+		Hash:      hashToken(secret),
+		CreatedAt: now,
+	}
+	if req.ExpiresInDays > 0 {
+		expires := now.AddDate(0, 0, req.ExpiresInDays)
+		token.ExpiresAt = &expires
+	}
+	if err := s.store.Save(token); err != nil {
+		utils.Logger.Error("save api token failed", "name", token.Name, "err", err)
+		return domain.CreatedToken{}, err
+	}
+
+	utils.Logger.Info("api token created", "id", token.ID, "name", token.Name, "kind", token.Kind, "user", user.Name, "scopes", token.Scopes)
+	return domain.CreatedToken{Token: token, Secret: secret}, nil
+}
+
+func validateTokenRequest(req domain.CreateTokenRequest) error {
+	if !tokenNameRe.MatchString(req.Name) {
+		return fmt.Errorf("%w: the name must be 1 to 64 letters, digits, dots, dashes or underscores", ErrInvalidTokenRequest)
+	}
+	if req.Kind != domain.TokenPersonal && req.Kind != domain.TokenService {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidTokenRequest, req.Kind)
+	}
+	if len(req.Scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrInvalidTokenRequest)
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(domain.Actions, scope) {
+			return fmt.Errorf("%w: unknown scope %q", ErrInvalidTokenRequest, scope)
+		}
+	}
+	if req.ExpiresInDays < 0 || req.ExpiresInDays > maxTokenDays {
+		return fmt.Errorf("%w: expiry must be between 0 and %d days", ErrInvalidTokenRequest, maxTokenDays)
+	}
+	return nil
+}
+
+// ListTokens returns the personal tokens of the user and, for those who manage them, the service tokens,
+// newest first.
+func (s *TokenService) ListTokens(user domain.User) ([]domain.APIToken, error) {
+	if !s.Enabled() {
+		return nil, ErrTokensDisabled
+	}
+	services := s.ManagesServiceTokens(user)
+	var out []domain.APIToken
+	for _, t := range s.store.List() {
+		if s.visible(t, user, services) {
+			out = append(out, t)
+		}
+	}
+	slices.SortFunc(out, func(a, b domain.APIToken) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return out, nil
+}
+
+// RevokeToken deletes a token of the user, or a service token for those who manage them.
+func (s *TokenService) RevokeToken(user domain.User, id string) error {
+	if !s.Enabled() {
+		return ErrTokensDisabled
+	}
+	if user.ViaToken() {
+		return fmt.Errorf("%w: tokens cannot revoke tokens", ErrForbidden)
+	}
+	services := s.ManagesServiceTokens(user)
+	i := slices.IndexFunc(s.store.List(), func(t domain.APIToken) bool { return t.ID == id && s.visible(t, user, services) })
+	if i < 0 {
+		return ErrTokenNotFound
+	}
+	if err := s.store.Delete(id); err != nil {
+		utils.Logger.Error("revoke api token failed", "id", id, "err", err)
+		return err
+	}
+	utils.Logger.Info("api token revoked", "id", id, "user", user.Name)
+	return nil
+}
+
+func (s *TokenService) visible(t domain.APIToken, user domain.User, services bool) bool {
+	if t.Kind == domain.TokenService {
+		return services
+	}
+	return t.Owner.Name == user.Name && t.Owner.Provider == user.Provider
+}
+
+// AuthenticateToken checks a bearer token and returns the user it acts as, limited to its scopes.
+// Personal tokens act as their owner as the user sources know it now, not as it was when the token was created.
+// The last-used timestamp is recorded with a resolution of a minute.
+func (s *TokenService) AuthenticateToken(secret string) (domain.User, error) {
+	if !s.Enabled() || !strings.HasPrefix(secret, tokenPrefix) {
+		return domain.User{}, ErrInvalidToken
+	}
+	hash := hashToken(secret)
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.store.List() {
+		if subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hash)) != 1 {
+			continue
+		}
+		if t.Expired(now) {
+			return domain.User{}, fmt.Errorf("%w: token %s expired", ErrInvalidToken, t.Name)
+		}
+		if t.Kind == domain.TokenPersonal && s.users != nil {
+			owner, ok := s.users.LookupUser(t.Owner)
+			if !ok {
+				return domain.User{}, fmt.Errorf("%w: the owner %s of token %s no longer exists", ErrInvalidToken, t.Owner.Name, t.Name)
+			}
+			t.Owner = owner
+		}
+		if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= lastUsedResolution {
+			t.LastUsedAt = &now
+			if err := s.store.Save(t); err != nil {
+				utils.Logger.Warn("record api token use failed", "id", t.ID, "err", err)
+			}
+		}
+		return t.Principal(), nil
+	}
+	return domain.User{}, ErrInvalidToken
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
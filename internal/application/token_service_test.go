@@ -0,0 +1,245 @@
+package application
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTokenStore struct {
+	tokens []domain.APIToken
+	saves  int
+}
+
+func (f *fakeTokenStore) List() []domain.APIToken { return slices.Clone(f.tokens) }
+
+func (f *fakeTokenStore) Save(token domain.APIToken) error {
+	f.saves++
+	if i := slices.IndexFunc(f.tokens, func(t domain.APIToken) bool { return t.ID == token.ID }); i >= 0 {
+		f.tokens[i] = token
+		return nil
+	}
+	f.tokens = append(f.tokens, token)
+	return nil
+}
+
+func (f *fakeTokenStore) Delete(id string) error {
+	f.tokens = slices.DeleteFunc(f.tokens, func(t domain.APIToken) bool { return t.ID == id })
+	return nil
+}
+
+func newTestTokenService(t *testing.T) (*TokenService, *fakeTokenStore, *testutil.FakeClusterRepository, *time.Time) {
+	t.Helper()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "shared-prod", Brokers: []string{"b1"}}, {Name: "billing", Brokers: []string{"b2"}}}
+	repo.RBAC = teamRBAC()
+	store := &fakeTokenStore{}
+	svc := NewTokenService(store, NewClusterService(repo))
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	return svc, store, repo, &now
+}
+
+func TestTokenService_Disabled(t *testing.T) {
+	t.Parallel()
+	svc := NewTokenService(nil, NewClusterService(testutil.NewFakeClusterRepository()))
+	require.False(t, svc.Enabled())
+
+	_, err := svc.CreateToken(domain.User{Name: "olga"}, domain.CreateTokenRequest{Name: "ci", Scopes: []domain.Action{domain.ActionView}})
+	require.ErrorIs(t, err, ErrTokensDisabled)
+	_, err = svc.AuthenticateToken("mst_abc_def")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTokenService_CreateAndAuthenticate(t *testing.T) {
+	t.Parallel()
+	svc, store, _, now := newTestTokenService(t)
+	olga := domain.User{Name: "olga", Provider: "local", Groups: []string{"orders-team"}}
+
+	created, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: " ci ", Scopes: []domain.Action{domain.ActionView}, ExpiresInDays: 30})
+	require.NoError(t, err)
+	require.Equal(t, "ci", created.Token.Name)
+	require.Equal(t, domain.TokenPersonal, created.Token.Kind)
+	require.Contains(t, created.Secret, tokenPrefix)
+	require.NotContains(t, store.tokens[0].Hash, created.Secret)
+	require.Equal(t, hashToken(created.Secret), store.tokens[0].Hash)
+
+	user, err := svc.AuthenticateToken(created.Secret)
+	require.NoError(t, err)
+	require.Equal(t, "olga", user.Name)
+	require.Equal(t, []string{"orders-team"}, user.Groups)
+	require.True(t, user.ViaToken())
+	require.Equal(t, *now, *store.tokens[0].LastUsedAt)
+
+	// uses within a minute are not written again
+	saves := store.saves
+	*now = now.Add(30 * time.Second)
+	_, err = svc.AuthenticateToken(created.Secret)
+	require.NoError(t, err)
+	require.Equal(t, saves, store.saves)
+
+	_, err = svc.AuthenticateToken(created.Secret + "x")
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = svc.AuthenticateToken("Basic abc")
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	*now = now.AddDate(0, 0, 31)
+	_, err = svc.AuthenticateToken(created.Secret)
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTokenService_Validation(t *testing.T) {
+	t.Parallel()
+	svc, _, _, _ := newTestTokenService(t)
+	olga := domain.User{Name: "olga", Provider: "local", Groups: []string{"orders-team"}}
+	view := []domain.Action{domain.ActionView}
+
+	tests := []struct {
+		name string
+		req  domain.CreateTokenRequest
+	}{
+		{"empty name", domain.CreateTokenRequest{Scopes: view}},
+		{"bad name", domain.CreateTokenRequest{Name: "my token", Scopes: view}},
+		{"unknown kind", domain.CreateTokenRequest{Name: "ci", Kind: "robot", Scopes: view}},
+		{"no scopes", domain.CreateTokenRequest{Name: "ci"}},
+		{"unknown scope", domain.CreateTokenRequest{Name: "ci", Scopes: []domain.Action{"delete-everything"}}},
+		{"expiry too long", domain.CreateTokenRequest{Name: "ci", Scopes: view, ExpiresInDays: maxTokenDays + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateToken(olga, tt.req)
+			require.ErrorIs(t, err, ErrInvalidTokenRequest)
+		})
+	}
+
+	_, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: "ci", Scopes: view})
+	require.NoError(t, err)
+	_, err = svc.CreateToken(olga, domain.CreateTokenRequest{Name: "ci", Scopes: view})
+	require.ErrorIs(t, err, ErrInvalidTokenRequest)
+
+	// service tokens need cluster-admin everywhere, and tokens cannot create tokens
+	_, err = svc.CreateToken(olga, domain.CreateTokenRequest{Name: "deploy", Kind: domain.TokenService, Scopes: view})
+	require.ErrorIs(t, err, ErrForbidden)
+	_, err = svc.CreateToken(domain.User{Name: "olga", Scopes: view}, domain.CreateTokenRequest{Name: "other", Scopes: view})
+	require.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestTokenService_ListAndRevoke(t *testing.T) {
+	t.Parallel()
+	svc, _, _, now := newTestTokenService(t)
+	olga := domain.User{Name: "olga", Provider: "local", Groups: []string{"orders-team"}}
+	root := domain.User{Name: "root", Provider: "local"}
+	view := []domain.Action{domain.ActionView}
+
+	mine, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: "ci", Scopes: view})
+	require.NoError(t, err)
+	*now = now.Add(time.Hour)
+	theirs, err := svc.CreateToken(root, domain.CreateTokenRequest{Name: "ci", Scopes: view})
+	require.NoError(t, err)
+	*now = now.Add(time.Hour)
+	service, err := svc.CreateToken(root, domain.CreateTokenRequest{Name: "deploy", Kind: domain.TokenService, Scopes: view})
+	require.NoError(t, err)
+
+	tokens, err := svc.ListTokens(olga)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, mine.Token.ID, tokens[0].ID)
+
+	tokens, err = svc.ListTokens(root)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.Equal(t, service.Token.ID, tokens[0].ID, "newest first")
+
+	require.ErrorIs(t, svc.RevokeToken(olga, theirs.Token.ID), ErrTokenNotFound)
+	require.ErrorIs(t, svc.RevokeToken(olga, service.Token.ID), ErrTokenNotFound)
+	require.NoError(t, svc.RevokeToken(olga, mine.Token.ID))
+	_, err = svc.AuthenticateToken(mine.Secret)
+	require.ErrorIs(t, err, ErrInvalidToken)
+	require.NoError(t, svc.RevokeToken(root, service.Token.ID))
+}
+
+func TestTokenService_ScopesAndServicePrincipal(t *testing.T) {
+	t.Parallel()
+	svc, _, repo, _ := newTestTokenService(t)
+	repo.RBAC.Bindings = append(repo.RBAC.Bindings, config.RoleBinding{Role: "orders-reader", Users: []string{"service:deploy"}})
+	olga := domain.User{Name: "olga", Provider: "local", Groups: []string{"orders-team"}}
+	root := domain.User{Name: "root", Provider: "local"}
+	clusters := NewClusterService(repo)
+
+	// a view token of a topic owner cannot write, even though its owner can
+	viewer, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: "dashboards", Scopes: []domain.Action{domain.ActionView}})
+	require.NoError(t, err)
+	user, err := svc.AuthenticateToken(viewer.Secret)
+	require.NoError(t, err)
+	require.True(t, clusters.As(user).Allowed(domain.ActionView, "shared-prod", domain.TopicResource("orders.created")))
+	require.False(t, clusters.As(user).Allowed(domain.ActionProduce, "shared-prod", domain.TopicResource("orders.created")))
+
+	// a wider scope implies the narrower ones
+	admin, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: "ci", Scopes: []domain.Action{domain.ActionTopicAdmin}})
+	require.NoError(t, err)
+	user, err = svc.AuthenticateToken(admin.Secret)
+	require.NoError(t, err)
+	require.True(t, clusters.As(user).Allowed(domain.ActionProduce, "shared-prod", domain.TopicResource("orders.created")))
+
+	// service tokens act as their own principal, bound to roles like users
+	deploy, err := svc.CreateToken(root, domain.CreateTokenRequest{Name: "deploy", Kind: domain.TokenService, Scopes: []domain.Action{domain.ActionClusterAdmin}})
+	require.NoError(t, err)
+	user, err = svc.AuthenticateToken(deploy.Secret)
+	require.NoError(t, err)
+	require.Equal(t, "service:deploy", user.Name)
+	require.True(t, clusters.As(user).Allowed(domain.ActionConsume, "shared-prod", domain.TopicResource("orders.created")))
+	require.False(t, clusters.As(user).Allowed(domain.ActionClusterAdmin, "billing", domain.ClusterResource))
+}
+
+// groupedPasswords is a password source whose users and groups can change while tokens exist.
+type groupedPasswords map[string][]string
+
+func (g groupedPasswords) Verify(username, _ string) (domain.User, bool) { return g.Lookup(username) }
+
+func (g groupedPasswords) Lookup(username string) (domain.User, bool) {
+	groups, ok := g[username]
+	if !ok {
+		return domain.User{}, false
+	}
+	return domain.User{Name: username, Provider: "local", Groups: groups}, true
+}
+
+func TestTokenService_FollowsTheOwner(t *testing.T) {
+	t.Parallel()
+	svc, _, _, _ := newTestTokenService(t)
+	users := groupedPasswords{"olga": {"orders-team"}}
+	svc.WithUsers(NewAuthService([]domain.PasswordVerifier{users}, nil, 0))
+	olga, ok := users.Lookup("olga")
+	require.True(t, ok)
+
+	created, err := svc.CreateToken(olga, domain.CreateTokenRequest{Name: "ci", Scopes: []domain.Action{domain.ActionView}})
+	require.NoError(t, err)
+	user, err := svc.AuthenticateToken(created.Secret)
+	require.NoError(t, err)
+	require.Equal(t, []string{"orders-team"}, user.Groups)
+
+	// a token acts with the groups its owner has now
+	users["olga"] = []string{"billing-team"}
+	user, err = svc.AuthenticateToken(created.Secret)
+	require.NoError(t, err)
+	require.Equal(t, []string{"billing-team"}, user.Groups)
+
+	// and stops working once the owner is removed
+	delete(users, "olga")
+	_, err = svc.AuthenticateToken(created.Secret)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// service tokens belong to no user and keep working
+	deploy, err := svc.CreateToken(domain.User{Name: "root", Provider: "local"},
+		domain.CreateTokenRequest{Name: "deploy", Kind: domain.TokenService, Scopes: []domain.Action{domain.ActionView}})
+	require.NoError(t, err)
+	_, err = svc.AuthenticateToken(deploy.Secret)
+	require.NoError(t, err)
+}
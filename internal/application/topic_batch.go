@@ -0,0 +1,74 @@
+package application
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// batchTopicConcurrency bounds the topics changed at the same time by a batch operation.
+const batchTopicConcurrency = 8
+
+// BatchTopics applies a delete, config update or partition increase to several topics concurrently.
+// Every topic is attempted; the results keep the order of req.Topics and carry the error of each failed topic.
+func (s *TopicService) BatchTopics(clusterName string, req domain.BatchTopicRequest) ([]domain.BatchTopicResult, error) {
+	if len(req.Topics) == 0 {
+		return nil, fmt.Errorf("%w: no topics selected", ErrInvalidBatchTopics)
+	}
+	var apply func(topic string) error
+	switch req.Action {
+	case domain.TopicActionDelete:
+		// Topics that fire a deletion check are reported as failed unless their name is among the confirmations.
+		apply = func(topic string) error {
+			var confirmation string
+			if slices.Contains(req.Confirmations, topic) {
+				confirmation = topic
+			}
+			return s.DeleteTopic(clusterName, topic, domain.DeleteTopicRequest{Confirmation: confirmation})
+		}
+	case domain.TopicActionUpdateConfig:
+		if len(req.Configs) == 0 {
+			return nil, ErrInvalidTopicConfig
+		}
+		apply = func(topic string) error {
+			return s.UpdateTopicConfig(clusterName, topic, domain.UpdateTopicConfigRequest{Configs: req.Configs})
+		}
+	case domain.TopicActionIncreasePartitions:
+		if req.TotalPartitions <= 0 {
+			return nil, ErrInvalidPartitionCount
+		}
+		apply = func(topic string) error {
+			return s.IncreasePartitions(clusterName, topic, domain.IncreasePartitionsRequest{TotalPartitions: req.TotalPartitions})
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidBatchTopics, req.Action)
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.BatchTopicResult, len(req.Topics))
+	sem := make(chan struct{}, batchTopicConcurrency)
+	var wg sync.WaitGroup
+	for i, topic := range req.Topics {
+		results[i].Topic = topic
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := apply(topic); err != nil {
+				results[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	utils.Logger.Info("batch topic operation finished", "cluster", clusterName, "action", req.Action,
+		"topics", len(results), "failed", domain.BatchTopicFailures(results))
+	return results, nil
+}
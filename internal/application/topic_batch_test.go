@@ -0,0 +1,77 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicService_BatchTopics(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}, TopicPolicy: &config.TopicPolicy{MaxPartitions: 12}}}
+	fake := testutil.NewFakeKafkaClient()
+	repo.Clients["c1"] = fake
+	svc := NewTopicService(NewClusterService(repo))
+
+	topics := make([]string, 50)
+	for i := range topics {
+		topics[i] = fmt.Sprintf("topic-%02d", i)
+	}
+
+	results, err := svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionDelete, Topics: topics})
+	require.NoError(t, err)
+	require.Len(t, results, 50)
+	for i, r := range results {
+		require.Equal(t, topics[i], r.Topic)
+		require.Empty(t, r.Error)
+	}
+
+	retention := "1000"
+	results, err = svc.BatchTopics("c1", domain.BatchTopicRequest{
+		Action:  domain.TopicActionUpdateConfig,
+		Topics:  topics[:2],
+		Configs: map[string]*string{"retention.ms": &retention},
+	})
+	require.NoError(t, err)
+	require.Zero(t, domain.BatchTopicFailures(results))
+
+	// policy violations are reported per topic
+	results, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionIncreasePartitions, Topics: topics[:3], TotalPartitions: 24})
+	require.NoError(t, err)
+	require.Equal(t, 3, domain.BatchTopicFailures(results))
+
+	// topics still in use are only deleted when confirmed
+	fake.GroupOffsets = []domain.ConsumerGroupOffsets{{Group: "billing", Offsets: []domain.PartitionOffset{
+		{Topic: topics[0], Partition: 0, Offset: 1}, {Topic: topics[1], Partition: 0, Offset: 1},
+	}}}
+	fake.ConsumerGroups = []domain.ConsumerGroupSummary{{GroupID: "billing", State: "Stable", Members: 1}}
+	results, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionDelete, Topics: topics[:2], Confirmations: []string{topics[1]}})
+	require.NoError(t, err)
+	require.NotEmpty(t, results[0].Error)
+	require.Empty(t, results[1].Error)
+	fake.GroupOffsets, fake.ConsumerGroups = nil, nil
+
+	fake.Err = errors.New("boom")
+	results, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionDelete, Topics: topics[:2]})
+	require.NoError(t, err)
+	require.Contains(t, results[1].Error, "boom")
+
+	_, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionDelete})
+	require.ErrorIs(t, err, ErrInvalidBatchTopics)
+	_, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionCreate, Topics: topics[:1]})
+	require.ErrorIs(t, err, ErrInvalidBatchTopics)
+	_, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionUpdateConfig, Topics: topics[:1]})
+	require.ErrorIs(t, err, ErrInvalidTopicConfig)
+	_, err = svc.BatchTopics("c1", domain.BatchTopicRequest{Action: domain.TopicActionIncreasePartitions, Topics: topics[:1]})
+	require.ErrorIs(t, err, ErrInvalidPartitionCount)
+	_, err = svc.BatchTopics("missing", domain.BatchTopicRequest{Action: domain.TopicActionDelete, Topics: topics[:1]})
+	require.ErrorIs(t, err, ErrClusterNotFound)
+}
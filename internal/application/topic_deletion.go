@@ -0,0 +1,365 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// deletionActivityWindow is how far back produce activity is looked for before a topic is deleted.
+const deletionActivityWindow = 24 * time.Hour
+
+const (
+	retentionMsConfig   = "retention.ms"
+	quarantinePrincipal = "User:*"
+	maxQuarantineDays   = 90
+)
+
+// quarantineOperations are denied to every principal while a topic is quarantined.
+var quarantineOperations = []string{"READ", "WRITE"}
+
+// TopicDeletionError is returned when a topic deletion fired a safety check and was not confirmed.
+// It matches ErrTopicDeletionUnconfirmed with errors.Is.
+type TopicDeletionError struct {
+	Cluster string
+	Check   domain.TopicDeletionCheck
+}
+
+func (e *TopicDeletionError) Error() string {
+	var reasons []string
+	if n := len(e.Check.ConsumerGroups); n > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d active consumer groups", n))
+	}
+	if e.Check.RecentRecords > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d records produced since %s", e.Check.RecentRecords, e.Check.ActivitySince.Format(time.RFC3339)))
+	}
+	if n := len(e.Check.ACLs); n > 0 {
+		reasons = append(reasons, fmt.Sprintf("%d ACLs", n))
+	}
+	return fmt.Sprintf("%s: topic %s on cluster %s has %s", ErrTopicDeletionUnconfirmed, e.Check.Topic, e.Cluster, strings.Join(reasons, ", "))
+}
+
+// Is reports whether target is ErrTopicDeletionUnconfirmed.
+func (e *TopicDeletionError) Is(target error) bool {
+	return target == ErrTopicDeletionUnconfirmed
+}
+
+// CheckTopicDeletion runs the safety checks that guard a topic deletion.
+func (s *TopicService) CheckTopicDeletion(clusterName, topicName string) (*domain.TopicDeletionCheck, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.TopicResource(topicName)); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("check topic deletion client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+
+	check, err := s.checkTopicDeletion(clusterName, client, topicName)
+	if err != nil {
+		utils.Logger.Error("check topic deletion failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return nil, err
+	}
+	return check, nil
+}
+
+func (s *TopicService) checkTopicDeletion(clusterName string, client domain.KafkaClient, topicName string) (*domain.TopicDeletionCheck, error) {
+	check := &domain.TopicDeletionCheck{Topic: topicName, ActivitySince: time.Now().Add(-deletionActivityWindow)}
+
+	offsets, err := client.ListConsumerGroupOffsets()
+	if err != nil {
+		return nil, fmt.Errorf("list consumer group offsets: %w", err)
+	}
+	committed := make(map[string]bool)
+	for _, g := range offsets {
+		if slices.ContainsFunc(g.Offsets, func(o domain.PartitionOffset) bool { return o.Topic == topicName }) {
+			committed[g.Group] = true
+		}
+	}
+	if len(committed) > 0 {
+		groups, err := client.ListConsumerGroups()
+		if err != nil {
+			return nil, fmt.Errorf("list consumer groups: %w", err)
+		}
+		for _, g := range groups {
+			if committed[g.GroupID] && g.State != "Empty" && g.State != "Dead" {
+				check.ConsumerGroups = append(check.ConsumerGroups, g)
+			}
+		}
+		slices.SortFunc(check.ConsumerGroups, func(a, b domain.ConsumerGroupSummary) int {
+			return strings.Compare(a.GroupID, b.GroupID)
+		})
+	}
+
+	check.RecentRecords, err = client.CountRecordsSince(topicName, check.ActivitySince)
+	if err != nil {
+		return nil, fmt.Errorf("count recent records: %w", err)
+	}
+
+	q, quarantined := s.findQuarantine(clusterName, topicName)
+	if quarantined {
+		check.Quarantined = true
+		check.DeleteAfter = &q.DeleteAfter
+	}
+
+	// Clusters without an authorizer have no ACLs to lose.
+	acls, err := client.DescribeACLs(domain.ACLFilter{ResourceType: "TOPIC", ResourceName: topicName, PatternType: "LITERAL"})
+	if err != nil && !errors.Is(err, domain.ErrSecurityDisabled) {
+		return nil, fmt.Errorf("describe acls: %w", err)
+	}
+	for _, acl := range acls {
+		if quarantined && isQuarantineACL(acl) {
+			continue
+		}
+		check.ACLs = append(check.ACLs, acl)
+	}
+
+	return check, nil
+}
+
+// ListQuarantinedTopics returns the quarantined topics of a cluster.
+func (s *TopicService) ListQuarantinedTopics(clusterName string) ([]config.QuarantinedTopic, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+
+	var out []config.QuarantinedTopic
+	for _, q := range s.repo.FindQuarantinedTopics() {
+		if q.Cluster == clusterName && s.clusterService.Allowed(domain.ActionView, clusterName, domain.TopicResource(q.Topic)) {
+			out = append(out, q)
+		}
+	}
+	return out, nil
+}
+
+// QuarantineTopic blocks a topic ahead of its deletion: produce and consume are denied to every
+// principal and retention is disabled so no data expires, until the topic is deleted once req.Days have passed.
+// Clusters without an authorizer cannot deny access, so their topics only keep their data.
+func (s *TopicService) QuarantineTopic(clusterName, topicName string, req domain.QuarantineTopicRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicQuarantine, clusterName, domain.TopicResource(topicName))
+	defer func() { audit.done(err) }()
+
+	if req.Days <= 0 || req.Days > maxQuarantineDays {
+		return fmt.Errorf("%w: days must be between 1 and %d", ErrInvalidQuarantine, maxQuarantineDays)
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+	if _, ok := s.findQuarantine(clusterName, topicName); ok {
+		return fmt.Errorf("%w: topic %s is already quarantined", ErrInvalidQuarantine, topicName)
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("quarantine topic client not found", "cluster", clusterName)
+		return ErrClusterNotFound
+	}
+
+	detail, err := client.GetTopicDetail(topicName)
+	if err != nil {
+		utils.Logger.Error("quarantine topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
+	if detail == nil {
+		return fmt.Errorf("%w: topic %s not found", ErrInvalidQuarantine, topicName)
+	}
+
+	now := time.Now().UTC()
+	record := config.QuarantinedTopic{
+		Cluster:       clusterName,
+		Topic:         topicName,
+		QuarantinedAt: now,
+		DeleteAfter:   now.AddDate(0, 0, req.Days),
+	}
+	for _, e := range detail.ConfigEntries {
+		if e.Name == retentionMsConfig && e.IsOverridden() {
+			record.RetentionMs = e.Value
+		}
+	}
+
+	for _, acl := range quarantineACLs(topicName) {
+		err := client.CreateACL(acl)
+		if errors.Is(err, domain.ErrSecurityDisabled) {
+			utils.Logger.Warn("quarantine topic without deny acls, the cluster has no authorizer", "cluster", clusterName, "topic", topicName)
+			break
+		}
+		if err != nil {
+			utils.Logger.Error("quarantine topic acl failed", "cluster", clusterName, "topic", topicName, "err", err)
+			_ = s.removeQuarantineACLs(clusterName, client, topicName)
+			return err
+		}
+	}
+	unlimited := "-1"
+	if err := client.UpdateTopicConfig(topicName, domain.UpdateTopicConfigRequest{Configs: map[string]*string{retentionMsConfig: &unlimited}}); err != nil {
+		utils.Logger.Error("quarantine topic retention failed", "cluster", clusterName, "topic", topicName, "err", err)
+		_ = s.removeQuarantineACLs(clusterName, client, topicName)
+		return err
+	}
+	audit.after = record
+	if err := s.repo.SaveQuarantinedTopic(record); err != nil {
+		utils.Logger.Error("save quarantined topic failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("topic quarantined", "cluster", clusterName, "topic", topicName, "delete_after", record.DeleteAfter)
+	return nil
+}
+
+// ReleaseTopicQuarantine lifts the quarantine of a topic, restoring its ACLs and retention.
+func (s *TopicService) ReleaseTopicQuarantine(clusterName, topicName string) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicRelease, clusterName, domain.TopicResource(topicName))
+	defer func() { audit.done(err) }()
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+	q, ok := s.findQuarantine(clusterName, topicName)
+	if !ok {
+		return fmt.Errorf("%w: topic %s is not quarantined", ErrInvalidQuarantine, topicName)
+	}
+	audit.before = q
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("release topic quarantine client not found", "cluster", clusterName)
+		return ErrClusterNotFound
+	}
+
+	var retention *string
+	if q.RetentionMs != "" {
+		retention = &q.RetentionMs
+	}
+	if err := client.UpdateTopicConfig(topicName, domain.UpdateTopicConfigRequest{Configs: map[string]*string{retentionMsConfig: retention}}); err != nil {
+		utils.Logger.Error("release topic quarantine retention failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
+	if err := s.clearQuarantine(clusterName, client, topicName); err != nil {
+		return err
+	}
+
+	utils.Logger.Info("topic quarantine released", "cluster", clusterName, "topic", topicName)
+	return nil
+}
+
+// DeleteExpiredQuarantinedTopics deletes every quarantined topic whose quarantine ended before now.
+// The quarantine itself stands for the deletion confirmation.
+func (s *TopicService) DeleteExpiredQuarantinedTopics(now time.Time) []domain.BatchTopicResult {
+	var results []domain.BatchTopicResult
+	for _, q := range s.repo.FindQuarantinedTopics() {
+		if now.Before(q.DeleteAfter) {
+			continue
+		}
+		result := domain.BatchTopicResult{Topic: q.Topic}
+		if err := s.DeleteTopic(q.Cluster, q.Topic, domain.DeleteTopicRequest{Confirmation: q.Topic}); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// RunQuarantineSweeper deletes expired quarantined topics every interval until ctx is done.
+func (s *TopicService) RunQuarantineSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if results := s.DeleteExpiredQuarantinedTopics(now); len(results) > 0 {
+				utils.Logger.Info("quarantine sweep finished", "deleted", len(results)-domain.BatchTopicFailures(results),
+					"failed", domain.BatchTopicFailures(results))
+			}
+		}
+	}
+}
+
+func (s *TopicService) findQuarantine(clusterName, topicName string) (config.QuarantinedTopic, bool) {
+	for _, q := range s.repo.FindQuarantinedTopics() {
+		if q.Cluster == clusterName && q.Topic == topicName {
+			return q, true
+		}
+	}
+	return config.QuarantinedTopic{}, false
+}
+
+// clearQuarantine drops the deny ACLs and the record of a quarantined topic. Topics that are not
+// quarantined are left untouched.
+func (s *TopicService) clearQuarantine(clusterName string, client domain.KafkaClient, topicName string) error {
+	if _, ok := s.findQuarantine(clusterName, topicName); !ok {
+		return nil
+	}
+	if err := s.removeQuarantineACLs(clusterName, client, topicName); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteQuarantinedTopic(clusterName, topicName); err != nil {
+		utils.Logger.Error("delete quarantined topic record failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
+	return nil
+}
+
+func (s *TopicService) removeQuarantineACLs(clusterName string, client domain.KafkaClient, topicName string) error {
+	for _, acl := range quarantineACLs(topicName) {
+		filter := domain.ACLFilter{
+			Principal:    acl.Principal,
+			Host:         acl.Host,
+			ResourceType: acl.ResourceType,
+			ResourceName: acl.ResourceName,
+			PatternType:  acl.PatternType,
+			Operation:    acl.Operation,
+			Permission:   acl.Permission,
+		}
+		_, err := client.DeleteACLs(filter)
+		if errors.Is(err, domain.ErrSecurityDisabled) {
+			return nil
+		}
+		if err != nil {
+			utils.Logger.Error("remove quarantine acl failed", "cluster", clusterName, "topic", topicName, "err", err)
+			return err
+		}
+	}
+	return nil
+}
+
+func quarantineACLs(topicName string) []domain.ACL {
+	acls := make([]domain.ACL, 0, len(quarantineOperations))
+	for _, op := range quarantineOperations {
+		acls = append(acls, domain.ACL{
+			Principal:    quarantinePrincipal,
+			Host:         "*",
+			ResourceType: "TOPIC",
+			ResourceName: topicName,
+			PatternType:  "LITERAL",
+			Operation:    op,
+			Permission:   "DENY",
+		})
+	}
+	return acls
+}
+
+func isQuarantineACL(acl domain.ACL) bool {
+	return acl.Principal == quarantinePrincipal && acl.Host == "*" && acl.PatternType == "LITERAL" &&
+		acl.Permission == "DENY" && slices.Contains(quarantineOperations, acl.Operation)
+}
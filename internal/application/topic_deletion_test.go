@@ -0,0 +1,153 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicService_CheckTopicDeletion(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.GroupOffsets = []domain.ConsumerGroupOffsets{
+		{Group: "billing", Offsets: []domain.PartitionOffset{{Topic: "orders", Partition: 0, Offset: 10}}},
+		{Group: "archiver", Offsets: []domain.PartitionOffset{{Topic: "orders", Partition: 0, Offset: 3}}},
+		{Group: "audit", Offsets: []domain.PartitionOffset{{Topic: "payments", Partition: 0, Offset: 1}}},
+	}
+	fake.ConsumerGroups = []domain.ConsumerGroupSummary{
+		{GroupID: "billing", State: "Stable", Members: 2},
+		{GroupID: "archiver", State: "Empty"},
+		{GroupID: "audit", State: "Stable", Members: 1},
+	}
+	repo.Clients["c1"] = fake
+	svc := NewTopicService(NewClusterService(repo))
+
+	check, err := svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.Len(t, check.ConsumerGroups, 1)
+	require.Equal(t, "billing", check.ConsumerGroups[0].GroupID)
+	require.True(t, check.RequiresConfirmation())
+
+	fake.GroupOffsets = nil
+	check, err = svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.False(t, check.RequiresConfirmation())
+
+	fake.RecentRecords = 42
+	check, err = svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.EqualValues(t, 42, check.RecentRecords)
+	require.True(t, check.RequiresConfirmation())
+
+	fake.RecentRecords = 0
+	fake.ACLs = []domain.ACL{{Principal: "User:billing", Host: "*", ResourceType: "TOPIC", ResourceName: "orders", PatternType: "LITERAL", Operation: "READ", Permission: "ALLOW"}}
+	check, err = svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.Len(t, check.ACLs, 1)
+
+	fake.ACLs = nil
+	fake.ACLsErr = fmt.Errorf("%w: SECURITY_DISABLED", domain.ErrSecurityDisabled)
+	check, err = svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.Empty(t, check.ACLs)
+	require.False(t, check.RequiresConfirmation())
+
+	fake.ACLsErr = errors.New("authorization failed")
+	_, err = svc.CheckTopicDeletion("c1", "orders")
+	require.Error(t, err)
+	fake.ACLsErr = nil
+
+	_, err = svc.CheckTopicDeletion("unknown", "orders")
+	require.ErrorIs(t, err, ErrClusterNotFound)
+}
+
+func TestTopicService_DeleteTopicConfirmation(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.RecentRecords = 5
+	repo.Clients["c1"] = fake
+	svc := NewTopicService(NewClusterService(repo))
+
+	err := svc.DeleteTopic("c1", "orders", domain.DeleteTopicRequest{})
+	require.ErrorIs(t, err, ErrTopicDeletionUnconfirmed)
+	var deletionErr *TopicDeletionError
+	require.True(t, errors.As(err, &deletionErr))
+	require.EqualValues(t, 5, deletionErr.Check.RecentRecords)
+
+	err = svc.DeleteTopic("c1", "orders", domain.DeleteTopicRequest{Confirmation: "order"})
+	require.ErrorIs(t, err, ErrTopicDeletionUnconfirmed)
+
+	require.NoError(t, svc.DeleteTopic("c1", "orders", domain.DeleteTopicRequest{Confirmation: "orders"}))
+}
+
+func TestTopicService_Quarantine(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.TopicDetail = &domain.TopicDetail{
+		Name: "orders",
+		ConfigEntries: []domain.TopicConfigEntry{
+			{Name: "retention.ms", Value: "3600000", Source: domain.ConfigSourceTopic},
+		},
+	}
+	repo.Clients["c1"] = fake
+	svc := NewTopicService(NewClusterService(repo))
+
+	require.ErrorIs(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{}), ErrInvalidQuarantine)
+	require.ErrorIs(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 365}), ErrInvalidQuarantine)
+
+	require.NoError(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 7}))
+	require.Len(t, fake.ACLs, 2)
+	quarantined, err := svc.ListQuarantinedTopics("c1")
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	require.Equal(t, "3600000", quarantined[0].RetentionMs)
+	require.ErrorIs(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 7}), ErrInvalidQuarantine)
+
+	// the deny ACLs of the quarantine do not count as a deletion check
+	check, err := svc.CheckTopicDeletion("c1", "orders")
+	require.NoError(t, err)
+	require.True(t, check.Quarantined)
+	require.Empty(t, check.ACLs)
+
+	require.Empty(t, svc.DeleteExpiredQuarantinedTopics(time.Now()))
+	results := svc.DeleteExpiredQuarantinedTopics(time.Now().AddDate(0, 0, 8))
+	require.Len(t, results, 1)
+	require.Empty(t, results[0].Error)
+	require.Empty(t, repo.Quarantined)
+
+	require.NoError(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 1}))
+	require.NoError(t, svc.ReleaseTopicQuarantine("c1", "orders"))
+	require.Empty(t, repo.Quarantined)
+	require.ErrorIs(t, svc.ReleaseTopicQuarantine("c1", "orders"), ErrInvalidQuarantine)
+
+	// clusters without an authorizer still keep the data of quarantined topics
+	fake.ACLs = nil
+	fake.ACLsErr = fmt.Errorf("%w: SECURITY_DISABLED", domain.ErrSecurityDisabled)
+	require.NoError(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 7}))
+	require.Empty(t, fake.ACLs)
+	quarantined, err = svc.ListQuarantinedTopics("c1")
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+	require.NoError(t, svc.ReleaseTopicQuarantine("c1", "orders"))
+	require.Empty(t, repo.Quarantined)
+
+	fake.ACLsErr = errors.New("authorization failed")
+	require.Error(t, svc.QuarantineTopic("c1", "orders", domain.QuarantineTopicRequest{Days: 7}))
+	require.Empty(t, repo.Quarantined)
+}
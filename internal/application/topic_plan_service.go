@@ -0,0 +1,262 @@
+package application
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// TopicPlanService computes and applies the changes needed to converge clusters to a declarative topic state.
+type TopicPlanService struct {
+	clusterService *ClusterService
+	topicService   *TopicService
+}
+
+// NewTopicPlanService creates a new topic plan service.
+func NewTopicPlanService(clusterService *ClusterService, topicService *TopicService) *TopicPlanService {
+	return &TopicPlanService{
+		clusterService: clusterService,
+		topicService:   topicService,
+	}
+}
+
+// ParseDesiredTopicState decodes and validates a desired topic state YAML document. JSON is accepted as well.
+func ParseDesiredTopicState(data []byte) (domain.DesiredTopicState, error) {
+	var state domain.DesiredTopicState
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&state); err != nil && !errors.Is(err, io.EOF) {
+		return state, fmt.Errorf("%w: %v", ErrInvalidTopicState, err)
+	}
+	if len(state.Clusters) == 0 {
+		return state, fmt.Errorf("%w: no clusters declared", ErrInvalidTopicState)
+	}
+
+	for cluster, desired := range state.Clusters {
+		seen := make(map[string]bool, len(desired.Topics))
+		for _, t := range desired.Topics {
+			switch {
+			case strings.TrimSpace(t.Name) == "":
+				return state, fmt.Errorf("%w: cluster %s has a topic without a name", ErrInvalidTopicState, cluster)
+			case seen[t.Name]:
+				return state, fmt.Errorf("%w: topic %s declared twice in cluster %s", ErrInvalidTopicState, t.Name, cluster)
+			case t.Partitions <= 0:
+				return state, fmt.Errorf("%w: topic %s in cluster %s needs a positive partition count", ErrInvalidTopicState, t.Name, cluster)
+			case t.ReplicationFactor <= 0:
+				return state, fmt.Errorf("%w: topic %s in cluster %s needs a positive replication factor", ErrInvalidTopicState, t.Name, cluster)
+			}
+			seen[t.Name] = true
+		}
+	}
+	return state, nil
+}
+
+// Plan computes the changes for every cluster in the desired state, ordered by cluster name.
+// Topics that exist but are not declared are only planned for deletion when allowDelete is set.
+func (s *TopicPlanService) Plan(state domain.DesiredTopicState, allowDelete bool) ([]domain.TopicPlan, error) {
+	plans := make([]domain.TopicPlan, 0, len(state.Clusters))
+	for _, name := range sortedClusterNames(state) {
+		plan, err := s.PlanCluster(name, state.Clusters[name], allowDelete)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// PlanCluster diffs the declared topics of a cluster against its live state.
+// Only declared config keys are compared; configs left out of the file are never reset.
+func (s *TopicPlanService) PlanCluster(clusterName string, desired domain.DesiredClusterTopics, allowDelete bool) (domain.TopicPlan, error) {
+	plan := domain.TopicPlan{Cluster: clusterName}
+	if _, ok := s.clusterService.GetCluster(clusterName); !ok {
+		return plan, fmt.Errorf("%w: %s", ErrClusterNotFound, clusterName)
+	}
+
+	live, err := s.topicService.ListTopics(clusterName, false)
+	if err != nil {
+		return plan, err
+	}
+
+	topics := append([]domain.DesiredTopic(nil), desired.Topics...)
+	sort.Slice(topics, func(i, j int) bool { return topics[i].Name < topics[j].Name })
+
+	declared := make(map[string]bool, len(topics))
+	for _, t := range topics {
+		declared[t.Name] = true
+		if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.TopicResource(t.Name)); err != nil {
+			return plan, err
+		}
+
+		var detail *domain.TopicDetail
+		if _, exists := live[t.Name]; exists {
+			if detail, err = s.topicService.GetTopicDetail(clusterName, t.Name); err != nil {
+				return plan, err
+			}
+		}
+		if detail == nil {
+			plan.Changes = append(plan.Changes, domain.TopicChange{
+				Action:            domain.TopicActionCreate,
+				Topic:             t.Name,
+				ToPartitions:      t.Partitions,
+				ReplicationFactor: t.ReplicationFactor,
+				Configs:           diffTopicConfigs(nil, t.Configs),
+			})
+			continue
+		}
+
+		if changes := diffTopicConfigs(detail.Configs, t.Configs); len(changes) > 0 {
+			plan.Changes = append(plan.Changes, domain.TopicChange{
+				Action:  domain.TopicActionUpdateConfig,
+				Topic:   t.Name,
+				Configs: changes,
+			})
+		}
+
+		current := int32(detail.Partitions)
+		switch {
+		case t.Partitions > current:
+			plan.Changes = append(plan.Changes, domain.TopicChange{
+				Action:         domain.TopicActionIncreasePartitions,
+				Topic:          t.Name,
+				FromPartitions: current,
+				ToPartitions:   t.Partitions,
+			})
+		case t.Partitions < current:
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("topic %s has %d partitions and cannot be reduced to %d", t.Name, current, t.Partitions))
+		}
+		if int(t.ReplicationFactor) != detail.ReplicationFactor {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("topic %s has replication factor %d, changing it to %d requires a partition reassignment", t.Name, detail.ReplicationFactor, t.ReplicationFactor))
+		}
+	}
+
+	if allowDelete {
+		var extra []string
+		for name := range live {
+			if !declared[name] {
+				extra = append(extra, name)
+			}
+		}
+		sort.Strings(extra)
+		for _, name := range extra {
+			plan.Changes = append(plan.Changes, domain.TopicChange{Action: domain.TopicActionDelete, Topic: name})
+		}
+	}
+
+	return plan, nil
+}
+
+// Apply plans every cluster of the desired state and executes the changes through ApplyPlans.
+func (s *TopicPlanService) Apply(state domain.DesiredTopicState, allowDelete bool) ([]domain.TopicApplyResult, error) {
+	plans, err := s.Plan(state, allowDelete)
+	if err != nil {
+		return nil, err
+	}
+	return s.ApplyPlans(plans)
+}
+
+// ApplyPlans executes the changes of plans computed earlier through the topic service, so what was
+// reviewed is what gets applied. A failing change does not stop the remaining ones; each outcome is
+// reported in the result. Nothing is applied when a cluster with pending changes is read-only or a
+// change is not allowed for the user.
+func (s *TopicPlanService) ApplyPlans(plans []domain.TopicPlan) ([]domain.TopicApplyResult, error) {
+	for _, plan := range plans {
+		if !plan.HasChanges() {
+			continue
+		}
+		if err := s.clusterService.CheckWritable(plan.Cluster); err != nil {
+			return nil, err
+		}
+		for _, change := range plan.Changes {
+			if err := s.clusterService.authorize(domain.ActionTopicAdmin, plan.Cluster, domain.TopicResource(change.Topic)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	results := make([]domain.TopicApplyResult, 0, len(plans))
+	for _, plan := range plans {
+		result := domain.TopicApplyResult{Cluster: plan.Cluster, Warnings: plan.Warnings}
+		for _, change := range plan.Changes {
+			r := domain.TopicChangeResult{Change: change}
+			if err := s.applyChange(plan.Cluster, change); err != nil {
+				r.Error = err.Error()
+			}
+			result.Results = append(result.Results, r)
+		}
+		utils.Logger.Info("topic plan applied", "cluster", plan.Cluster, "changes", len(plan.Changes), "failed", result.Failed())
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (s *TopicPlanService) applyChange(clusterName string, change domain.TopicChange) error {
+	switch change.Action {
+	case domain.TopicActionCreate:
+		return s.topicService.CreateTopic(clusterName, domain.CreateTopicRequest{
+			Name:              change.Topic,
+			NumPartitions:     change.ToPartitions,
+			ReplicationFactor: change.ReplicationFactor,
+			Configs:           configChangeValues(change.Configs),
+		})
+	case domain.TopicActionUpdateConfig:
+		return s.topicService.UpdateTopicConfig(clusterName, change.Topic, domain.UpdateTopicConfigRequest{
+			Configs: configChangeValues(change.Configs),
+		})
+	case domain.TopicActionIncreasePartitions:
+		return s.topicService.IncreasePartitions(clusterName, change.Topic, domain.IncreasePartitionsRequest{
+			TotalPartitions: change.ToPartitions,
+		})
+	case domain.TopicActionDelete:
+		// Deletes are only planned when explicitly allowed, which stands for the typed confirmation.
+		return s.topicService.DeleteTopic(clusterName, change.Topic, domain.DeleteTopicRequest{Confirmation: change.Topic})
+	default:
+		return fmt.Errorf("unknown topic change action %q", change.Action)
+	}
+}
+
+func diffTopicConfigs(live, desired map[string]string) []domain.ConfigChange {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var changes []domain.ConfigChange
+	for _, k := range keys {
+		old, ok := live[k]
+		if ok && old == desired[k] {
+			continue
+		}
+		changes = append(changes, domain.ConfigChange{Key: k, Old: old, New: desired[k]})
+	}
+	return changes
+}
+
+func configChangeValues(changes []domain.ConfigChange) map[string]*string {
+	if len(changes) == 0 {
+		return nil
+	}
+	out := make(map[string]*string, len(changes))
+	for _, c := range changes {
+		v := c.New
+		out[c.Key] = &v
+	}
+	return out
+}
+
+func sortedClusterNames(state domain.DesiredTopicState) []string {
+	names := make([]string, 0, len(state.Clusters))
+	for name := range state.Clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDesiredTopicState(t *testing.T) {
+	t.Parallel()
+
+	state, err := ParseDesiredTopicState([]byte(`
+clusters:
+  c1:
+    topics:
+      - name: orders
+        partitions: 6
+        replication_factor: 3
+        configs:
+          retention.ms: "86400000"
+`))
+	require.NoError(t, err)
+	require.Len(t, state.Clusters["c1"].Topics, 1)
+	require.Equal(t, "86400000", state.Clusters["c1"].Topics[0].Configs["retention.ms"])
+
+	invalid := []string{
+		``,
+		`clusters: {c1: {topics: [{name: orders, partitions: 0, replication_factor: 1}]}}`,
+		`clusters: {c1: {topics: [{name: orders, partitions: 1, replication_factor: 0}]}}`,
+		`clusters: {c1: {topics: [{partitions: 1, replication_factor: 1}]}}`,
+		`clusters: {c1: {topics: [{name: a, partitions: 1, replication_factor: 1}, {name: a, partitions: 1, replication_factor: 1}]}}`,
+		`clusters: {c1: {topics: [{name: a, partitions: 1, replication_factor: 1, unknown: true}]}}`,
+	}
+	for _, doc := range invalid {
+		_, err := ParseDesiredTopicState([]byte(doc))
+		require.ErrorIs(t, err, ErrInvalidTopicState, doc)
+	}
+}
+
+func TestTopicPlanService_Plan(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.Topics = map[string]int{"orders": 3, "legacy": 1}
+	fake.TopicDetail = &domain.TopicDetail{
+		Name:              "orders",
+		Partitions:        3,
+		ReplicationFactor: 3,
+		Configs:           map[string]string{"retention.ms": "1000", "cleanup.policy": "delete"},
+	}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewTopicPlanService(cs, NewTopicService(cs))
+
+	desired := domain.DesiredTopicState{Clusters: map[string]domain.DesiredClusterTopics{
+		"c1": {Topics: []domain.DesiredTopic{
+			{Name: "payments", Partitions: 2, ReplicationFactor: 3, Configs: map[string]string{"retention.ms": "5000"}},
+			{Name: "orders", Partitions: 6, ReplicationFactor: 2, Configs: map[string]string{"retention.ms": "2000", "cleanup.policy": "delete"}},
+		}},
+	}}
+
+	plans, err := svc.Plan(desired, false)
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+	require.Equal(t, []domain.TopicChange{
+		{Action: domain.TopicActionUpdateConfig, Topic: "orders", Configs: []domain.ConfigChange{{Key: "retention.ms", Old: "1000", New: "2000"}}},
+		{Action: domain.TopicActionIncreasePartitions, Topic: "orders", FromPartitions: 3, ToPartitions: 6},
+		{Action: domain.TopicActionCreate, Topic: "payments", ToPartitions: 2, ReplicationFactor: 3, Configs: []domain.ConfigChange{{Key: "retention.ms", New: "5000"}}},
+	}, plans[0].Changes)
+	require.Len(t, plans[0].Warnings, 1)
+
+	plans, err = svc.Plan(desired, true)
+	require.NoError(t, err)
+	last := plans[0].Changes[len(plans[0].Changes)-1]
+	require.Equal(t, domain.TopicChange{Action: domain.TopicActionDelete, Topic: "legacy"}, last)
+
+	_, err = svc.Plan(domain.DesiredTopicState{Clusters: map[string]domain.DesiredClusterTopics{"unknown": {}}}, false)
+	require.ErrorIs(t, err, ErrClusterNotFound)
+}
+
+func TestTopicPlanService_Apply(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewTopicPlanService(cs, NewTopicService(cs))
+
+	desired := domain.DesiredTopicState{Clusters: map[string]domain.DesiredClusterTopics{
+		"c1": {Topics: []domain.DesiredTopic{{Name: "orders", Partitions: 1, ReplicationFactor: 1}}},
+	}}
+
+	results, err := svc.Apply(desired, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Results, 1)
+	require.False(t, results[0].Failed())
+
+	// plans are applied as they were computed, even when the cluster changed in between
+	fake.Topics["orders"] = 1
+	plans, err := svc.Plan(domain.DesiredTopicState{Clusters: map[string]domain.DesiredClusterTopics{"c1": {}}}, true)
+	require.NoError(t, err)
+	require.Len(t, plans[0].Changes, 1)
+	fake.Topics["payments"] = 1
+	results, err = svc.ApplyPlans(plans)
+	require.NoError(t, err)
+	require.Len(t, results[0].Results, 1)
+	require.Equal(t, domain.TopicActionDelete, results[0].Results[0].Change.Action)
+	require.Equal(t, "orders", results[0].Results[0].Change.Topic)
+
+	fake.Topics = map[string]int{}
+	fake.Err = errors.New("boom")
+	_, err = svc.Apply(desired, false)
+	require.Error(t, err)
+}
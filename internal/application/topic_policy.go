@@ -0,0 +1,135 @@
+package application
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+)
+
+// Topic policy rules reported in a PolicyViolation.
+const (
+	PolicyRuleName             = "name_pattern"
+	PolicyRuleMinPartitions    = "min_partitions"
+	PolicyRuleMaxPartitions    = "max_partitions"
+	PolicyRuleMinReplication   = "min_replication_factor"
+	PolicyRuleRequireMinInsync = "require_min_insync_replicas"
+	PolicyRuleForbiddenConfig  = "forbidden_configs"
+)
+
+const minInsyncReplicasConfig = "min.insync.replicas"
+
+// PolicyViolation is a single topic policy rule broken by a request.
+type PolicyViolation struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// TopicPolicyError is returned when a request breaks the topic policy of a cluster.
+// It matches ErrTopicPolicyViolation with errors.Is.
+type TopicPolicyError struct {
+	Cluster    string
+	Topic      string
+	Violations []PolicyViolation
+}
+
+func (e *TopicPolicyError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return fmt.Sprintf("%s: topic %s on cluster %s: %s", ErrTopicPolicyViolation, e.Topic, e.Cluster, strings.Join(msgs, "; "))
+}
+
+// Is reports whether target is ErrTopicPolicyViolation.
+func (e *TopicPolicyError) Is(target error) bool {
+	return target == ErrTopicPolicyViolation
+}
+
+// checkCreateTopicPolicy validates a topic creation against the policy of its cluster.
+func checkCreateTopicPolicy(cfg config.ClusterConfig, req domain.CreateTopicRequest) error {
+	p := cfg.TopicPolicy
+	if p == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+	if p.NamePattern != "" {
+		re, err := regexp.Compile(p.NamePattern)
+		if err != nil {
+			return fmt.Errorf("cluster %s has an invalid topic name pattern %q: %w", cfg.Name, p.NamePattern, err)
+		}
+		if !re.MatchString(req.Name) {
+			violations = append(violations, PolicyViolation{PolicyRuleName, fmt.Sprintf("name must match %s", p.NamePattern)})
+		}
+	}
+	violations = append(violations, partitionViolations(p, req.NumPartitions)...)
+	if p.MinReplicationFactor > 0 && req.ReplicationFactor < p.MinReplicationFactor {
+		violations = append(violations, PolicyViolation{PolicyRuleMinReplication, fmt.Sprintf("replication factor must be at least %d", p.MinReplicationFactor)})
+	}
+	if p.RequireMinInsyncReplicas {
+		if v, ok := req.Configs[minInsyncReplicasConfig]; !ok || v == nil || *v == "" {
+			violations = append(violations, PolicyViolation{PolicyRuleRequireMinInsync, minInsyncReplicasConfig + " must be set"})
+		}
+	}
+	violations = append(violations, forbiddenConfigViolations(p, req.Configs)...)
+
+	return policyError(cfg.Name, req.Name, violations)
+}
+
+// checkUpdateTopicConfigPolicy validates a config update against the policy of the topic's cluster.
+func checkUpdateTopicConfigPolicy(cfg config.ClusterConfig, topicName string, req domain.UpdateTopicConfigRequest) error {
+	p := cfg.TopicPolicy
+	if p == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+	if p.RequireMinInsyncReplicas {
+		if v, ok := req.Configs[minInsyncReplicasConfig]; ok && (v == nil || *v == "") {
+			violations = append(violations, PolicyViolation{PolicyRuleRequireMinInsync, minInsyncReplicasConfig + " cannot be removed"})
+		}
+	}
+	violations = append(violations, forbiddenConfigViolations(p, req.Configs)...)
+
+	return policyError(cfg.Name, topicName, violations)
+}
+
+// checkIncreasePartitionsPolicy validates a new partition count against the policy of the topic's cluster.
+func checkIncreasePartitionsPolicy(cfg config.ClusterConfig, topicName string, req domain.IncreasePartitionsRequest) error {
+	if cfg.TopicPolicy == nil {
+		return nil
+	}
+	return policyError(cfg.Name, topicName, partitionViolations(cfg.TopicPolicy, req.TotalPartitions))
+}
+
+func partitionViolations(p *config.TopicPolicy, partitions int32) []PolicyViolation {
+	var violations []PolicyViolation
+	if p.MinPartitions > 0 && partitions < p.MinPartitions {
+		violations = append(violations, PolicyViolation{PolicyRuleMinPartitions, fmt.Sprintf("partitions must be at least %d", p.MinPartitions)})
+	}
+	if p.MaxPartitions > 0 && partitions > p.MaxPartitions {
+		violations = append(violations, PolicyViolation{PolicyRuleMaxPartitions, fmt.Sprintf("partitions must be at most %d", p.MaxPartitions)})
+	}
+	return violations
+}
+
+// forbiddenConfigViolations reports the forbidden configs that configs sets. Removing them is always allowed.
+func forbiddenConfigViolations(p *config.TopicPolicy, configs map[string]*string) []PolicyViolation {
+	var violations []PolicyViolation
+	for _, key := range p.ForbiddenConfigs {
+		if v, ok := configs[key]; ok && v != nil {
+			violations = append(violations, PolicyViolation{PolicyRuleForbiddenConfig, key + " cannot be set"})
+		}
+	}
+	return violations
+}
+
+func policyError(cluster, topic string, violations []PolicyViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return &TopicPolicyError{Cluster: cluster, Topic: topic, Violations: violations}
+}
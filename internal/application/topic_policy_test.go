@@ -0,0 +1,83 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCreateTopicPolicy(t *testing.T) {
+	t.Parallel()
+	cfg := config.ClusterConfig{Name: "prod", TopicPolicy: &config.TopicPolicy{
+		NamePattern:              `^[a-z]+\.[a-z-]+$`,
+		MinPartitions:            3,
+		MaxPartitions:            48,
+		MinReplicationFactor:     3,
+		RequireMinInsyncReplicas: true,
+		ForbiddenConfigs:         []string{"unclean.leader.election.enable"},
+	}}
+
+	two, enabled := "2", "true"
+	require.NoError(t, checkCreateTopicPolicy(cfg, domain.CreateTopicRequest{
+		Name: "orders.created", NumPartitions: 6, ReplicationFactor: 3,
+		Configs: map[string]*string{"min.insync.replicas": &two},
+	}))
+
+	err := checkCreateTopicPolicy(cfg, domain.CreateTopicRequest{
+		Name: "test123", NumPartitions: 1, ReplicationFactor: 1,
+		Configs: map[string]*string{"unclean.leader.election.enable": &enabled},
+	})
+	require.ErrorIs(t, err, ErrTopicPolicyViolation)
+	var policyErr *TopicPolicyError
+	require.True(t, errors.As(err, &policyErr))
+	rules := make([]string, 0, len(policyErr.Violations))
+	for _, v := range policyErr.Violations {
+		rules = append(rules, v.Rule)
+	}
+	require.Equal(t, []string{PolicyRuleName, PolicyRuleMinPartitions, PolicyRuleMinReplication, PolicyRuleRequireMinInsync, PolicyRuleForbiddenConfig}, rules)
+
+	require.NoError(t, checkCreateTopicPolicy(config.ClusterConfig{Name: "dev"}, domain.CreateTopicRequest{Name: "test123"}))
+
+	cfg.TopicPolicy = &config.TopicPolicy{NamePattern: "("}
+	err = checkCreateTopicPolicy(cfg, domain.CreateTopicRequest{Name: "orders"})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrTopicPolicyViolation)
+}
+
+func TestCheckUpdateTopicConfigPolicy(t *testing.T) {
+	t.Parallel()
+	cfg := config.ClusterConfig{Name: "prod", TopicPolicy: &config.TopicPolicy{
+		RequireMinInsyncReplicas: true,
+		ForbiddenConfigs:         []string{"unclean.leader.election.enable"},
+		MaxPartitions:            12,
+	}}
+
+	retention := "1000"
+	require.NoError(t, checkUpdateTopicConfigPolicy(cfg, "orders", domain.UpdateTopicConfigRequest{
+		Configs: map[string]*string{"retention.ms": &retention, "unclean.leader.election.enable": nil},
+	}))
+	require.ErrorIs(t, checkUpdateTopicConfigPolicy(cfg, "orders", domain.UpdateTopicConfigRequest{
+		Configs: map[string]*string{"min.insync.replicas": nil},
+	}), ErrTopicPolicyViolation)
+
+	require.NoError(t, checkIncreasePartitionsPolicy(cfg, "orders", domain.IncreasePartitionsRequest{TotalPartitions: 12}))
+	require.ErrorIs(t, checkIncreasePartitionsPolicy(cfg, "orders", domain.IncreasePartitionsRequest{TotalPartitions: 24}), ErrTopicPolicyViolation)
+}
+
+func TestTopicService_CreateTopicPolicy(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "prod", Brokers: []string{"b1"}, TopicPolicy: &config.TopicPolicy{MinReplicationFactor: 3}}}
+	repo.Clients["prod"] = testutil.NewFakeKafkaClient()
+
+	svc := NewTopicService(NewClusterService(repo))
+	err := svc.CreateTopic("prod", domain.CreateTopicRequest{Name: "test123", NumPartitions: 1, ReplicationFactor: 1})
+	require.ErrorIs(t, err, ErrTopicPolicyViolation)
+	require.NoError(t, svc.CreateTopic("prod", domain.CreateTopicRequest{Name: "orders", NumPartitions: 1, ReplicationFactor: 3}))
+}
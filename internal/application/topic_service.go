@@ -2,8 +2,10 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
 )
@@ -22,12 +24,15 @@ func NewTopicService(clusterService *ClusterService) *TopicService {
 	}
 }
 
-// ListTopics retrieves all topics from a cluster.
+// ListTopics retrieves the topics of a cluster the user may view.
 func (s *TopicService) ListTopics(clusterName string, showInternal bool) (map[string]int, error) {
 	_, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return nil, ErrClusterNotFound
 	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -41,7 +46,7 @@ func (s *TopicService) ListTopics(clusterName string, showInternal bool) (map[st
 		return nil, err
 	}
 
-	return topics, nil
+	return filterAllowed(s.clusterService, clusterName, domain.ResourceTopic, topics), nil
 }
 
 // GetTopicDetail retrieves detailed information about a specific topic.
@@ -50,6 +55,9 @@ func (s *TopicService) GetTopicDetail(clusterName, topicName string) (*domain.To
 	if !ok {
 		return nil, ErrClusterNotFound
 	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.TopicResource(topicName)); err != nil {
+		return nil, err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -66,8 +74,59 @@ func (s *TopicService) GetTopicDetail(clusterName, topicName string) (*domain.To
 	return detail, nil
 }
 
+// ListTopicTemplates returns the topic templates defined in the configuration file.
+func (s *TopicService) ListTopicTemplates() []config.TopicTemplate {
+	return s.repo.FindTopicTemplates()
+}
+
+// ApplyTopicTemplate fills the partitions, replication factor and configs left unset in req
+// from the named topic template. Values already set in req take precedence.
+func (s *TopicService) ApplyTopicTemplate(name string, req *domain.CreateTopicRequest) error {
+	var tmpl *config.TopicTemplate
+	for _, t := range s.repo.FindTopicTemplates() {
+		if t.Name == name {
+			tmpl = &t
+			break
+		}
+	}
+	if tmpl == nil {
+		return fmt.Errorf("%w: %s", ErrTopicTemplateNotFound, name)
+	}
+
+	if req.NumPartitions <= 0 {
+		req.NumPartitions = tmpl.Partitions
+	}
+	if req.ReplicationFactor <= 0 {
+		req.ReplicationFactor = tmpl.ReplicationFactor
+	}
+	for k, v := range tmpl.Configs {
+		if _, ok := req.Configs[k]; ok {
+			continue
+		}
+		if req.Configs == nil {
+			req.Configs = make(map[string]*string, len(tmpl.Configs))
+		}
+		req.Configs[k] = &v
+	}
+	return nil
+}
+
 // CreateTopic creates a new topic in the cluster.
-func (s *TopicService) CreateTopic(clusterName string, req domain.CreateTopicRequest) error {
+func (s *TopicService) CreateTopic(clusterName string, req domain.CreateTopicRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicCreate, clusterName, domain.TopicResource(req.Name))
+	defer func() { audit.done(err) }()
+
+	if req.Template != "" {
+		if err := s.ApplyTopicTemplate(req.Template, &req); err != nil {
+			return err
+		}
+	}
+	audit.after = map[string]any{
+		"partitions":         req.NumPartitions,
+		"replication_factor": req.ReplicationFactor,
+		"configs":            req.Configs,
+		"template":           req.Template,
+	}
 	if req.Name == "" {
 		return ErrInvalidTopicName
 	}
@@ -78,10 +137,20 @@ func (s *TopicService) CreateTopic(clusterName string, req domain.CreateTopicReq
 		return ErrInvalidReplicationFactor
 	}
 
-	_, ok := s.clusterService.GetCluster(clusterName)
+	cfg, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return ErrClusterNotFound
 	}
+	if err := s.clusterService.checkWritable(cfg); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(req.Name)); err != nil {
+		return err
+	}
+	if err := checkCreateTopicPolicy(cfg, req); err != nil {
+		utils.Logger.Warn("create topic rejected by policy", "cluster", clusterName, "topic", req.Name, "err", err)
+		return err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -98,11 +167,17 @@ func (s *TopicService) CreateTopic(clusterName string, req domain.CreateTopicReq
 	return nil
 }
 
-// DeleteTopic removes a topic from the cluster.
-func (s *TopicService) DeleteTopic(clusterName, topicName string) error {
-	_, ok := s.clusterService.GetCluster(clusterName)
-	if !ok {
-		return ErrClusterNotFound
+// DeleteTopic removes a topic from the cluster. Unless req.Confirmation repeats the topic name,
+// the deletion checks run first and a *TopicDeletionError is returned when any of them fires.
+func (s *TopicService) DeleteTopic(clusterName, topicName string, req domain.DeleteTopicRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicDelete, clusterName, domain.TopicResource(topicName))
+	defer func() { audit.done(err) }()
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
 	}
 
 	client, ok := s.repo.GetClient(clusterName)
@@ -111,25 +186,55 @@ func (s *TopicService) DeleteTopic(clusterName, topicName string) error {
 		return ErrClusterNotFound
 	}
 
+	if req.Confirmation != topicName {
+		check, err := s.checkTopicDeletion(clusterName, client, topicName)
+		if err != nil {
+			utils.Logger.Error("check topic deletion failed", "cluster", clusterName, "topic", topicName, "err", err)
+			return err
+		}
+		if check.RequiresConfirmation() {
+			utils.Logger.Warn("delete topic needs confirmation", "cluster", clusterName, "topic", topicName)
+			return &TopicDeletionError{Cluster: clusterName, Check: *check}
+		}
+	}
+
+	audit.before = auditTopic(client, topicName)
 	if err := client.DeleteTopic(topicName); err != nil {
 		utils.Logger.Error("delete topic failed", "cluster", clusterName, "topic", topicName, "err", err)
 		return err
 	}
+	if err := s.clearQuarantine(clusterName, client, topicName); err != nil {
+		utils.Logger.Warn("clear topic quarantine failed", "cluster", clusterName, "topic", topicName, "err", err)
+	}
 
 	utils.Logger.Info("topic deleted", "cluster", clusterName, "topic", topicName)
 	return nil
 }
 
 // UpdateTopicConfig updates the configuration of an existing topic.
-func (s *TopicService) UpdateTopicConfig(clusterName, topicName string, req domain.UpdateTopicConfigRequest) error {
+func (s *TopicService) UpdateTopicConfig(clusterName, topicName string, req domain.UpdateTopicConfigRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicUpdateConfig, clusterName, domain.TopicResource(topicName))
+	audit.after = req.Configs
+	defer func() { audit.done(err) }()
+
 	if len(req.Configs) == 0 {
 		return ErrInvalidTopicConfig
 	}
 
-	_, ok := s.clusterService.GetCluster(clusterName)
+	cfg, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return ErrClusterNotFound
 	}
+	if err := s.clusterService.checkWritable(cfg); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+	if err := checkUpdateTopicConfigPolicy(cfg, topicName, req); err != nil {
+		utils.Logger.Warn("update topic config rejected by policy", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -137,6 +242,7 @@ func (s *TopicService) UpdateTopicConfig(clusterName, topicName string, req doma
 		return ErrClusterNotFound
 	}
 
+	audit.before = auditTopicConfigs(client, topicName, req.Configs)
 	if err := client.UpdateTopicConfig(topicName, req); err != nil {
 		utils.Logger.Error("update topic config failed", "cluster", clusterName, "topic", topicName, "err", err)
 		return err
@@ -147,15 +253,29 @@ func (s *TopicService) UpdateTopicConfig(clusterName, topicName string, req doma
 }
 
 // IncreasePartitions increases the number of partitions for a topic.
-func (s *TopicService) IncreasePartitions(clusterName, topicName string, req domain.IncreasePartitionsRequest) error {
+func (s *TopicService) IncreasePartitions(clusterName, topicName string, req domain.IncreasePartitionsRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTopicIncreasePartitions, clusterName, domain.TopicResource(topicName))
+	audit.after = map[string]int32{"partitions": req.TotalPartitions}
+	defer func() { audit.done(err) }()
+
 	if req.TotalPartitions <= 0 {
 		return ErrInvalidPartitionCount
 	}
 
-	_, ok := s.clusterService.GetCluster(clusterName)
+	cfg, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return ErrClusterNotFound
 	}
+	if err := s.clusterService.checkWritable(cfg); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+	if err := checkIncreasePartitionsPolicy(cfg, topicName, req); err != nil {
+		utils.Logger.Warn("increase partitions rejected by policy", "cluster", clusterName, "topic", topicName, "err", err)
+		return err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -163,6 +283,9 @@ func (s *TopicService) IncreasePartitions(clusterName, topicName string, req dom
 		return ErrClusterNotFound
 	}
 
+	if t := auditTopic(client, topicName); t != nil {
+		audit.before = map[string]int{"partitions": t.Partitions}
+	}
 	if err := client.IncreasePartitions(topicName, req); err != nil {
 		utils.Logger.Error("increase partitions failed", "cluster", clusterName, "topic", topicName, "err", err)
 		return err
@@ -172,12 +295,106 @@ func (s *TopicService) IncreasePartitions(clusterName, topicName string, req dom
 	return nil
 }
 
+// PreviewDeleteRecords reports how many records a delete records request would remove, per partition.
+func (s *TopicService) PreviewDeleteRecords(clusterName, topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	if err := validateDeleteRecords(req); err != nil {
+		return nil, err
+	}
+
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("preview delete records client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+
+	results, err := client.PreviewDeleteRecords(topicName, req)
+	if err != nil {
+		utils.Logger.Error("preview delete records failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DeleteRecords deletes records from a topic up to an offset, a timestamp, or entirely.
+func (s *TopicService) DeleteRecords(clusterName, topicName string, req domain.DeleteRecordsRequest) (_ []domain.DeleteRecordsResult, err error) {
+	audit := s.clusterService.audit(domain.AuditTopicDeleteRecords, clusterName, domain.TopicResource(topicName))
+	request := map[string]any{"offsets": req.Offsets, "before_timestamp": req.BeforeTimestamp, "all": req.All}
+	audit.after = request
+	defer func() { audit.done(err) }()
+
+	if err := validateDeleteRecords(req); err != nil {
+		return nil, err
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return nil, err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("delete records client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+
+	results, err := client.DeleteRecords(topicName, req)
+	if err != nil {
+		utils.Logger.Error("delete records failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return nil, err
+	}
+
+	var total int64
+	for _, r := range results {
+		total += r.Records
+	}
+	request["records"] = total
+	utils.Logger.Info("topic records deleted", "cluster", clusterName, "topic", topicName, "records", total)
+	return results, nil
+}
+
+// validateDeleteRecords ensures exactly one record selector is set and offsets are not negative.
+func validateDeleteRecords(req domain.DeleteRecordsRequest) error {
+	selectors := 0
+	if len(req.Offsets) > 0 {
+		selectors++
+	}
+	if req.BeforeTimestamp > 0 {
+		selectors++
+	}
+	if req.All {
+		selectors++
+	}
+	if selectors != 1 {
+		return ErrInvalidDeleteRecords
+	}
+	for _, o := range req.Offsets {
+		if o < 0 {
+			return ErrInvalidDeleteRecords
+		}
+	}
+	return nil
+}
+
 // StreamMessages streams messages from a topic to a channel.
 func (s *TopicService) StreamMessages(ctx context.Context, clusterName, topicName string, out chan<- domain.Message) error {
 	_, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return ErrClusterNotFound
 	}
+	if err := s.clusterService.authorize(domain.ActionConsume, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
 
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
@@ -189,18 +406,46 @@ func (s *TopicService) StreamMessages(ctx context.Context, clusterName, topicNam
 	return nil
 }
 
-// WriteMessage writes a message to the specified topic within the given cluster. Returns an error if the operation fails.
-func (s *TopicService) WriteMessage(clusterName, topicName string, msg domain.Message) (err error) {
+// ConsumeMessages reads a topic from the requested position into a channel until the context is canceled.
+func (s *TopicService) ConsumeMessages(ctx context.Context, clusterName, topicName string, req domain.ConsumeRequest, out chan<- domain.Message) error {
+	if topicName == "" {
+		return ErrInvalidTopicName
+	}
 	_, ok := s.clusterService.GetCluster(clusterName)
 	if !ok {
 		return ErrClusterNotFound
 	}
+	if err := s.clusterService.authorize(domain.ActionConsume, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("consume messages client not found", "cluster", clusterName)
+		return ErrClusterNotFound
+	}
+	return client.ConsumeMessages(ctx, topicName, req, out)
+}
+
+// WriteMessage writes a message to the specified topic within the given cluster. Returns an error if the operation fails.
+func (s *TopicService) WriteMessage(clusterName, topicName string, msg domain.Message) (err error) {
+	audit := s.clusterService.audit(domain.AuditMessageProduce, clusterName, domain.TopicResource(topicName))
+	// Only the key and size are recorded, as values may carry personal data.
+	audit.after = map[string]any{"key": string(msg.Key), "bytes": len(msg.Value)}
+	defer func() { audit.done(err) }()
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionProduce, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
 	client, ok := s.repo.GetClient(clusterName)
 	if !ok {
 		utils.Logger.Warn("write message client not found", "cluster", clusterName)
 		return ErrClusterNotFound
 	}
-	ctx, _ := context.WithTimeout(context.Background(), 5*time.Second)
-	client.WriteMessage(ctx, topicName, msg)
-	return nil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return client.WriteMessage(ctx, topicName, msg)
 }
@@ -84,8 +84,80 @@ func TestTopicService_GetTopicDetailAndMutations(t *testing.T) {
 	require.NoError(t, err)
 
 	// delete topic
-	err = svc.DeleteTopic("unknown", "t")
+	err = svc.DeleteTopic("unknown", "t", domain.DeleteTopicRequest{})
 	require.Error(t, err)
-	err = svc.DeleteTopic("c1", "t")
+	err = svc.DeleteTopic("c1", "t", domain.DeleteTopicRequest{})
 	require.NoError(t, err)
 }
+
+func TestTopicService_DeleteRecords(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.DeletedRecords = []domain.DeleteRecordsResult{
+		{Partition: 0, StartOffset: 0, EndOffset: 10, TargetOffset: 10, Records: 10},
+		{Partition: 1, StartOffset: 5, EndOffset: 8, TargetOffset: 8, Records: 3},
+	}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewTopicService(cs)
+
+	// no selector
+	_, err := svc.PreviewDeleteRecords("c1", "t", domain.DeleteRecordsRequest{})
+	require.ErrorIs(t, err, ErrInvalidDeleteRecords)
+
+	// more than one selector
+	_, err = svc.DeleteRecords("c1", "t", domain.DeleteRecordsRequest{All: true, BeforeTimestamp: 1})
+	require.ErrorIs(t, err, ErrInvalidDeleteRecords)
+
+	// negative offset
+	_, err = svc.DeleteRecords("c1", "t", domain.DeleteRecordsRequest{Offsets: map[int32]int64{0: -1}})
+	require.ErrorIs(t, err, ErrInvalidDeleteRecords)
+
+	// missing cluster
+	_, err = svc.DeleteRecords("unknown", "t", domain.DeleteRecordsRequest{All: true})
+	require.Error(t, err)
+
+	// success
+	preview, err := svc.PreviewDeleteRecords("c1", "t", domain.DeleteRecordsRequest{All: true})
+	require.NoError(t, err)
+	require.Len(t, preview, 2)
+	results, err := svc.DeleteRecords("c1", "t", domain.DeleteRecordsRequest{Offsets: map[int32]int64{0: 10, 1: 8}})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), results[1].Records)
+}
+
+func TestTopicService_ApplyTopicTemplate(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	repo.Templates = []config.TopicTemplate{{
+		Name:              "compacted-changelog",
+		Partitions:        6,
+		ReplicationFactor: 3,
+		Configs:           map[string]string{"cleanup.policy": "compact", "min.insync.replicas": "2"},
+	}}
+	repo.Clients["c1"] = testutil.NewFakeKafkaClient()
+
+	svc := NewTopicService(NewClusterService(repo))
+	require.Len(t, svc.ListTopicTemplates(), 1)
+
+	override := "3"
+	req := domain.CreateTopicRequest{Name: "t", NumPartitions: 12, Configs: map[string]*string{"min.insync.replicas": &override}}
+	require.NoError(t, svc.ApplyTopicTemplate("compacted-changelog", &req))
+	require.Equal(t, int32(12), req.NumPartitions)
+	require.Equal(t, int16(3), req.ReplicationFactor)
+	require.Equal(t, "compact", *req.Configs["cleanup.policy"])
+	require.Equal(t, "3", *req.Configs["min.insync.replicas"])
+
+	err := svc.ApplyTopicTemplate("missing", &domain.CreateTopicRequest{})
+	require.ErrorIs(t, err, ErrTopicTemplateNotFound)
+
+	// partitions and replication factor come from the template
+	require.NoError(t, svc.CreateTopic("c1", domain.CreateTopicRequest{Name: "t", Template: "compacted-changelog"}))
+	require.ErrorIs(t, svc.CreateTopic("c1", domain.CreateTopicRequest{Name: "t", Template: "missing"}), ErrTopicTemplateNotFound)
+}
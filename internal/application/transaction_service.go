@@ -0,0 +1,107 @@
+package application
+
+import (
+	"strings"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+// TransactionService provides operations related to transactions and idempotent producers.
+type TransactionService struct {
+	clusterService *ClusterService
+	repo           domain.ClusterRepository
+}
+
+// NewTransactionService creates a new transaction service.
+func NewTransactionService(clusterService *ClusterService) *TransactionService {
+	return &TransactionService{
+		clusterService: clusterService,
+		repo:           clusterService.getRepo(),
+	}
+}
+
+// ListTransactions returns every transactional ID of a cluster with its current state.
+func (s *TransactionService) ListTransactions(clusterName string) ([]domain.Transaction, error) {
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	txns, err := client.ListTransactions()
+	if err != nil {
+		utils.Logger.Error("list transactions failed", "cluster", clusterName, "err", err)
+		return nil, err
+	}
+	return txns, nil
+}
+
+// ListProducers returns the active producers of every partition of a topic.
+func (s *TransactionService) ListProducers(clusterName, topicName string) ([]domain.ActiveProducer, error) {
+	if strings.TrimSpace(topicName) == "" {
+		return nil, ErrInvalidTopicName
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.TopicResource(topicName)); err != nil {
+		return nil, err
+	}
+
+	producers, err := client.DescribeProducers(topicName)
+	if err != nil {
+		utils.Logger.Error("describe producers failed", "cluster", clusterName, "topic", topicName, "err", err)
+		return nil, err
+	}
+	return producers, nil
+}
+
+// AbortTransaction aborts the open transaction a producer holds on a topic partition.
+func (s *TransactionService) AbortTransaction(clusterName, topicName string, req domain.AbortTransactionRequest) (err error) {
+	audit := s.clusterService.audit(domain.AuditTransactionAbort, clusterName, domain.TopicResource(topicName))
+	audit.after = req
+	defer func() { audit.done(err) }()
+
+	if strings.TrimSpace(topicName) == "" || req.Partition < 0 || req.ProducerID < 0 {
+		return ErrInvalidAbortTransaction
+	}
+
+	if err := s.clusterService.CheckWritable(clusterName); err != nil {
+		return err
+	}
+	if err := s.clusterService.authorize(domain.ActionTopicAdmin, clusterName, domain.TopicResource(topicName)); err != nil {
+		return err
+	}
+
+	client, err := s.client(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.AbortTransaction(topicName, req); err != nil {
+		utils.Logger.Error("abort transaction failed", "cluster", clusterName, "topic", topicName, "partition", req.Partition, "producer_id", req.ProducerID, "err", err)
+		return err
+	}
+
+	utils.Logger.Info("transaction aborted", "cluster", clusterName, "topic", topicName, "partition", req.Partition, "producer_id", req.ProducerID)
+	return nil
+}
+
+func (s *TransactionService) client(clusterName string) (domain.KafkaClient, error) {
+	_, ok := s.clusterService.GetCluster(clusterName)
+	if !ok {
+		return nil, ErrClusterNotFound
+	}
+	if err := s.clusterService.authorize(domain.ActionView, clusterName, domain.ClusterResource); err != nil {
+		return nil, err
+	}
+
+	client, ok := s.repo.GetClient(clusterName)
+	if !ok {
+		utils.Logger.Warn("transaction client not found", "cluster", clusterName)
+		return nil, ErrClusterNotFound
+	}
+	return client, nil
+}
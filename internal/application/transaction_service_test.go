@@ -0,0 +1,61 @@
+package application
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/testutil"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionService_List(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	fake.Transactions = []domain.Transaction{{TransactionalID: "tx-1", State: "Ongoing", ProducerID: 7}}
+	fake.Producers = []domain.ActiveProducer{{Topic: "orders", Partition: 0, ProducerID: 7, CurrentTxnStartOffset: 42}}
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewTransactionService(cs)
+
+	_, err := svc.ListTransactions("unknown")
+	require.ErrorIs(t, err, ErrClusterNotFound)
+
+	txns, err := svc.ListTransactions("c1")
+	require.NoError(t, err)
+	require.Equal(t, fake.Transactions, txns)
+
+	_, err = svc.ListProducers("c1", " ")
+	require.ErrorIs(t, err, ErrInvalidTopicName)
+
+	producers, err := svc.ListProducers("c1", "orders")
+	require.NoError(t, err)
+	require.Len(t, producers, 1)
+	require.True(t, producers[0].InTransaction())
+}
+
+func TestTransactionService_AbortTransaction(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	repo := testutil.NewFakeClusterRepository()
+	repo.Cfgs = []config.ClusterConfig{{Name: "c1", Brokers: []string{"b1"}}}
+	fake := testutil.NewFakeKafkaClient()
+	repo.Clients["c1"] = fake
+
+	cs := NewClusterService(repo)
+	svc := NewTransactionService(cs)
+
+	require.ErrorIs(t, svc.AbortTransaction("c1", "", domain.AbortTransactionRequest{}), ErrInvalidAbortTransaction)
+	require.ErrorIs(t, svc.AbortTransaction("c1", "orders", domain.AbortTransactionRequest{Partition: -1, ProducerID: 7}), ErrInvalidAbortTransaction)
+	require.ErrorIs(t, svc.AbortTransaction("unknown", "orders", domain.AbortTransactionRequest{ProducerID: 7}), ErrClusterNotFound)
+	require.NoError(t, svc.AbortTransaction("c1", "orders", domain.AbortTransactionRequest{ProducerID: 7}))
+
+	fake.Err = errors.New("boom")
+	require.Error(t, svc.AbortTransaction("c1", "orders", domain.AbortTransactionRequest{ProducerID: 7}))
+}
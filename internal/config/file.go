@@ -6,8 +6,11 @@
 package config
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
+	"io"
 	"os"
 	"time"
 
@@ -16,13 +19,27 @@ import (
 
 // ClusterConfig holds cluster connectivity and security configuration.
 type ClusterConfig struct {
-	Name     string            `yaml:"name" json:"name"`
-	Brokers  []string          `yaml:"brokers" json:"brokers"`
-	ClientID string            `yaml:"client_id,omitempty" json:"client_id,omitempty"`
-	TLS      *TLSConfig        `yaml:"tls,omitempty" json:"tls,omitempty"`
-	SASL     *SASLConfig       `yaml:"sasl,omitempty" json:"sasl,omitempty"`
-	AWS      *AWSConfig        `yaml:"aws,omitempty" json:"aws,omitempty"`
-	Options  map[string]string `yaml:"options,omitempty" json:"options,omitempty"`
+	Name        string            `yaml:"name" json:"name"`
+	Brokers     []string          `yaml:"brokers" json:"brokers"`
+	ClientID    string            `yaml:"client_id,omitempty" json:"client_id,omitempty"`
+	TLS         *TLSConfig        `yaml:"tls,omitempty" json:"tls,omitempty"`
+	SASL        *SASLConfig       `yaml:"sasl,omitempty" json:"sasl,omitempty"`
+	AWS         *AWSConfig        `yaml:"aws,omitempty" json:"aws,omitempty"`
+	Options     map[string]string `yaml:"options,omitempty" json:"options,omitempty"`
+	TopicPolicy *TopicPolicy      `yaml:"topic_policy,omitempty" json:"topic_policy,omitempty"`
+	// ReadOnly rejects every change to the cluster. Protected allows changes once the cluster name is typed to confirm them.
+	ReadOnly  bool `yaml:"read_only,omitempty" json:"read_only,omitempty"`
+	Protected bool `yaml:"protected,omitempty" json:"protected,omitempty"`
+}
+
+// TopicPolicy holds naming and config rules for the topics of a cluster. Zero values disable a rule.
+type TopicPolicy struct {
+	NamePattern              string   `yaml:"name_pattern,omitempty" json:"name_pattern,omitempty"`
+	MinPartitions            int32    `yaml:"min_partitions,omitempty" json:"min_partitions,omitempty"`
+	MaxPartitions            int32    `yaml:"max_partitions,omitempty" json:"max_partitions,omitempty"`
+	MinReplicationFactor     int16    `yaml:"min_replication_factor,omitempty" json:"min_replication_factor,omitempty"`
+	RequireMinInsyncReplicas bool     `yaml:"require_min_insync_replicas,omitempty" json:"require_min_insync_replicas,omitempty"`
+	ForbiddenConfigs         []string `yaml:"forbidden_configs,omitempty" json:"forbidden_configs,omitempty"`
 }
 
 // TLSConfig holds TLS related fields.
@@ -53,9 +70,141 @@ type AWSConfig struct {
 	SessionTokenEnv string `yaml:"session_token_env,omitempty" json:"session_token_env,omitempty"`
 }
 
+// TopicTemplate is a named preset used to pre-fill topic creation.
+type TopicTemplate struct {
+	Name              string            `yaml:"name" json:"name"`
+	Description       string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Partitions        int32             `yaml:"partitions,omitempty" json:"partitions,omitempty"`
+	ReplicationFactor int16             `yaml:"replication_factor,omitempty" json:"replication_factor,omitempty"`
+	Configs           map[string]string `yaml:"configs,omitempty" json:"configs,omitempty"`
+}
+
+// QuarantinedTopic records a topic that is blocked for produce and consume until it is deleted.
+// RetentionMs keeps the topic's own retention.ms override, if any, so a release can restore it.
+type QuarantinedTopic struct {
+	Cluster       string    `yaml:"cluster" json:"cluster"`
+	Topic         string    `yaml:"topic" json:"topic"`
+	QuarantinedAt time.Time `yaml:"quarantined_at" json:"quarantined_at"`
+	DeleteAfter   time.Time `yaml:"delete_after" json:"delete_after"`
+	RetentionMs   string    `yaml:"retention_ms,omitempty" json:"retention_ms,omitempty"`
+}
+
+// AuthConfig enables sign-in for the web UI and API. Users come from a users file, an htpasswd file
+// and an OpenID Connect provider; configuring any of them turns authentication on.
+// SessionTTL is a Go duration such as "12h" and defaults to 12 hours. API tokens are kept in TokensFile
+// and are only available when it is set.
+type AuthConfig struct {
+	UsersFile    string      `yaml:"users_file,omitempty" json:"users_file,omitempty"`
+	HtpasswdFile string      `yaml:"htpasswd_file,omitempty" json:"htpasswd_file,omitempty"`
+	OIDC         *OIDCConfig `yaml:"oidc,omitempty" json:"oidc,omitempty"`
+	SessionTTL   string      `yaml:"session_ttl,omitempty" json:"session_ttl,omitempty"`
+	TokensFile   string      `yaml:"tokens_file,omitempty" json:"tokens_file,omitempty"`
+}
+
+// OIDCConfig holds the OpenID Connect client settings. The secret may be provided inline or via an env var name.
+// UsernameClaim names the ID token claim used as the user name, preferred_username by default,
+// and GroupsClaim the claim listing the user's groups, groups by default.
+type OIDCConfig struct {
+	Issuer          string   `yaml:"issuer" json:"issuer"`
+	ClientID        string   `yaml:"client_id" json:"client_id"`
+	ClientSecret    string   `yaml:"client_secret,omitempty" json:"client_secret,omitempty"`
+	ClientSecretEnv string   `yaml:"client_secret_env,omitempty" json:"client_secret_env,omitempty"`
+	RedirectURL     string   `yaml:"redirect_url" json:"redirect_url"`
+	Scopes          []string `yaml:"scopes,omitempty" json:"scopes,omitempty"`
+	UsernameClaim   string   `yaml:"username_claim,omitempty" json:"username_claim,omitempty"`
+	GroupsClaim     string   `yaml:"groups_claim,omitempty" json:"groups_claim,omitempty"`
+}
+
+// Enabled reports whether any user source is configured.
+func (a *AuthConfig) Enabled() bool {
+	return a != nil && (a.UsersFile != "" || a.HtpasswdFile != "" || a.OIDC != nil)
+}
+
+// UsersFile is the structure of the local users file referenced by AuthConfig.UsersFile.
+type UsersFile struct {
+	Users []LocalUser `yaml:"users" json:"users"`
+}
+
+// LocalUser is a user of the local users file. PasswordHash is a bcrypt hash.
+type LocalUser struct {
+	Username     string   `yaml:"username" json:"username"`
+	PasswordHash string   `yaml:"password_hash" json:"password_hash"`
+	Groups       []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+}
+
+// RBACConfig maps users and groups to roles. Without it every signed-in user may do everything;
+// with it users may only do what their roles grant.
+type RBACConfig struct {
+	Roles    []Role        `yaml:"roles" json:"roles"`
+	Bindings []RoleBinding `yaml:"bindings" json:"bindings"`
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        string       `yaml:"name" json:"name"`
+	Permissions []Permission `yaml:"permissions" json:"permissions"`
+}
+
+// Permission grants actions on the clusters, topics and consumer groups matching its glob patterns.
+// An empty pattern list matches everything of its kind, unless only patterns of the other kind are
+// given: a permission listing groups grants no topics, and one listing topics grants no groups.
+type Permission struct {
+	Actions  []string `yaml:"actions" json:"actions"`
+	Clusters []string `yaml:"clusters,omitempty" json:"clusters,omitempty"`
+	Topics   []string `yaml:"topics,omitempty" json:"topics,omitempty"`
+	Groups   []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+}
+
+// RoleBinding gives a role to users and groups. The user "*" stands for every signed-in user.
+type RoleBinding struct {
+	Role   string   `yaml:"role" json:"role"`
+	Users  []string `yaml:"users,omitempty" json:"users,omitempty"`
+	Groups []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+}
+
+// AuditConfig enables the audit log of every change, written as JSON lines to File. The file is rotated
+// once it grows past MaxSizeMB, 100 by default, keeping MaxFiles rotated files, 10 by default.
+type AuditConfig struct {
+	File      string `yaml:"file" json:"file"`
+	MaxSizeMB int    `yaml:"max_size_mb,omitempty" json:"max_size_mb,omitempty"`
+	MaxFiles  int    `yaml:"max_files,omitempty" json:"max_files,omitempty"`
+}
+
+// ServerConfig holds the HTTP server settings. Address defaults to ":" followed by MANED_SCOUT_HTTP_PORT,
+// or ":8080". BasePath serves the application under a URL prefix such as "/tools/kafka", for a reverse proxy
+// forwarding a subpath without rewriting it. The timeouts are Go durations such as "30s". MaxBodyBytes bounds
+// request bodies, 10 MiB by default. TrustedProxies lists the addresses or CIDR ranges of the proxies whose
+// X-Forwarded-For and X-Real-IP headers are believed; the headers of other clients are ignored.
+type ServerConfig struct {
+	Address         string           `yaml:"address,omitempty" json:"address,omitempty"`
+	BasePath        string           `yaml:"base_path,omitempty" json:"base_path,omitempty"`
+	TLS             *ServerTLSConfig `yaml:"tls,omitempty" json:"tls,omitempty"`
+	ReadTimeout     string           `yaml:"read_timeout,omitempty" json:"read_timeout,omitempty"`
+	WriteTimeout    string           `yaml:"write_timeout,omitempty" json:"write_timeout,omitempty"`
+	IdleTimeout     string           `yaml:"idle_timeout,omitempty" json:"idle_timeout,omitempty"`
+	ShutdownTimeout string           `yaml:"shutdown_timeout,omitempty" json:"shutdown_timeout,omitempty"`
+	MaxBodyBytes    int64            `yaml:"max_body_bytes,omitempty" json:"max_body_bytes,omitempty"`
+	TrustedProxies  []string         `yaml:"trusted_proxies,omitempty" json:"trusted_proxies,omitempty"`
+}
+
+// ServerTLSConfig serves HTTPS with the certificate and key in PEM files. The files are read again when
+// they change, so renewed certificates are picked up without a restart.
+type ServerTLSConfig struct {
+	CertFile string `yaml:"cert_file" json:"cert_file"`
+	KeyFile  string `yaml:"key_file" json:"key_file"`
+}
+
 // FileConfig represents the root configuration file structure for Maned Scout.
+// ReadOnly applies read-only mode to every cluster and to the cluster list itself.
 type FileConfig struct {
-	Clusters []ClusterConfig `yaml:"clusters" json:"clusters"`
+	ReadOnly          bool               `yaml:"read_only,omitempty" json:"read_only,omitempty"`
+	Server            *ServerConfig      `yaml:"server,omitempty" json:"server,omitempty"`
+	Auth              *AuthConfig        `yaml:"auth,omitempty" json:"auth,omitempty"`
+	RBAC              *RBACConfig        `yaml:"rbac,omitempty" json:"rbac,omitempty"`
+	Audit             *AuditConfig       `yaml:"audit,omitempty" json:"audit,omitempty"`
+	Clusters          []ClusterConfig    `yaml:"clusters" json:"clusters"`
+	TopicTemplates    []TopicTemplate    `yaml:"topic_templates,omitempty" json:"topic_templates,omitempty"`
+	QuarantinedTopics []QuarantinedTopic `yaml:"quarantined_topics,omitempty" json:"quarantined_topics,omitempty"`
 }
 
 // ReadConfig loads a FileConfig from the provided path.
@@ -69,6 +218,22 @@ func ReadConfig(path string) (FileConfig, error) {
 	return cfg, err
 }
 
+// ReadConfigStrict loads a FileConfig like ReadConfig, but fails on keys the structure does not have,
+// which are usually misspelled settings.
+func ReadConfigStrict(path string) (FileConfig, error) {
+	var cfg FileConfig
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return cfg, err
+	}
+	return cfg, nil
+}
+
 // WriteConfig persists the FileConfig to the provided path.
 func WriteConfig(path string, cfg FileConfig) error {
 	b, err := yaml.Marshal(&cfg)
@@ -205,6 +205,112 @@ func TestReadConfig(t *testing.T) {
 			t.Errorf("expected option 'request.timeout.ms' = '30000', got '%s'", cfg.Clusters[0].Options["request.timeout.ms"])
 		}
 	})
+
+	t.Run("config with topic templates", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		configPath := filepath.Join(tmpDir, "templates.yml")
+
+		yamlContent := `clusters:
+  - name: dev
+    brokers:
+      - localhost:9092
+topic_templates:
+  - name: compacted-changelog
+    description: Compacted changelog for state stores
+    partitions: 6
+    replication_factor: 3
+    configs:
+      cleanup.policy: compact
+      min.insync.replicas: "2"
+`
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		cfg, err := ReadConfig(configPath)
+		if err != nil {
+			t.Fatalf("ReadConfig() error = %v", err)
+		}
+
+		if len(cfg.TopicTemplates) != 1 {
+			t.Fatalf("expected 1 topic template, got %d", len(cfg.TopicTemplates))
+		}
+		tmpl := cfg.TopicTemplates[0]
+		if tmpl.Name != "compacted-changelog" || tmpl.Partitions != 6 || tmpl.ReplicationFactor != 3 {
+			t.Errorf("unexpected topic template %+v", tmpl)
+		}
+		if tmpl.Configs["cleanup.policy"] != "compact" {
+			t.Errorf("expected config 'cleanup.policy' = 'compact', got '%s'", tmpl.Configs["cleanup.policy"])
+		}
+	})
+
+	t.Run("config with server settings", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		configPath := filepath.Join(tmpDir, "server.yml")
+
+		yamlContent := `server:
+  address: 127.0.0.1:8443
+  base_path: /tools/kafka
+  tls:
+    cert_file: /etc/scout/tls.crt
+    key_file: /etc/scout/tls.key
+  read_timeout: 15s
+  max_body_bytes: 1048576
+  trusted_proxies:
+    - 10.0.0.0/8
+clusters: []
+`
+		if err := os.WriteFile(configPath, []byte(yamlContent), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		cfg, err := ReadConfig(configPath)
+		if err != nil {
+			t.Fatalf("ReadConfig() error = %v", err)
+		}
+
+		sv := cfg.Server
+		if sv == nil {
+			t.Fatal("expected server settings, got nil")
+		}
+		if sv.Address != "127.0.0.1:8443" || sv.BasePath != "/tools/kafka" || sv.ReadTimeout != "15s" || sv.MaxBodyBytes != 1048576 {
+			t.Errorf("unexpected server settings %+v", sv)
+		}
+		if sv.TLS == nil || sv.TLS.CertFile != "/etc/scout/tls.crt" || sv.TLS.KeyFile != "/etc/scout/tls.key" {
+			t.Errorf("unexpected server tls settings %+v", sv.TLS)
+		}
+		if len(sv.TrustedProxies) != 1 || sv.TrustedProxies[0] != "10.0.0.0/8" {
+			t.Errorf("unexpected trusted proxies %v", sv.TrustedProxies)
+		}
+	})
+}
+
+func TestReadConfigStrict(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(configPath, []byte("clusters:\n  - name: dev\n    brokers: [localhost:9092]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := ReadConfigStrict(configPath)
+	if err != nil {
+		t.Fatalf("ReadConfigStrict() error = %v", err)
+	}
+	if len(cfg.Clusters) != 1 || cfg.Clusters[0].Name != "dev" {
+		t.Errorf("ReadConfigStrict() clusters = %+v", cfg.Clusters)
+	}
+
+	if err := os.WriteFile(configPath, []byte("clusters:\n  - name: dev\n    broker: [localhost:9092]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadConfigStrict(configPath); err == nil {
+		t.Error("ReadConfigStrict() expected an error for the misspelled key")
+	}
+
+	if err := os.WriteFile(configPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadConfigStrict(configPath); err != nil {
+		t.Errorf("ReadConfigStrict() error = %v for an empty file", err)
+	}
 }
 
 func TestWriteConfig(t *testing.T) {
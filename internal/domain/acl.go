@@ -0,0 +1,37 @@
+package domain
+
+import "errors"
+
+// ErrSecurityDisabled is returned when ACLs are asked of a cluster without an authorizer, which has none.
+var ErrSecurityDisabled = errors.New("security features are disabled on the cluster")
+
+// ACL represents a single Kafka access control entry
+type ACL struct {
+	Principal    string `json:"principal" yaml:"principal"`
+	Host         string `json:"host" yaml:"host"`
+	ResourceType string `json:"resource_type" yaml:"resource_type"`
+	ResourceName string `json:"resource_name" yaml:"resource_name"`
+	PatternType  string `json:"pattern_type" yaml:"pattern_type"`
+	Operation    string `json:"operation" yaml:"operation"`
+	Permission   string `json:"permission" yaml:"permission"`
+}
+
+// ACLFilter selects ACLs to describe or delete. Empty fields match anything.
+// PatternType MATCH selects every ACL that applies to ResourceName, including wildcard and prefixed rules.
+type ACLFilter struct {
+	Principal    string `json:"principal"`
+	Host         string `json:"host"`
+	ResourceType string `json:"resource_type"`
+	ResourceName string `json:"resource_name"`
+	PatternType  string `json:"pattern_type"`
+	Operation    string `json:"operation"`
+	Permission   string `json:"permission"`
+}
+
+// ACL enum values as understood by the brokers.
+var (
+	ACLResourceTypes = []string{"TOPIC", "GROUP", "CLUSTER", "TRANSACTIONAL_ID", "DELEGATION_TOKEN"}
+	ACLPatternTypes  = []string{"LITERAL", "PREFIXED"}
+	ACLOperations    = []string{"ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"}
+	ACLPermissions   = []string{"ALLOW", "DENY"}
+)
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Audited actions. They name what was changed, unlike the RBAC actions that name what a user may do.
+const (
+	AuditClusterAdd              = "cluster.add"
+	AuditClusterUpdate           = "cluster.update"
+	AuditClusterDelete           = "cluster.delete"
+	AuditTopicCreate             = "topic.create"
+	AuditTopicDelete             = "topic.delete"
+	AuditTopicUpdateConfig       = "topic.update-config"
+	AuditTopicIncreasePartitions = "topic.increase-partitions"
+	AuditTopicDeleteRecords      = "topic.delete-records"
+	AuditTopicQuarantine         = "topic.quarantine"
+	AuditTopicRelease            = "topic.release"
+	AuditMessageProduce          = "message.produce"
+	AuditTransactionAbort        = "transaction.abort"
+	AuditGroupResetOffsets       = "group.reset-offsets"
+	AuditACLCreate               = "acl.create"
+	AuditACLDelete               = "acl.delete"
+	AuditSCRAMUpsert             = "scram.upsert"
+	AuditSCRAMDelete             = "scram.delete"
+	AuditQuotaAlter              = "quota.alter"
+)
+
+// AuditActions lists every audited action.
+var AuditActions = []string{
+	AuditClusterAdd, AuditClusterUpdate, AuditClusterDelete,
+	AuditTopicCreate, AuditTopicDelete, AuditTopicUpdateConfig, AuditTopicIncreasePartitions,
+	AuditTopicDeleteRecords, AuditTopicQuarantine, AuditTopicRelease,
+	AuditMessageProduce, AuditTransactionAbort, AuditGroupResetOffsets,
+	AuditACLCreate, AuditACLDelete, AuditSCRAMUpsert, AuditSCRAMDelete, AuditQuotaAlter,
+}
+
+// Audit results. A denied change was refused by RBAC or read-only mode before reaching the cluster.
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+	AuditDenied  = "denied"
+)
+
+// RequestInfo describes the request a change was made in. OSUser names the operating system user running
+// a command, for changes not made on behalf of a signed-in user.
+type RequestInfo struct {
+	SourceIP  string
+	RequestID string
+	OSUser    string
+}
+
+// AuditEvent records one change: who made it, when and from where, what it was applied to and its outcome.
+// Before and After hold the changed values as JSON; either is empty when there is nothing to show.
+// Changes made by commands carry the operating system user with the "os" provider. An empty User stands
+// for Maned Scout itself, e.g. the quarantine sweeper.
+type AuditEvent struct {
+	Time         time.Time       `json:"time"`
+	User         string          `json:"user,omitempty"`
+	Provider     string          `json:"provider,omitempty"`
+	SourceIP     string          `json:"source_ip,omitempty"`
+	RequestID    string          `json:"request_id,omitempty"`
+	Cluster      string          `json:"cluster"`
+	ResourceType string          `json:"resource_type"`
+	ResourceName string          `json:"resource_name,omitempty"`
+	Action       string          `json:"action"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	After        json.RawMessage `json:"after,omitempty"`
+	Result       string          `json:"result"`
+	Error        string          `json:"error,omitempty"`
+}
+
+// Resource returns the resource the change was applied to.
+func (e AuditEvent) Resource() Resource {
+	return Resource{Kind: e.ResourceType, Name: e.ResourceName}
+}
+
+// AuditFilter selects audit events. Zero fields match everything; Resource matches a part of the
+// resource name, the other strings match exactly. Limit caps the number of events, newest first.
+type AuditFilter struct {
+	User     string
+	Cluster  string
+	Resource string
+	Action   string
+	Result   string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+// Matches reports whether the event is selected by the filter.
+func (f AuditFilter) Matches(e AuditEvent) bool {
+	switch {
+	case f.User != "" && e.User != f.User,
+		f.Cluster != "" && e.Cluster != f.Cluster,
+		f.Action != "" && e.Action != f.Action,
+		f.Result != "" && e.Result != f.Result,
+		f.Resource != "" && !strings.Contains(e.ResourceName, f.Resource),
+		!f.Since.IsZero() && e.Time.Before(f.Since),
+		!f.Until.IsZero() && !e.Time.Before(f.Until):
+		return false
+	}
+	return true
+}
+
+// AuditLog stores audit events. Events are only ever appended.
+type AuditLog interface {
+	Record(event AuditEvent) error
+	// Query returns the events matching the filter, newest first.
+	Query(filter AuditFilter) ([]AuditEvent, error)
+}
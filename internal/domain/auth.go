@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ProviderOIDC is the provider of users signed in through OpenID Connect.
+const ProviderOIDC = "oidc"
+
+// User represents a signed-in user. Provider names where the identity came from: local, htpasswd or oidc.
+// Groups are matched by RBAC role bindings. Scopes are set when the user authenticated with an API token
+// and limit the actions to those the token grants.
+type User struct {
+	Name     string   `json:"name" yaml:"name"`
+	Provider string   `json:"provider" yaml:"provider"`
+	Groups   []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Scopes   []Action `json:"scopes,omitempty" yaml:"-"`
+}
+
+// ViaToken reports whether the user authenticated with an API token rather than signing in.
+func (u User) ViaToken() bool {
+	return len(u.Scopes) > 0
+}
+
+// Session represents a browser session opened by a sign-in
+type Session struct {
+	ID        string    `json:"-"`
+	User      User      `json:"user"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// PasswordVerifier checks user credentials against a password source such as a users or htpasswd file.
+// Lookup returns the current identity of a user without checking a password.
+type PasswordVerifier interface {
+	Verify(username, password string) (User, bool)
+	Lookup(username string) (User, bool)
+}
+
+// IdentityProvider signs users in through an OpenID Connect authorization code flow.
+type IdentityProvider interface {
+	AuthCodeURL(state, nonce string) string
+	Exchange(ctx context.Context, code, nonce string) (User, error)
+}
+
+// API token kinds. A personal token acts as the user who created it, a service token as a principal
+// of its own named service:<token name>, which RBAC bindings grant roles to.
+const (
+	TokenPersonal = "personal"
+	TokenService  = "service"
+)
+
+// ServicePrincipalPrefix starts the user name service tokens act as.
+const ServicePrincipalPrefix = "service:"
+
+// APIToken is a bearer token for the API. Only the SHA-256 hash of its secret is stored.
+type APIToken struct {
+	ID         string     `json:"id" yaml:"id"`
+	Name       string     `json:"name" yaml:"name"`
+	Kind       string     `json:"kind" yaml:"kind"`
+	Owner      User       `json:"owner" yaml:"owner"`
+	Scopes     []Action   `json:"scopes" yaml:"scopes"`
+	Hash       string     `json:"-" yaml:"hash"`
+	CreatedAt  time.Time  `json:"created_at" yaml:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty" yaml:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" yaml:"last_used_at,omitempty"`
+}
+
+// Expired reports whether the token can no longer be used.
+func (t APIToken) Expired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+// Principal returns the user the token acts as, limited to the token scopes.
+func (t APIToken) Principal() User {
+	user := t.Owner
+	if t.Kind == TokenService {
+		user = User{Name: ServicePrincipalPrefix + t.Name, Provider: TokenService}
+	}
+	user.Scopes = t.Scopes
+	return user
+}
+
+// CreateTokenRequest asks for a new API token. ExpiresInDays of zero creates a token that does not expire.
+type CreateTokenRequest struct {
+	Name          string   `json:"name"`
+	Kind          string   `json:"kind"`
+	Scopes        []Action `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"`
+}
+
+// CreatedToken is a newly created token along with its secret, which is only ever shown once.
+type CreatedToken struct {
+	Token  APIToken `json:"token"`
+	Secret string   `json:"secret"`
+}
+
+// TokenStore persists API tokens.
+type TokenStore interface {
+	List() []APIToken
+	Save(token APIToken) error
+	Delete(id string) error
+}
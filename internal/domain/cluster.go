@@ -3,16 +3,21 @@
 // statistics, as well as abstractions for Kafka client operations and client factory creation.
 package domain
 
-import "github.com/OliveiraNt/maned-scout/internal/config"
+import (
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
 
 // Cluster represents a Kafka cluster with its metadata
 type Cluster struct {
-	ID       string                  `json:"id"`
-	Name     string                  `json:"name"`
-	Brokers  []string                `json:"brokers"`
-	IsOnline bool                    `json:"is_online"`
-	AuthType string                  `json:"auth_type"`
-	CertInfo *config.CertificateInfo `json:"cert_info,omitempty"`
+	ID        string                  `json:"id"`
+	Name      string                  `json:"name"`
+	Brokers   []string                `json:"brokers"`
+	IsOnline  bool                    `json:"is_online"`
+	AuthType  string                  `json:"auth_type"`
+	CertInfo  *config.CertificateInfo `json:"cert_info,omitempty"`
+	ReadOnly  bool                    `json:"read_only"`
+	Protected bool                    `json:"protected"`
 }
 
 // ClusterStats holds detailed statistics about a cluster
@@ -40,3 +45,76 @@ type ConsumerGroupSummary struct {
 	State   string `json:"state"`
 	Members int    `json:"members"`
 }
+
+// ClusterOverview gathers a cluster with its statistics and, when described in detail, its brokers and consumer groups.
+// Stats is nil while the cluster is offline.
+type ClusterOverview struct {
+	Cluster
+	Stats          *ClusterStats          `json:"stats,omitempty"`
+	BrokerDetails  []BrokerDetail         `json:"broker_details,omitempty"`
+	ConsumerGroups []ConsumerGroupSummary `json:"consumer_groups,omitempty"`
+}
+
+// ConsumerGroup holds a consumer group with its members and the lag of its committed offsets
+type ConsumerGroup struct {
+	GroupID     string                `json:"group_id"`
+	State       string                `json:"state"`
+	Protocol    string                `json:"protocol"`
+	Coordinator int32                 `json:"coordinator"`
+	Members     []ConsumerGroupMember `json:"members"`
+	TotalLag    int64                 `json:"total_lag"`
+	Lag         []PartitionLag        `json:"lag"`
+}
+
+// ConsumerGroupMember holds a member of a consumer group
+type ConsumerGroupMember struct {
+	MemberID   string `json:"member_id"`
+	InstanceID string `json:"instance_id,omitempty"`
+	ClientID   string `json:"client_id"`
+	ClientHost string `json:"client_host"`
+}
+
+// PartitionLag holds the committed offset and lag of a consumer group on a partition.
+// Lag is -1 when the commit or the end offset could not be read; MemberID is empty while the group is empty.
+type PartitionLag struct {
+	Topic           string `json:"topic"`
+	Partition       int32  `json:"partition"`
+	CommittedOffset int64  `json:"committed_offset"`
+	EndOffset       int64  `json:"end_offset"`
+	Lag             int64  `json:"lag"`
+	MemberID        string `json:"member_id,omitempty"`
+}
+
+// NewConsumerGroup builds a consumer group from its description and the lag of its committed offsets.
+func NewConsumerGroup(g kadm.DescribedGroupLag) ConsumerGroup {
+	group := ConsumerGroup{
+		GroupID:     g.Group,
+		State:       g.State,
+		Protocol:    g.Protocol,
+		Coordinator: g.Coordinator.NodeID,
+		Members:     make([]ConsumerGroupMember, 0, len(g.Members)),
+		TotalLag:    g.Lag.Total(),
+		Lag:         []PartitionLag{},
+	}
+	for _, m := range g.Members {
+		member := ConsumerGroupMember{MemberID: m.MemberID, ClientID: m.ClientID, ClientHost: m.ClientHost}
+		if m.InstanceID != nil {
+			member.InstanceID = *m.InstanceID
+		}
+		group.Members = append(group.Members, member)
+	}
+	for _, l := range g.Lag.Sorted() {
+		lag := PartitionLag{
+			Topic:           l.Topic,
+			Partition:       l.Partition,
+			CommittedOffset: l.Commit.At,
+			EndOffset:       l.End.Offset,
+			Lag:             l.Lag,
+		}
+		if l.Member != nil {
+			lag.MemberID = l.Member.MemberID
+		}
+		group.Lag = append(group.Lag, lag)
+	}
+	return group
+}
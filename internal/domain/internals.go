@@ -0,0 +1,76 @@
+package domain
+
+// MetadataVersionFeature is the feature that carries the KRaft metadata.version level.
+const MetadataVersionFeature = "metadata.version"
+
+// Cluster metadata modes.
+const (
+	ClusterModeKRaft     = "KRaft"
+	ClusterModeZooKeeper = "ZooKeeper"
+)
+
+// ClusterInternals holds low level information about how a cluster is run:
+// its metadata quorum, feature levels and the API versions each broker supports
+type ClusterInternals struct {
+	Mode                   string              `json:"mode"`
+	ZkMigrationReady       bool                `json:"zk_migration_ready"`
+	Quorum                 *MetadataQuorum     `json:"quorum,omitempty"`
+	QuorumError            string              `json:"quorum_error,omitempty"`
+	FinalizedFeaturesEpoch int64               `json:"finalized_features_epoch"`
+	Features               []Feature           `json:"features"`
+	Brokers                []BrokerAPIVersions `json:"brokers"`
+}
+
+// MetadataQuorum describes the KRaft quorum replicating the cluster metadata log
+type MetadataQuorum struct {
+	LeaderID      int32           `json:"leader_id"`
+	LeaderEpoch   int32           `json:"leader_epoch"`
+	HighWatermark int64           `json:"high_watermark"`
+	Voters        []QuorumReplica `json:"voters"`
+	Observers     []QuorumReplica `json:"observers"`
+}
+
+// QuorumReplica is a voter or observer of the metadata quorum. Lag is measured against the leader log end offset.
+type QuorumReplica struct {
+	ReplicaID             int32 `json:"replica_id"`
+	LogEndOffset          int64 `json:"log_end_offset"`
+	Lag                   int64 `json:"lag"`
+	LastFetchTimestamp    int64 `json:"last_fetch_timestamp"`
+	LastCaughtUpTimestamp int64 `json:"last_caught_up_timestamp"`
+}
+
+// Feature is a cluster feature with the version range every broker supports and its finalized level, if any
+type Feature struct {
+	Name         string `json:"name"`
+	SupportedMin int16  `json:"supported_min"`
+	SupportedMax int16  `json:"supported_max"`
+	Finalized    bool   `json:"finalized"`
+	FinalizedMin int16  `json:"finalized_min"`
+	FinalizedMax int16  `json:"finalized_max"`
+}
+
+// BrokerAPIVersions lists the request versions a broker supports
+type BrokerAPIVersions struct {
+	NodeID       int32        `json:"node_id"`
+	VersionGuess string       `json:"version_guess"`
+	APIs         []APIVersion `json:"apis"`
+	Error        string       `json:"error,omitempty"`
+}
+
+// APIVersion is the supported version range of a single request key
+type APIVersion struct {
+	Key  int16  `json:"key"`
+	Name string `json:"name"`
+	Min  int16  `json:"min"`
+	Max  int16  `json:"max"`
+}
+
+// MetadataVersion returns the finalized metadata.version level, if the feature is finalized.
+func (c ClusterInternals) MetadataVersion() (int16, bool) {
+	for _, f := range c.Features {
+		if f.Name == MetadataVersionFeature && f.Finalized {
+			return f.FinalizedMax, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Named offset positions.
+const (
+	OffsetEarliest = "earliest"
+	OffsetLatest   = "latest"
+)
+
+// OffsetSpec is a position in every partition of a topic: the earliest or latest offset, an exact
+// offset, or the first offset of a record produced at or after Time.
+type OffsetSpec struct {
+	Position string    `json:"position,omitempty"`
+	Offset   int64     `json:"offset,omitempty"`
+	Time     time.Time `json:"time,omitzero"`
+}
+
+// ParseOffsetSpec parses earliest, latest, an offset such as 42, or an RFC 3339 time.
+func ParseOffsetSpec(s string) (OffsetSpec, error) {
+	switch s = strings.TrimSpace(s); s {
+	case OffsetEarliest, OffsetLatest:
+		return OffsetSpec{Position: s}, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil && n >= 0 {
+		return OffsetSpec{Offset: n}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return OffsetSpec{Time: t}, nil
+	}
+	return OffsetSpec{}, fmt.Errorf("invalid offset %q: want earliest, latest, an offset or an RFC 3339 time", s)
+}
+
+func (o OffsetSpec) String() string {
+	switch {
+	case o.Position != "":
+		return o.Position
+	case !o.Time.IsZero():
+		return o.Time.Format(time.RFC3339)
+	}
+	return strconv.FormatInt(o.Offset, 10)
+}
+
+// ConsumeRequest selects where reading a topic starts. Partition -1 reads every partition.
+type ConsumeRequest struct {
+	From      OffsetSpec `json:"from"`
+	Partition int32      `json:"partition"`
+}
+
+// MessageFilter selects messages by key, content and age. Zero values match every message.
+type MessageFilter struct {
+	Key      string    `json:"key,omitempty"`
+	Contains string    `json:"contains,omitempty"`
+	Since    time.Time `json:"since,omitzero"`
+}
+
+// Match reports whether the message passes the filter. Contains matches the key or the value.
+func (f MessageFilter) Match(m Message) bool {
+	if f.Key != "" && string(m.Key) != f.Key {
+		return false
+	}
+	if f.Contains != "" && !bytes.Contains(m.Value, []byte(f.Contains)) && !bytes.Contains(m.Key, []byte(f.Contains)) {
+		return false
+	}
+	return f.Since.IsZero() || !m.Timestamp.Before(f.Since)
+}
+
+// ResetOffsetsRequest moves the committed offsets of a consumer group to To. An empty Topic resets
+// every topic the group has committed offsets for. DryRun computes the new offsets without committing them.
+type ResetOffsetsRequest struct {
+	Topic  string     `json:"topic,omitempty"`
+	To     OffsetSpec `json:"to"`
+	DryRun bool       `json:"dry_run,omitempty"`
+}
+
+// OffsetReset is the committed offset of a consumer group on a partition before and after a reset.
+// From is -1 when the group had not committed an offset for the partition.
+type OffsetReset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	From      int64  `json:"from"`
+	To        int64  `json:"to"`
+}
@@ -0,0 +1,42 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOffsetSpec(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for in, want := range map[string]domain.OffsetSpec{
+		"earliest":             {Position: domain.OffsetEarliest},
+		"latest":               {Position: domain.OffsetLatest},
+		"42":                   {Offset: 42},
+		"2026-01-02T03:04:05Z": {Time: at},
+	} {
+		got, err := domain.ParseOffsetSpec(in)
+		require.NoError(t, err, in)
+		require.Equal(t, want, got)
+		require.Equal(t, in, got.String())
+	}
+	for _, in := range []string{"", "-1", "yesterday"} {
+		_, err := domain.ParseOffsetSpec(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestMessageFilter_Match(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	msg := domain.Message{Key: []byte("order-1"), Value: []byte(`{"status":"paid"}`), Timestamp: now}
+
+	require.True(t, domain.MessageFilter{}.Match(msg))
+	require.True(t, domain.MessageFilter{Key: "order-1", Contains: "paid", Since: now}.Match(msg))
+	require.True(t, domain.MessageFilter{Contains: "order"}.Match(msg))
+	require.False(t, domain.MessageFilter{Key: "order"}.Match(msg))
+	require.False(t, domain.MessageFilter{Contains: "refunded"}.Match(msg))
+	require.False(t, domain.MessageFilter{Since: now.Add(time.Second)}.Match(msg))
+}
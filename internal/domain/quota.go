@@ -0,0 +1,41 @@
+package domain
+
+// Client quota entity types supported by the brokers.
+const (
+	QuotaEntityUser     = "user"
+	QuotaEntityClientID = "client-id"
+)
+
+// QuotaKeys lists the client quota configurations that can be set.
+var QuotaKeys = []string{"producer_byte_rate", "consumer_byte_rate", "request_percentage", "controller_mutation_rate"}
+
+// QuotaEntityComponent is a single component of a quota entity, such as user=alice.
+// Default selects the default entity of the type instead of a named one.
+type QuotaEntityComponent struct {
+	Type    string `json:"type"`
+	Name    string `json:"name,omitempty"`
+	Default bool   `json:"default,omitempty"`
+}
+
+// ClientQuota represents the quotas configured for an entity
+type ClientQuota struct {
+	Entity []QuotaEntityComponent `json:"entity"`
+	Values map[string]float64     `json:"values"`
+}
+
+// AlterClientQuotaRequest represents a request to set or remove quotas of an entity
+type AlterClientQuotaRequest struct {
+	Entity []QuotaEntityComponent `json:"entity"`
+	Set    map[string]float64     `json:"set,omitempty"`
+	Remove []string               `json:"remove,omitempty"`
+}
+
+// Component returns the entity component of the given type, if present.
+func (q ClientQuota) Component(entityType string) (QuotaEntityComponent, bool) {
+	for _, c := range q.Entity {
+		if c.Type == entityType {
+			return c, true
+		}
+	}
+	return QuotaEntityComponent{}, false
+}
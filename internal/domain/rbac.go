@@ -0,0 +1,49 @@
+package domain
+
+// Action is an operation that RBAC roles grant on clusters, topics and consumer groups.
+type Action string
+
+// Actions granted by roles. Higher actions include lower ones on the same resources:
+// cluster-admin includes every action, topic-admin includes view, produce and consume,
+// group-admin includes view, and produce and consume include view.
+const (
+	ActionView         Action = "view"
+	ActionProduce      Action = "produce"
+	ActionConsume      Action = "consume"
+	ActionTopicAdmin   Action = "topic-admin"
+	ActionGroupAdmin   Action = "group-admin"
+	ActionClusterAdmin Action = "cluster-admin"
+)
+
+// Actions lists every action a role can grant.
+var Actions = []Action{ActionView, ActionProduce, ActionConsume, ActionTopicAdmin, ActionGroupAdmin, ActionClusterAdmin}
+
+// Resource kinds an action applies to.
+const (
+	ResourceCluster = "cluster"
+	ResourceTopic   = "topic"
+	ResourceGroup   = "group"
+)
+
+// Resource identifies what an action is performed on inside a cluster.
+// An empty Name stands for any resource of the kind, e.g. to decide whether to offer topic creation at all.
+type Resource struct {
+	Kind string
+	Name string
+}
+
+// ClusterResource is the cluster itself, for cluster-wide views and changes.
+var ClusterResource = Resource{Kind: ResourceCluster}
+
+// AnyTopic stands for any topic of a cluster.
+var AnyTopic = Resource{Kind: ResourceTopic}
+
+// TopicResource returns the topic with the given name.
+func TopicResource(name string) Resource {
+	return Resource{Kind: ResourceTopic, Name: name}
+}
+
+// GroupResource returns the consumer group with the given name.
+func GroupResource(name string) Resource {
+	return Resource{Kind: ResourceGroup, Name: name}
+}
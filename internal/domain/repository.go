@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/twmb/franz-go/pkg/kadm"
@@ -13,6 +14,12 @@ type ClusterRepository interface {
 	Delete(name string) error
 	FindByName(name string) (config.ClusterConfig, bool)
 	FindAll() []config.ClusterConfig
+	IsReadOnly() bool
+	FindRBAC() *config.RBACConfig
+	FindTopicTemplates() []config.TopicTemplate
+	FindQuarantinedTopics() []config.QuarantinedTopic
+	SaveQuarantinedTopic(q config.QuarantinedTopic) error
+	DeleteQuarantinedTopic(cluster, topic string) error
 	Watch() error
 	GetClient(name string) (KafkaClient, bool)
 }
@@ -29,6 +36,7 @@ type KafkaClient interface {
 	GetClusterInfo() (*Cluster, error)
 	GetClusterStats() (*ClusterStats, error)
 	GetBrokerDetails() ([]BrokerDetail, error)
+	GetClusterInternals() (*ClusterInternals, error)
 	ListConsumerGroups() ([]ConsumerGroupSummary, error)
 	ListConsumerGroupsWithLagFromTopic(ctx context.Context, groupName []string, topicName string) (kadm.DescribedGroupLags, error)
 	GetTopicDetail(topicName string) (*TopicDetail, error)
@@ -36,7 +44,25 @@ type KafkaClient interface {
 	DeleteTopic(topicName string) error
 	UpdateTopicConfig(topicName string, req UpdateTopicConfigRequest) error
 	IncreasePartitions(topicName string, req IncreasePartitionsRequest) error
+	PreviewDeleteRecords(topicName string, req DeleteRecordsRequest) ([]DeleteRecordsResult, error)
+	DeleteRecords(topicName string, req DeleteRecordsRequest) ([]DeleteRecordsResult, error)
+	DescribeACLs(filter ACLFilter) ([]ACL, error)
+	CreateACL(acl ACL) error
+	DeleteACLs(filter ACLFilter) ([]ACL, error)
+	ListSCRAMUsers() ([]SCRAMUser, error)
+	UpsertSCRAMUser(req UpsertSCRAMUserRequest) error
+	DeleteSCRAMUser(name, mechanism string) error
+	DescribeClientQuotas() ([]ClientQuota, error)
+	AlterClientQuotas(req AlterClientQuotaRequest) error
+	ExportTopics() ([]DesiredTopic, error)
+	ListConsumerGroupOffsets() ([]ConsumerGroupOffsets, error)
+	ListTransactions() ([]Transaction, error)
+	DescribeProducers(topicName string) ([]ActiveProducer, error)
+	AbortTransaction(topicName string, req AbortTransactionRequest) error
+	CountRecordsSince(topicName string, since time.Time) (int64, error)
 	StreamMessages(ctx context.Context, topic string, out chan<- Message)
-	WriteMessage(ctx context.Context, topic string, msg Message)
+	ConsumeMessages(ctx context.Context, topic string, req ConsumeRequest, out chan<- Message) error
+	ResetConsumerGroupOffsets(ctx context.Context, group string, req ResetOffsetsRequest) ([]OffsetReset, error)
+	WriteMessage(ctx context.Context, topic string, msg Message) error
 	Close()
 }
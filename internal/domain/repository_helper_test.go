@@ -44,6 +44,6 @@ func TestKafkaClientInterface_MethodsReturnDefault(t *testing.T) {
 
 	ch := make(chan domain.Message, 1)
 	client.StreamMessages(context.Background(), "t", ch)
-	client.WriteMessage(context.Background(), "t", domain.Message{})
+	require.NoError(t, client.WriteMessage(context.Background(), "t", domain.Message{}))
 	client.Close()
 }
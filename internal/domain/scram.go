@@ -0,0 +1,31 @@
+package domain
+
+// SCRAMUser represents a user holding SCRAM credentials in the cluster
+type SCRAMUser struct {
+	Name        string            `json:"name"`
+	Credentials []SCRAMCredential `json:"credentials"`
+}
+
+// SCRAMCredential represents a single SCRAM credential of a user
+type SCRAMCredential struct {
+	Mechanism  string `json:"mechanism"`
+	Iterations int32  `json:"iterations"`
+}
+
+// UpsertSCRAMUserRequest represents a request to create or update a SCRAM credential.
+// Name may be left out where the user is named by the request path.
+type UpsertSCRAMUserRequest struct {
+	Name       string `json:"name,omitempty"`
+	Mechanism  string `json:"mechanism"`
+	Password   string `json:"password"`
+	Iterations int32  `json:"iterations"`
+}
+
+// SCRAMMechanisms lists the SCRAM mechanisms supported by the brokers.
+var SCRAMMechanisms = []string{"SCRAM-SHA-256", "SCRAM-SHA-512"}
+
+// SCRAM iteration bounds accepted by the brokers.
+const (
+	MinSCRAMIterations int32 = 4096
+	MaxSCRAMIterations int32 = 16384
+)
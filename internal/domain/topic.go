@@ -4,62 +4,111 @@ import "time"
 
 // Topic represents a Kafka topic with its metadata
 type Topic struct {
-	Name          string
-	Partitions    int
-	Replication   int
-	TotalMessages int64
-	Size          int64
-	RetentionMs   int64
-	CleanupPolicy string
+	Name          string `json:"name"`
+	Partitions    int    `json:"partitions"`
+	Replication   int    `json:"replication"`
+	TotalMessages int64  `json:"total_messages"`
+	Size          int64  `json:"size"`
+	RetentionMs   int64  `json:"retention_ms"`
+	CleanupPolicy string `json:"cleanup_policy"`
+}
+
+// TopicSummary represents a topic as listed on a cluster
+type TopicSummary struct {
+	Name       string `json:"name"`
+	Partitions int    `json:"partitions"`
 }
 
 // TopicDetail represents detailed topic information including all configurations
 type TopicDetail struct {
-	Name              string
-	Partitions        int
-	ReplicationFactor int
-	Configs           map[string]string
-	PartitionDetails  []PartitionDetail
+	Name              string             `json:"name"`
+	Partitions        int                `json:"partitions"`
+	ReplicationFactor int                `json:"replication_factor"`
+	Configs           map[string]string  `json:"configs"`
+	ConfigEntries     []TopicConfigEntry `json:"config_entries"`
+	PartitionDetails  []PartitionDetail  `json:"partition_details"`
+}
+
+// ConfigSourceTopic is the source of configs overridden on the topic itself.
+const ConfigSourceTopic = "DYNAMIC_TOPIC_CONFIG"
+
+// TopicConfigEntry is a topic config with the metadata needed to edit it.
+// Default is the value the config falls back to when its topic override is deleted.
+type TopicConfigEntry struct {
+	Name          string `json:"name"`
+	Value         string `json:"value"`
+	Source        string `json:"source"`
+	Default       string `json:"default"`
+	Type          string `json:"type"`
+	Documentation string `json:"documentation"`
+	Sensitive     bool   `json:"sensitive"`
+	ReadOnly      bool   `json:"read_only"`
+}
+
+// IsOverridden reports whether the config is set on the topic rather than inherited.
+func (e TopicConfigEntry) IsOverridden() bool {
+	return e.Source == ConfigSourceTopic
 }
 
 // PartitionDetail represents detailed partition information
 type PartitionDetail struct {
-	Partition int32
-	Leader    int32
-	Replicas  []int32
-	ISR       []int32
-	Offline   bool
+	Partition int32   `json:"partition"`
+	Leader    int32   `json:"leader"`
+	Replicas  []int32 `json:"replicas"`
+	ISR       []int32 `json:"isr"`
+	Offline   bool    `json:"offline"`
 }
 
 // CreateTopicRequest represents a request to create a new topic
+// When Template is set, the named topic template fills the partitions, replication factor and configs left unset.
 type CreateTopicRequest struct {
-	Name              string
-	NumPartitions     int32
-	ReplicationFactor int16
-	Configs           map[string]*string
+	Name              string             `json:"name"`
+	NumPartitions     int32              `json:"num_partitions"`
+	ReplicationFactor int16              `json:"replication_factor"`
+	Configs           map[string]*string `json:"configs,omitempty"`
+	Template          string             `json:"template,omitempty"`
 }
 
-// UpdateTopicConfigRequest represents a request to update topic configurations
+// UpdateTopicConfigRequest represents a request to update topic configurations.
+// A nil value deletes the topic override, resetting the config to its default.
 type UpdateTopicConfigRequest struct {
-	Configs map[string]*string
+	Configs map[string]*string `json:"configs"`
 }
 
 // IncreasePartitionsRequest represents a request to increase topic partitions
 type IncreasePartitionsRequest struct {
-	TotalPartitions int32
+	TotalPartitions int32 `json:"total_partitions"`
 }
 
 // Message represents a single message in a Kafka topic, containing key, value, partition, offset, and timestamp information.
 type Message struct {
-	Key       []byte
-	Value     []byte
-	Partition int32
-	Offset    int64
-	Timestamp time.Time
+	Key       []byte    `json:"key"`
+	Value     []byte    `json:"value"`
+	Partition int32     `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 // MessageRequest represents a request to produce a message to a Kafka topic
 type MessageRequest struct {
-	Key   string
-	Value string
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// DeleteRecordsRequest represents a request to delete records from a topic.
+// Exactly one selector is expected: Offsets deletes per partition up to (excluding) the given offset,
+// BeforeTimestamp deletes everything produced before the given unix millisecond, and All purges the whole topic.
+type DeleteRecordsRequest struct {
+	Offsets         map[int32]int64 `json:"offsets,omitempty"`
+	BeforeTimestamp int64           `json:"before_timestamp,omitempty"`
+	All             bool            `json:"all,omitempty"`
+}
+
+// DeleteRecordsResult represents the effect of a delete records request on a single partition
+type DeleteRecordsResult struct {
+	Partition    int32 `json:"partition"`
+	StartOffset  int64 `json:"start_offset"`
+	EndOffset    int64 `json:"end_offset"`
+	TargetOffset int64 `json:"target_offset"`
+	Records      int64 `json:"records"`
 }
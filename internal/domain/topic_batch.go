@@ -0,0 +1,29 @@
+package domain
+
+// BatchTopicRequest applies the same change to several topics of a cluster.
+// Action is one of TopicActionDelete, TopicActionUpdateConfig or TopicActionIncreasePartitions.
+// Confirmations repeats the names of the topics to delete even when their deletion checks fired.
+type BatchTopicRequest struct {
+	Action          TopicChangeAction  `json:"action"`
+	Topics          []string           `json:"topics"`
+	Configs         map[string]*string `json:"configs,omitempty"`
+	TotalPartitions int32              `json:"total_partitions,omitempty"`
+	Confirmations   []string           `json:"confirmations,omitempty"`
+}
+
+// BatchTopicResult is the outcome of a batch operation on a single topic.
+type BatchTopicResult struct {
+	Topic string `json:"topic"`
+	Error string `json:"error,omitempty"`
+}
+
+// BatchTopicFailures counts the failed topics of a batch operation.
+func BatchTopicFailures(results []BatchTopicResult) int {
+	n := 0
+	for _, r := range results {
+		if r.Error != "" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,45 @@
+package domain
+
+// TopicComparison is the drift between the topics of two clusters, or between two single topics.
+type TopicComparison struct {
+	SourceCluster string            `json:"source_cluster"`
+	TargetCluster string            `json:"target_cluster"`
+	OnlyInSource  []string          `json:"only_in_source,omitempty"`
+	OnlyInTarget  []string          `json:"only_in_target,omitempty"`
+	Differences   []TopicDifference `json:"differences,omitempty"`
+	Matching      int               `json:"matching"`
+}
+
+// InSync reports whether both sides have the same topics with the same settings.
+func (c TopicComparison) InSync() bool {
+	return len(c.OnlyInSource) == 0 && len(c.OnlyInTarget) == 0 && len(c.Differences) == 0
+}
+
+// TopicDifference holds the settings of a topic that differ between the source and the target.
+type TopicDifference struct {
+	SourceTopic             string             `json:"source_topic"`
+	TargetTopic             string             `json:"target_topic"`
+	SourcePartitions        int                `json:"source_partitions"`
+	TargetPartitions        int                `json:"target_partitions"`
+	SourceReplicationFactor int                `json:"source_replication_factor"`
+	TargetReplicationFactor int                `json:"target_replication_factor"`
+	Configs                 []ConfigDifference `json:"configs,omitempty"`
+}
+
+// PartitionsDiffer reports whether the partition counts differ.
+func (d TopicDifference) PartitionsDiffer() bool {
+	return d.SourcePartitions != d.TargetPartitions
+}
+
+// ReplicationDiffers reports whether the replication factors differ.
+func (d TopicDifference) ReplicationDiffers() bool {
+	return d.SourceReplicationFactor != d.TargetReplicationFactor
+}
+
+// ConfigDifference is a config key whose value differs between the source and the target.
+// An empty value means the key is not set on that side.
+type ConfigDifference struct {
+	Key    string `json:"key"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
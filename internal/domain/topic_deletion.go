@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// TopicDeletionCheck is the outcome of the safety checks run before a topic is deleted.
+// ConsumerGroups lists the active groups with committed offsets on the topic, RecentRecords
+// counts the records produced since ActivitySince and ACLs lists the literal ACLs naming the topic.
+type TopicDeletionCheck struct {
+	Topic          string                 `json:"topic"`
+	ConsumerGroups []ConsumerGroupSummary `json:"consumer_groups"`
+	RecentRecords  int64                  `json:"recent_records"`
+	ActivitySince  time.Time              `json:"activity_since"`
+	ACLs           []ACL                  `json:"acls"`
+	Quarantined    bool                   `json:"quarantined"`
+	DeleteAfter    *time.Time             `json:"delete_after,omitempty"`
+}
+
+// RequiresConfirmation reports whether any check fired, in which case the topic name must be typed to delete it
+func (c TopicDeletionCheck) RequiresConfirmation() bool {
+	return len(c.ConsumerGroups) > 0 || c.RecentRecords > 0 || len(c.ACLs) > 0
+}
+
+// DeleteTopicRequest represents a request to delete a topic.
+// Confirmation must repeat the topic name when the deletion checks fired.
+type DeleteTopicRequest struct {
+	Confirmation string `json:"confirmation"`
+}
+
+// QuarantineTopicRequest represents a request to block a topic for Days days before deleting it
+type QuarantineTopicRequest struct {
+	Days int `json:"days"`
+}
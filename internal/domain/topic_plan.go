@@ -0,0 +1,99 @@
+package domain
+
+// DesiredTopicState is the declarative description of the topics that should exist, per cluster
+type DesiredTopicState struct {
+	Clusters map[string]DesiredClusterTopics `yaml:"clusters" json:"clusters"`
+}
+
+// DesiredClusterTopics lists the topics declared for a single cluster.
+// Consumer group offsets and ACLs are informational sections filled by an export; plans ignore them.
+type DesiredClusterTopics struct {
+	Topics         []DesiredTopic         `yaml:"topics" json:"topics"`
+	ConsumerGroups []ConsumerGroupOffsets `yaml:"consumer_groups,omitempty" json:"consumer_groups,omitempty"`
+	ACLs           []ACL                  `yaml:"acls,omitempty" json:"acls,omitempty"`
+}
+
+// ConsumerGroupOffsets holds the committed offsets of a consumer group
+type ConsumerGroupOffsets struct {
+	Group   string            `yaml:"group" json:"group"`
+	Offsets []PartitionOffset `yaml:"offsets" json:"offsets"`
+}
+
+// PartitionOffset is an offset of a single topic partition
+type PartitionOffset struct {
+	Topic     string `yaml:"topic" json:"topic"`
+	Partition int32  `yaml:"partition" json:"partition"`
+	Offset    int64  `yaml:"offset" json:"offset"`
+}
+
+// DesiredTopic is a topic as declared in a desired state file
+type DesiredTopic struct {
+	Name              string            `yaml:"name" json:"name"`
+	Partitions        int32             `yaml:"partitions" json:"partitions"`
+	ReplicationFactor int16             `yaml:"replication_factor" json:"replication_factor"`
+	Configs           map[string]string `yaml:"configs,omitempty" json:"configs,omitempty"`
+}
+
+// TopicChangeAction is the kind of change a plan applies to a topic
+type TopicChangeAction string
+
+// Topic change actions, in the order a plan applies them.
+const (
+	TopicActionCreate             TopicChangeAction = "create"
+	TopicActionUpdateConfig       TopicChangeAction = "update_config"
+	TopicActionIncreasePartitions TopicChangeAction = "increase_partitions"
+	TopicActionDelete             TopicChangeAction = "delete"
+)
+
+// ConfigChange is a single configuration value that differs from the live state
+type ConfigChange struct {
+	Key string `json:"key"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// TopicChange is a single operation needed to converge a topic to its desired state
+type TopicChange struct {
+	Action            TopicChangeAction `json:"action"`
+	Topic             string            `json:"topic"`
+	FromPartitions    int32             `json:"from_partitions,omitempty"`
+	ToPartitions      int32             `json:"to_partitions,omitempty"`
+	ReplicationFactor int16             `json:"replication_factor,omitempty"`
+	Configs           []ConfigChange    `json:"configs,omitempty"`
+}
+
+// TopicPlan is the list of changes needed to converge a cluster to its desired topics.
+// Warnings report differences that cannot be applied, such as decreasing partitions.
+type TopicPlan struct {
+	Cluster  string        `json:"cluster"`
+	Changes  []TopicChange `json:"changes"`
+	Warnings []string      `json:"warnings,omitempty"`
+}
+
+// TopicChangeResult is the outcome of applying a single change
+type TopicChangeResult struct {
+	Change TopicChange `json:"change"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// TopicApplyResult is the outcome of applying a plan to a cluster
+type TopicApplyResult struct {
+	Cluster  string              `json:"cluster"`
+	Results  []TopicChangeResult `json:"results"`
+	Warnings []string            `json:"warnings,omitempty"`
+}
+
+// HasChanges reports whether applying the plan would change anything.
+func (p TopicPlan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+// Failed reports whether any change of the apply failed.
+func (r TopicApplyResult) Failed() bool {
+	for _, c := range r.Results {
+		if c.Error != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// DefaultTransactionMaxTimeout mirrors the broker default of transaction.max.timeout.ms.
+// A transaction open for longer than this is considered hanging.
+const DefaultTransactionMaxTimeout = 15 * time.Minute
+
+// TopicPartition identifies a single partition of a topic
+type TopicPartition struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+}
+
+// Transaction represents the state of a transactional ID as known by its coordinator
+type Transaction struct {
+	TransactionalID string           `json:"transactional_id"`
+	Coordinator     int32            `json:"coordinator"`
+	State           string           `json:"state"`
+	ProducerID      int64            `json:"producer_id"`
+	ProducerEpoch   int16            `json:"producer_epoch"`
+	TimeoutMs       int32            `json:"timeout_ms"`
+	StartTimestamp  int64            `json:"start_timestamp"`
+	Partitions      []TopicPartition `json:"partitions"`
+}
+
+// IsHanging reports whether an ongoing transaction has outlived its own timeout without being completed.
+func (t Transaction) IsHanging(now time.Time) bool {
+	if t.State != "Ongoing" || t.StartTimestamp <= 0 {
+		return false
+	}
+	return now.Sub(time.UnixMilli(t.StartTimestamp)) > time.Duration(t.TimeoutMs)*time.Millisecond
+}
+
+// ActiveProducer represents an idempotent or transactional producer writing to a partition
+type ActiveProducer struct {
+	Topic                 string `json:"topic"`
+	Partition             int32  `json:"partition"`
+	Leader                int32  `json:"leader"`
+	ProducerID            int64  `json:"producer_id"`
+	ProducerEpoch         int16  `json:"producer_epoch"`
+	LastSequence          int32  `json:"last_sequence"`
+	LastTimestamp         int64  `json:"last_timestamp"`
+	CoordinatorEpoch      int32  `json:"coordinator_epoch"`
+	CurrentTxnStartOffset int64  `json:"current_txn_start_offset"`
+}
+
+// InTransaction reports whether the producer has an open transaction on the partition.
+func (p ActiveProducer) InTransaction() bool {
+	return p.CurrentTxnStartOffset >= 0
+}
+
+// IsHanging reports whether the producer has kept a transaction open on the partition for longer than maxTimeout.
+func (p ActiveProducer) IsHanging(now time.Time, maxTimeout time.Duration) bool {
+	if !p.InTransaction() || p.LastTimestamp <= 0 {
+		return false
+	}
+	return now.Sub(time.UnixMilli(p.LastTimestamp)) > maxTimeout
+}
+
+// AbortTransactionRequest represents a request to abort the open transaction of a producer on a partition
+type AbortTransactionRequest struct {
+	Partition  int32 `json:"partition"`
+	ProducerID int64 `json:"producer_id"`
+}
@@ -0,0 +1,39 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveProducer_IsHanging(t *testing.T) {
+	t.Parallel()
+	now := time.UnixMilli(10_000_000)
+
+	idle := domain.ActiveProducer{CurrentTxnStartOffset: -1, LastTimestamp: 1}
+	require.False(t, idle.InTransaction())
+	require.False(t, idle.IsHanging(now, time.Minute))
+
+	recent := domain.ActiveProducer{CurrentTxnStartOffset: 5, LastTimestamp: now.Add(-30 * time.Second).UnixMilli()}
+	require.False(t, recent.IsHanging(now, time.Minute))
+
+	stale := domain.ActiveProducer{CurrentTxnStartOffset: 5, LastTimestamp: now.Add(-2 * time.Minute).UnixMilli()}
+	require.True(t, stale.IsHanging(now, time.Minute))
+}
+
+func TestTransaction_IsHanging(t *testing.T) {
+	t.Parallel()
+	now := time.UnixMilli(10_000_000)
+
+	txn := domain.Transaction{State: "Ongoing", TimeoutMs: 60_000, StartTimestamp: now.Add(-2 * time.Minute).UnixMilli()}
+	require.True(t, txn.IsHanging(now))
+
+	txn.StartTimestamp = now.Add(-30 * time.Second).UnixMilli()
+	require.False(t, txn.IsHanging(now))
+
+	txn.State = "CompleteCommit"
+	txn.StartTimestamp = now.Add(-2 * time.Minute).UnixMilli()
+	require.False(t, txn.IsHanging(now))
+}
@@ -0,0 +1,219 @@
+// Package audit stores the audit log of the changes made through Maned Scout.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+)
+
+const (
+	defaultMaxSizeMB = 100
+	defaultMaxFiles  = 10
+	// maxLineSize bounds a single event when reading the log back.
+	maxLineSize = 4 << 20
+)
+
+// FileLog appends audit events as JSON lines to a file. Once the file grows past its maximum size it
+// is renamed to <file>.1, the older files shift to <file>.2 and so on, and the oldest is dropped.
+type FileLog struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+// OpenFileLog opens the audit log for appending, creating it when missing.
+func OpenFileLog(cfg config.AuditConfig) (*FileLog, error) {
+	if cfg.File == "" {
+		return nil, errors.New("audit file is not set")
+	}
+	l := &FileLog{
+		path:     cfg.File,
+		maxSize:  int64(cfg.MaxSizeMB) << 20,
+		maxFiles: cfg.MaxFiles,
+	}
+	if l.maxSize <= 0 {
+		l.maxSize = defaultMaxSizeMB << 20
+	}
+	if l.maxFiles <= 0 {
+		l.maxFiles = defaultMaxFiles
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return nil, err
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *FileLog) open() error {
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	l.f = f
+	l.size = info.Size()
+	return nil
+}
+
+// Record appends the event, rotating the file first when the event would not fit.
+func (l *FileLog) Record(event domain.AuditEvent) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return os.ErrClosed
+	}
+	if l.size > 0 && l.size+int64(len(b)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			if l.f == nil {
+				return fmt.Errorf("rotate audit log: %w", err)
+			}
+			utils.Logger.Warn("audit log rotation failed, appending to the current file", "file", l.path, "err", err)
+		}
+	}
+	n, err := l.f.Write(b)
+	l.size += int64(n)
+	return err
+}
+
+// rotate renames the current file and opens a new one. The current file is opened again when the renames
+// fail, so the log keeps recording; l.f is only left nil when no file can be opened.
+func (l *FileLog) rotate() error {
+	err := l.f.Close()
+	l.f = nil
+	if err == nil {
+		err = l.shift()
+	}
+	if openErr := l.open(); openErr != nil {
+		return errors.Join(err, openErr)
+	}
+	return err
+}
+
+// shift renames the current file to <file>.1 and every rotated file to the next number.
+func (l *FileLog) shift() error {
+	for i := l.maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(l.rotated(i), l.rotated(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(l.path, l.rotated(1))
+}
+
+func (l *FileLog) rotated(i int) string {
+	return fmt.Sprintf("%s.%d", l.path, i)
+}
+
+// Query reads the current and the rotated files back and returns the matching events, newest first.
+// The files are read without holding the lock, so changes are not recorded behind a long query.
+func (l *FileLog) Query(filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	files, size, err := l.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer closeFiles(files)
+
+	var out []domain.AuditEvent
+	for i, f := range files {
+		var r io.Reader = f
+		if i == 0 {
+			r = io.LimitReader(f, size)
+		}
+		events, err := readEvents(r, filter)
+		if err != nil {
+			return nil, err
+		}
+		slices.Reverse(events)
+		out = append(out, events...)
+		if filter.Limit > 0 && len(out) >= filter.Limit {
+			return out[:filter.Limit], nil
+		}
+	}
+	return out, nil
+}
+
+// snapshot opens the current and the rotated files, newest first. An open file keeps its content when a
+// rotation renames it, and the current file is only read up to its size at the time of the snapshot, so
+// events recorded meanwhile are not read half written.
+func (l *FileLog) snapshot() (files []*os.File, size int64, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i := 0; i <= l.maxFiles; i++ {
+		path := l.path
+		if i > 0 {
+			path = l.rotated(i)
+		}
+		f, err := os.Open(path)
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		}
+		if err != nil {
+			closeFiles(files)
+			return nil, 0, err
+		}
+		files = append(files, f)
+	}
+	return files, l.size, nil
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		_ = f.Close()
+	}
+}
+
+func readEvents(r io.Reader, filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	var events []domain.AuditEvent
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64<<10), maxLineSize)
+	for sc.Scan() {
+		var e domain.AuditEvent
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			// A line cut short by a crash is skipped rather than hiding the rest of the log.
+			continue
+		}
+		if filter.Matches(e) {
+			events = append(events, e)
+		}
+	}
+	return events, sc.Err()
+}
+
+// Close closes the file.
+func (l *FileLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.f == nil {
+		return nil
+	}
+	err := l.f.Close()
+	l.f = nil
+	return err
+}
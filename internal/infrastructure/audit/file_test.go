@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileLog(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	l, err := OpenFileLog(config.AuditConfig{File: path})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	for i, topic := range []string{"orders", "payments", "orders"} {
+		require.NoError(t, l.Record(domain.AuditEvent{
+			Time:         start.Add(time.Duration(i) * time.Hour),
+			User:         "olga",
+			Cluster:      "prod",
+			ResourceType: domain.ResourceTopic,
+			ResourceName: topic,
+			Action:       domain.AuditTopicUpdateConfig,
+			After:        json.RawMessage(`{"retention.ms":"86400000"}`),
+			Result:       domain.AuditSuccess,
+		}))
+	}
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	events, err := l.Query(domain.AuditFilter{Resource: "orders"})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.True(t, events[0].Time.After(events[1].Time), "newest first")
+	require.JSONEq(t, `{"retention.ms":"86400000"}`, string(events[0].After))
+
+	events, err = l.Query(domain.AuditFilter{Since: start.Add(time.Hour), Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, start.Add(2*time.Hour), events[0].Time)
+	require.NoError(t, l.Close())
+
+	// reopening appends to the same file
+	l, err = OpenFileLog(config.AuditConfig{File: path})
+	require.NoError(t, err)
+	require.NoError(t, l.Record(domain.AuditEvent{Time: start.Add(3 * time.Hour), Action: domain.AuditTopicDelete, Result: domain.AuditFailure}))
+	events, err = l.Query(domain.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.NoError(t, l.Close())
+}
+
+func TestFileLog_Rotation(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := OpenFileLog(config.AuditConfig{File: path, MaxFiles: 2})
+	require.NoError(t, err)
+	l.maxSize = 300 // a couple of events per file
+	defer l.Close()
+
+	start := time.Date(2026, 5, 1, 8, 0, 0, 0, time.UTC)
+	for i := range 10 {
+		require.NoError(t, l.Record(domain.AuditEvent{
+			Time:         start.Add(time.Duration(i) * time.Minute),
+			Cluster:      "prod",
+			ResourceType: domain.ResourceTopic,
+			ResourceName: "orders",
+			Action:       domain.AuditMessageProduce,
+			Result:       domain.AuditSuccess,
+		}))
+	}
+
+	_, err = os.Stat(path + ".2")
+	require.NoError(t, err)
+	_, err = os.Stat(path + ".3")
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	events, err := l.Query(domain.AuditFilter{})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	require.Less(t, len(events), 10, "the oldest events were rotated out")
+	require.Equal(t, start.Add(9*time.Minute), events[0].Time)
+	for i := 1; i < len(events); i++ {
+		require.True(t, events[i-1].Time.After(events[i].Time))
+	}
+}
+
+func TestFileLog_FailedRotationKeepsRecording(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := OpenFileLog(config.AuditConfig{File: path, MaxFiles: 1})
+	require.NoError(t, err)
+	l.maxSize = 300
+	defer l.Close()
+	// a directory in the way of the rotated file makes the rename fail
+	require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "blocked"), 0700))
+
+	for i := range 5 {
+		require.NoError(t, l.Record(domain.AuditEvent{
+			Time:         time.Date(2026, 5, 1, 8, i, 0, 0, time.UTC),
+			Cluster:      "prod",
+			ResourceType: domain.ResourceTopic,
+			ResourceName: "orders",
+			Action:       domain.AuditMessageProduce,
+			Result:       domain.AuditSuccess,
+		}))
+	}
+	require.NoError(t, os.RemoveAll(path+".1"))
+	events, err := l.Query(domain.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+}
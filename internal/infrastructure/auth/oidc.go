@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+const (
+	defaultUsernameClaim = "preferred_username"
+	defaultGroupsClaim   = "groups"
+)
+
+// OIDCProvider signs users in with an OpenID Connect provider using the authorization code flow.
+type OIDCProvider struct {
+	oauth2        oauth2.Config
+	verifier      *oidc.IDTokenVerifier
+	usernameClaim string
+	groupsClaim   string
+}
+
+// NewOIDCProvider discovers the provider endpoints from the issuer and returns a provider for the client.
+func NewOIDCProvider(ctx context.Context, cfg config.OIDCConfig) (*OIDCProvider, error) {
+	if cfg.Issuer == "" || cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, errors.New("oidc: issuer, client_id and redirect_url are required")
+	}
+	provider, err := oidc.NewProvider(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+
+	secret := cfg.ClientSecret
+	if cfg.ClientSecretEnv != "" {
+		secret = os.Getenv(cfg.ClientSecretEnv)
+	}
+	scopes := cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"profile", "email"}
+	}
+	usernameClaim := cfg.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+	groupsClaim := cfg.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultGroupsClaim
+	}
+
+	return &OIDCProvider{
+		oauth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: secret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       append([]string{oidc.ScopeOpenID}, scopes...),
+		},
+		verifier:      provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}),
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+	}, nil
+}
+
+// AuthCodeURL returns the provider URL the browser is sent to for signing in.
+func (p *OIDCProvider) AuthCodeURL(state, nonce string) string {
+	return p.oauth2.AuthCodeURL(state, oidc.Nonce(nonce))
+}
+
+// Exchange redeems the authorization code, verifies the ID token and its nonce, and returns the user it names
+// along with the groups listed in the groups claim.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, nonce string) (domain.User, error) {
+	token, err := p.oauth2.Exchange(ctx, code)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("oidc code exchange: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return domain.User{}, errors.New("oidc: token response has no id_token")
+	}
+	idToken, err := p.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("oidc: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return domain.User{}, errors.New("oidc: id_token nonce does not match")
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return domain.User{}, fmt.Errorf("oidc claims: %w", err)
+	}
+	name, _ := claims[p.usernameClaim].(string)
+	if name == "" {
+		name = idToken.Subject
+	}
+	user := domain.User{Name: name, Provider: ProviderOIDC}
+	if groups, ok := claims[p.groupsClaim].([]any); ok {
+		for _, g := range groups {
+			if group, ok := g.(string); ok {
+				user.Groups = append(user.Groups, group)
+			}
+		}
+	}
+	return user, nil
+}
@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockIdP is a minimal OpenID Connect provider: discovery, keys and a token endpoint that
+// answers the code "valid" with an ID token for the user and the nonce of the last authorization.
+type mockIdP struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]any
+	nonce  string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp := &mockIdP{key: key, claims: map[string]any{"sub": "42", "preferred_username": "alice", "groups": []string{"kafka-admins"}}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                idp.URL,
+			"authorization_endpoint":                idp.URL + "/authorize",
+			"token_endpoint":                        idp.URL + "/token",
+			"jwks_uri":                              idp.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "valid" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idp.idToken(t),
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (m *mockIdP) idToken(t *testing.T) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: m.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"),
+	)
+	require.NoError(t, err)
+	now := time.Now()
+	claims := map[string]any{
+		"iss":   m.URL,
+		"aud":   "maned-scout",
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": m.nonce,
+	}
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	raw, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return raw
+}
+
+// authorize follows the provider URL as a browser would, remembering the nonce for the token.
+func (m *mockIdP) authorize(t *testing.T, authURL string) url.Values {
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	m.nonce = q.Get("nonce")
+	return q
+}
+
+func TestOIDCProvider(t *testing.T) {
+	t.Parallel()
+	idp := newMockIdP(t)
+	ctx := context.Background()
+
+	_, err := NewOIDCProvider(ctx, config.OIDCConfig{Issuer: idp.URL})
+	require.Error(t, err)
+
+	provider, err := NewOIDCProvider(ctx, config.OIDCConfig{
+		Issuer:       idp.URL,
+		ClientID:     "maned-scout",
+		ClientSecret: "secret",
+		RedirectURL:  "http://localhost:8080/auth/oidc/callback",
+	})
+	require.NoError(t, err)
+
+	q := idp.authorize(t, provider.AuthCodeURL("state-1", "nonce-1"))
+	require.Equal(t, "state-1", q.Get("state"))
+	require.Equal(t, "maned-scout", q.Get("client_id"))
+	require.Equal(t, "openid profile email", q.Get("scope"))
+
+	user, err := provider.Exchange(ctx, "valid", "nonce-1")
+	require.NoError(t, err)
+	require.Equal(t, "alice", user.Name)
+	require.Equal(t, ProviderOIDC, user.Provider)
+	require.Equal(t, []string{"kafka-admins"}, user.Groups)
+
+	// the ID token must answer this sign-in
+	_, err = provider.Exchange(ctx, "valid", "other-nonce")
+	require.Error(t, err)
+
+	_, err = provider.Exchange(ctx, "expired", "nonce-1")
+	require.Error(t, err)
+
+	// without the username claim the subject is used
+	delete(idp.claims, "preferred_username")
+	user, err = provider.Exchange(ctx, "valid", "nonce-1")
+	require.NoError(t, err)
+	require.Equal(t, "42", user.Name)
+}
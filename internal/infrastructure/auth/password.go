@@ -0,0 +1,197 @@
+// Package auth provides the user sources used to sign in to Maned Scout: local users and htpasswd
+// files holding bcrypt hashes, and OpenID Connect providers, as well as the API tokens store.
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
+)
+
+// Provider names reported in domain.User.
+const (
+	ProviderLocal    = "local"
+	ProviderHtpasswd = "htpasswd"
+	ProviderOIDC     = domain.ProviderOIDC
+)
+
+// dummyHash is compared against when the user does not exist, so unknown users take as long as wrong passwords.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("maned-scout"), bcrypt.DefaultCost)
+	return hash
+})
+
+// PasswordFile verifies credentials against bcrypt hashes loaded from a file.
+type PasswordFile struct {
+	provider string
+	hashes   map[string][]byte
+	groups   map[string][]string
+}
+
+// LoadUsersFile loads the local users file, a YAML list of usernames, bcrypt password hashes and groups.
+func LoadUsersFile(path string) (*PasswordFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f config.UsersFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("parse users file %s: %w", path, err)
+	}
+
+	pf := &PasswordFile{
+		provider: ProviderLocal,
+		hashes:   make(map[string][]byte, len(f.Users)),
+		groups:   make(map[string][]string, len(f.Users)),
+	}
+	for _, u := range f.Users {
+		if u.Username == "" {
+			return nil, fmt.Errorf("users file %s: user without a username", path)
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("users file %s: user %s: password_hash is not a bcrypt hash", path, u.Username)
+		}
+		pf.hashes[u.Username] = []byte(u.PasswordHash)
+		pf.groups[u.Username] = u.Groups
+	}
+	return pf, nil
+}
+
+// LoadHtpasswd imports the users of an htpasswd file. Only bcrypt entries, as written by htpasswd -B,
+// are supported; entries using other schemes are skipped with a warning.
+func LoadHtpasswd(path string) (*PasswordFile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pf := &PasswordFile{provider: ProviderHtpasswd, hashes: make(map[string][]byte)}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		username, hash, ok := strings.Cut(entry, ":")
+		if !ok || username == "" {
+			return nil, fmt.Errorf("htpasswd file %s: line %d is not user:hash", path, line)
+		}
+		if _, err := bcrypt.Cost([]byte(hash)); err != nil {
+			utils.Logger.Warn("skipping htpasswd entry without a bcrypt hash", "file", path, "user", username)
+			continue
+		}
+		pf.hashes[username] = []byte(hash)
+	}
+	return pf, scanner.Err()
+}
+
+// Verify reports whether the password matches the user's hash.
+func (f *PasswordFile) Verify(username, password string) (domain.User, bool) {
+	hash, ok := f.hashes[username]
+	if !ok {
+		_ = bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+		return domain.User{}, false
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(password)) != nil {
+		return domain.User{}, false
+	}
+	return domain.User{Name: username, Provider: f.provider, Groups: f.groups[username]}, true
+}
+
+// Lookup returns the user with its current groups, if the file holds it.
+func (f *PasswordFile) Lookup(username string) (domain.User, bool) {
+	if _, ok := f.hashes[username]; !ok {
+		return domain.User{}, false
+	}
+	return domain.User{Name: username, Provider: f.provider, Groups: f.groups[username]}, true
+}
+
+// Len returns the number of users loaded.
+func (f *PasswordFile) Len() int {
+	return len(f.hashes)
+}
+
+// WatchedPasswordFile is a PasswordFile loaded again once its file changes, so users added to or removed
+// from the file take effect without a restart, including for the sessions and API tokens they hold.
+// A change that fails to load keeps the previous users until the file is fixed.
+type WatchedPasswordFile struct {
+	path string
+	load func(string) (*PasswordFile, error)
+
+	mu   sync.Mutex
+	file *PasswordFile
+	mod  time.Time
+}
+
+// WatchUsersFile loads the local users file like LoadUsersFile and reloads it when it changes.
+func WatchUsersFile(path string) (*WatchedPasswordFile, error) {
+	return watchPasswordFile(path, LoadUsersFile)
+}
+
+// WatchHtpasswd loads the htpasswd file like LoadHtpasswd and reloads it when it changes.
+func WatchHtpasswd(path string) (*WatchedPasswordFile, error) {
+	return watchPasswordFile(path, LoadHtpasswd)
+}
+
+func watchPasswordFile(path string, load func(string) (*PasswordFile, error)) (*WatchedPasswordFile, error) {
+	w := &WatchedPasswordFile{path: path, load: load}
+	if err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// reload loads the file when it changed since it was last loaded. It is called with mu held,
+// or before the file is shared.
+func (w *WatchedPasswordFile) reload() error {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+	if w.file != nil && info.ModTime().Equal(w.mod) {
+		return nil
+	}
+	file, err := w.load(w.path)
+	if err != nil {
+		return err
+	}
+	if w.file != nil {
+		utils.Logger.Info("password file reloaded", "file", w.path, "users", file.Len())
+	}
+	w.file, w.mod = file, info.ModTime()
+	return nil
+}
+
+func (w *WatchedPasswordFile) current() *PasswordFile {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if err := w.reload(); err != nil {
+		utils.Logger.Warn("password file reload failed, keeping the previous users", "file", w.path, "err", err)
+	}
+	return w.file
+}
+
+// Verify reports whether the password matches the user's hash in the current file.
+func (w *WatchedPasswordFile) Verify(username, password string) (domain.User, bool) {
+	return w.current().Verify(username, password)
+}
+
+// Lookup returns the user with its current groups, if the current file holds it.
+func (w *WatchedPasswordFile) Lookup(username string) (domain.User, bool) {
+	return w.current().Lookup(username)
+}
+
+// Len returns the number of users in the current file.
+func (w *WatchedPasswordFile) Len() int {
+	return w.current().Len()
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/utils"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func hash(t *testing.T, password string) string {
+	t.Helper()
+	b, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestLoadUsersFile(t *testing.T) {
+	t.Parallel()
+	path := writeFile(t, "users.yml", "users:\n  - username: alice\n    password_hash: "+hash(t, "s3cret")+"\n    groups: [orders-team]\n")
+
+	users, err := LoadUsersFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 1, users.Len())
+
+	user, ok := users.Verify("alice", "s3cret")
+	require.True(t, ok)
+	require.Equal(t, "alice", user.Name)
+	require.Equal(t, ProviderLocal, user.Provider)
+	require.Equal(t, []string{"orders-team"}, user.Groups)
+
+	_, ok = users.Verify("alice", "wrong")
+	require.False(t, ok)
+	_, ok = users.Verify("bob", "s3cret")
+	require.False(t, ok)
+
+	user, ok = users.Lookup("alice")
+	require.True(t, ok)
+	require.Equal(t, []string{"orders-team"}, user.Groups)
+	_, ok = users.Lookup("bob")
+	require.False(t, ok)
+
+	// plain text passwords are refused
+	_, err = LoadUsersFile(writeFile(t, "users.yml", "users:\n  - username: alice\n    password_hash: s3cret\n"))
+	require.Error(t, err)
+}
+
+func TestLoadHtpasswd(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	content := "# managed by htpasswd -B\n" +
+		"alice:" + hash(t, "s3cret") + "\n" +
+		"\n" +
+		"legacy:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"
+	users, err := LoadHtpasswd(writeFile(t, ".htpasswd", content))
+	require.NoError(t, err)
+	require.Equal(t, 1, users.Len())
+
+	user, ok := users.Verify("alice", "s3cret")
+	require.True(t, ok)
+	require.Equal(t, ProviderHtpasswd, user.Provider)
+
+	_, ok = users.Verify("legacy", "password")
+	require.False(t, ok)
+
+	_, err = LoadHtpasswd(writeFile(t, ".htpasswd", "not an entry\n"))
+	require.Error(t, err)
+}
+
+func TestWatchedPasswordFile_ReloadsChangedFile(t *testing.T) {
+	t.Parallel()
+	utils.InitLogger()
+	path := writeFile(t, "users.yml", "users:\n  - username: alice\n    password_hash: "+hash(t, "s3cret")+"\n")
+	users, err := WatchUsersFile(path)
+	require.NoError(t, err)
+	_, ok := users.Lookup("alice")
+	require.True(t, ok)
+
+	// the file is read again once its modification time moves
+	rewrite := func(content string, mod time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		require.NoError(t, os.Chtimes(path, mod, mod))
+	}
+	later := time.Now().Add(time.Minute)
+	rewrite("users:\n  - username: bob\n    password_hash: "+hash(t, "hunter2")+"\n", later)
+	_, ok = users.Lookup("alice")
+	require.False(t, ok)
+	user, ok := users.Verify("bob", "hunter2")
+	require.True(t, ok)
+	require.Equal(t, ProviderLocal, user.Provider)
+
+	// a broken change keeps the previous users
+	rewrite("users:\n  - username: bob\n    password_hash: hunter2\n", later.Add(time.Minute))
+	_, ok = users.Verify("bob", "hunter2")
+	require.True(t, ok)
+	require.Equal(t, 1, users.Len())
+
+	_, err = WatchHtpasswd(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
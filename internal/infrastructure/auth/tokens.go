@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// tokensFile is the on-disk layout of the API tokens file.
+type tokensFile struct {
+	Tokens []domain.APIToken `yaml:"tokens"`
+}
+
+// TokenFile stores API tokens in a YAML file. Only token hashes are written; the file is
+// created on the first token and kept readable by the owner only.
+type TokenFile struct {
+	path string
+
+	mu     sync.RWMutex
+	tokens []domain.APIToken
+}
+
+// LoadTokenFile loads the API tokens file. A missing file holds no tokens.
+func LoadTokenFile(path string) (*TokenFile, error) {
+	tf := &TokenFile{path: path}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tf, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f tokensFile
+	if err := yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("parse tokens file %s: %w", path, err)
+	}
+	tf.tokens = f.Tokens
+	return tf, nil
+}
+
+// List returns every stored token.
+func (f *TokenFile) List() []domain.APIToken {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return slices.Clone(f.tokens)
+}
+
+// Save adds the token, or replaces the stored token with the same ID, and writes the file.
+func (f *TokenFile) Save(token domain.APIToken) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens := slices.Clone(f.tokens)
+	if i := slices.IndexFunc(tokens, func(t domain.APIToken) bool { return t.ID == token.ID }); i >= 0 {
+		tokens[i] = token
+	} else {
+		tokens = append(tokens, token)
+	}
+	return f.write(tokens)
+}
+
+// Delete removes the token with the given ID and writes the file.
+func (f *TokenFile) Delete(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tokens := slices.DeleteFunc(slices.Clone(f.tokens), func(t domain.APIToken) bool { return t.ID == id })
+	return f.write(tokens)
+}
+
+// write replaces the file through a temporary file, so a crash never leaves it half written.
+func (f *TokenFile) write(tokens []domain.APIToken) error {
+	b, err := yaml.Marshal(tokensFile{Tokens: tokens})
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(f.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tokens-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), f.path); err != nil {
+		return err
+	}
+	f.tokens = tokens
+	return nil
+}
+
+// Len returns the number of stored tokens.
+func (f *TokenFile) Len() int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.tokens)
+}
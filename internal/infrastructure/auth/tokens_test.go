@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "state", "tokens.yml")
+
+	tokens, err := LoadTokenFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 0, tokens.Len())
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	token := domain.APIToken{
+		ID:        "t1",
+		Name:      "ci",
+		Kind:      domain.TokenPersonal,
+		Owner:     domain.User{Name: "alice", Provider: ProviderLocal, Groups: []string{"orders-team"}},
+		Scopes:    []domain.Action{domain.ActionView},
+		Hash:      "abc",
+		CreatedAt: created,
+	}
+	require.NoError(t, tokens.Save(token))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// a second token, then an update of the first
+	require.NoError(t, tokens.Save(domain.APIToken{ID: "t2", Name: "deploy", Kind: domain.TokenService, Hash: "def"}))
+	used := created.Add(time.Hour)
+	token.LastUsedAt = &used
+	require.NoError(t, tokens.Save(token))
+
+	reloaded, err := LoadTokenFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, reloaded.Len())
+	got := reloaded.List()[0]
+	require.Equal(t, "abc", got.Hash)
+	require.Equal(t, []string{"orders-team"}, got.Owner.Groups)
+	require.True(t, got.LastUsedAt.Equal(used))
+
+	require.NoError(t, reloaded.Delete("t1"))
+	require.Len(t, reloaded.List(), 1)
+	require.Equal(t, "t2", reloaded.List()[0].ID)
+
+	_, err = LoadTokenFile(writeFile(t, "tokens.yml", "tokens: [oops"))
+	require.Error(t, err)
+}
@@ -0,0 +1,244 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// DescribeACLs returns the ACLs matching the given filter
+func (a *Admin) DescribeACLs(ctx context.Context, filter domain.ACLFilter) ([]domain.ACL, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	b, err := buildACLFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := a.client.DescribeACLs(cctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []domain.ACL
+	for _, r := range results {
+		if errors.Is(r.Err, kerr.SecurityDisabled) {
+			return nil, fmt.Errorf("%w: %w", domain.ErrSecurityDisabled, r.Err)
+		}
+		if r.Err != nil {
+			return nil, fmt.Errorf("%w: %s", r.Err, r.ErrMessage)
+		}
+		for _, d := range r.Described {
+			out = append(out, toDomainACL(d.Principal, d.Host, d.Type, d.Name, d.Pattern, d.Operation, d.Permission))
+		}
+	}
+	sortACLs(out)
+	return out, nil
+}
+
+// CreateACL creates a single ACL entry
+func (a *Admin) CreateACL(ctx context.Context, acl domain.ACL) error {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	op, err := kmsg.ParseACLOperation(acl.Operation)
+	if err != nil {
+		return err
+	}
+	pattern := kadm.ACLPatternLiteral
+	if acl.PatternType != "" {
+		if pattern, err = kmsg.ParseACLResourcePatternType(acl.PatternType); err != nil {
+			return err
+		}
+	}
+	host := acl.Host
+	if host == "" {
+		host = "*"
+	}
+
+	b := kadm.NewACLs().Operations(op).ResourcePatternType(pattern)
+	if strings.EqualFold(acl.Permission, "DENY") {
+		b.Deny(acl.Principal).DenyHosts(host)
+	} else {
+		b.Allow(acl.Principal).AllowHosts(host)
+	}
+	if err := setACLResource(b, acl.ResourceType, acl.ResourceName); err != nil {
+		return err
+	}
+	if err := b.ValidateCreate(); err != nil {
+		return err
+	}
+
+	results, err := a.client.CreateACLs(cctx, b)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		if errors.Is(r.Err, kerr.SecurityDisabled) {
+			return fmt.Errorf("%w: %w", domain.ErrSecurityDisabled, r.Err)
+		}
+		if r.Err != nil {
+			return fmt.Errorf("%w: %s", r.Err, r.ErrMessage)
+		}
+	}
+	return nil
+}
+
+// DeleteACLs deletes every ACL matching the given filter and returns the deleted entries
+func (a *Admin) DeleteACLs(ctx context.Context, filter domain.ACLFilter) ([]domain.ACL, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	b, err := buildACLFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := a.client.DeleteACLs(cctx, b)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []domain.ACL
+	for _, r := range results {
+		if errors.Is(r.Err, kerr.SecurityDisabled) {
+			return nil, fmt.Errorf("%w: %w", domain.ErrSecurityDisabled, r.Err)
+		}
+		if r.Err != nil {
+			return nil, fmt.Errorf("%w: %s", r.Err, r.ErrMessage)
+		}
+		for _, d := range r.Deleted {
+			if d.Err != nil {
+				return out, fmt.Errorf("%w: %s", d.Err, d.ErrMessage)
+			}
+			out = append(out, toDomainACL(d.Principal, d.Host, d.Type, d.Name, d.Pattern, d.Operation, d.Permission))
+		}
+	}
+	sortACLs(out)
+	return out, nil
+}
+
+// buildACLFilter converts a domain filter into a kadm builder suitable for describing or deleting
+func buildACLFilter(filter domain.ACLFilter) (*kadm.ACLBuilder, error) {
+	b := kadm.NewACLs()
+
+	var principals, hosts []string
+	if filter.Principal != "" {
+		principals = []string{filter.Principal}
+	}
+	if filter.Host != "" {
+		hosts = []string{filter.Host}
+	}
+	switch strings.ToUpper(filter.Permission) {
+	case "":
+		b.Allow(principals...).AllowHosts(hosts...).Deny(principals...).DenyHosts(hosts...)
+	case "ALLOW":
+		b.Allow(principals...).AllowHosts(hosts...)
+	case "DENY":
+		b.Deny(principals...).DenyHosts(hosts...)
+	default:
+		return nil, fmt.Errorf("invalid acl permission %q", filter.Permission)
+	}
+
+	if filter.Operation == "" {
+		b.Operations()
+	} else {
+		op, err := kmsg.ParseACLOperation(filter.Operation)
+		if err != nil {
+			return nil, err
+		}
+		b.Operations(op)
+	}
+
+	pattern := kadm.ACLPatternAny
+	if filter.PatternType != "" {
+		var err error
+		if pattern, err = kmsg.ParseACLResourcePatternType(filter.PatternType); err != nil {
+			return nil, err
+		}
+	}
+	b.ResourcePatternType(pattern)
+
+	if filter.ResourceType == "" || strings.EqualFold(filter.ResourceType, "ANY") {
+		if filter.ResourceName != "" {
+			b.AnyResource(filter.ResourceName)
+		} else {
+			b.AnyResource()
+		}
+	} else if err := setACLResource(b, filter.ResourceType, filter.ResourceName); err != nil {
+		return nil, err
+	}
+
+	if err := b.ValidateFilter(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// setACLResource sets the resource a builder targets. An empty name matches every resource of the type when filtering.
+func setACLResource(b *kadm.ACLBuilder, resourceType, name string) error {
+	var names []string
+	if name != "" {
+		names = []string{name}
+	}
+
+	rt, err := kmsg.ParseACLResourceType(resourceType)
+	if err != nil {
+		return err
+	}
+	switch rt {
+	case kmsg.ACLResourceTypeTopic:
+		b.Topics(names...)
+	case kmsg.ACLResourceTypeGroup:
+		b.Groups(names...)
+	case kmsg.ACLResourceTypeCluster:
+		b.Clusters()
+	case kmsg.ACLResourceTypeTransactionalId:
+		b.TransactionalIDs(names...)
+	case kmsg.ACLResourceTypeDelegationToken:
+		b.DelegationTokens(names...)
+	default:
+		return fmt.Errorf("unsupported acl resource type %q", resourceType)
+	}
+	return nil
+}
+
+func toDomainACL(principal, host string, rt kmsg.ACLResourceType, name string, pattern kadm.ACLPattern, op kadm.ACLOperation, perm kmsg.ACLPermissionType) domain.ACL {
+	return domain.ACL{
+		Principal:    principal,
+		Host:         host,
+		ResourceType: rt.String(),
+		ResourceName: name,
+		PatternType:  pattern.String(),
+		Operation:    op.String(),
+		Permission:   perm.String(),
+	}
+}
+
+func sortACLs(acls []domain.ACL) {
+	sort.Slice(acls, func(i, j int) bool {
+		a, b := acls[i], acls[j]
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		if a.ResourceName != b.ResourceName {
+			return a.ResourceName < b.ResourceName
+		}
+		if a.Principal != b.Principal {
+			return a.Principal < b.Principal
+		}
+		if a.Operation != b.Operation {
+			return a.Operation < b.Operation
+		}
+		return a.Permission < b.Permission
+	})
+}
@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+func TestBuildACLFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  domain.ACLFilter
+		wantErr bool
+	}{
+		{name: "empty filter matches everything", filter: domain.ACLFilter{}},
+		{name: "topic match", filter: domain.ACLFilter{ResourceType: "TOPIC", ResourceName: "orders", PatternType: "MATCH"}},
+		{name: "principal and permission", filter: domain.ACLFilter{Principal: "User:alice", Permission: "DENY", Operation: "WRITE"}},
+		{name: "cluster", filter: domain.ACLFilter{ResourceType: "CLUSTER"}},
+		{name: "invalid permission", filter: domain.ACLFilter{Permission: "MAYBE"}, wantErr: true},
+		{name: "invalid operation", filter: domain.ACLFilter{Operation: "FLY"}, wantErr: true},
+		{name: "unsupported resource type", filter: domain.ACLFilter{ResourceType: "USER"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := buildACLFilter(tt.filter)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !b.HasResource() || !b.HasPrincipals() || !b.HasHosts() {
+				t.Error("expected builder to have resource, principals and hosts")
+			}
+		})
+	}
+}
+
+func TestToDomainACL(t *testing.T) {
+	acl := toDomainACL("User:alice", "*", 2, "orders", kadm.ACLPatternPrefixed, kadm.OpRead, 3)
+	want := domain.ACL{
+		Principal:    "User:alice",
+		Host:         "*",
+		ResourceType: "TOPIC",
+		ResourceName: "orders",
+		PatternType:  "PREFIXED",
+		Operation:    "READ",
+		Permission:   "ALLOW",
+	}
+	if acl != want {
+		t.Errorf("got %+v, want %+v", acl, want)
+	}
+}
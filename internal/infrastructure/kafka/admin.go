@@ -5,21 +5,27 @@ package kafka
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
 	"github.com/OliveiraNt/maned-scout/internal/domain"
 	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
 )
 
 // Admin provides methods for managing and retrieving Kafka cluster information through the kadm client.
 type Admin struct {
 	client *kadm.Client
+	raw    kmsg.Requestor
 }
 
-// NewAdmin creates a new Admin
-func NewAdmin(client *kadm.Client) *Admin {
-	return &Admin{client: client}
+// NewAdmin creates a new Admin on top of a franz-go client.
+// Requests kadm has no helper for are issued directly through the underlying client.
+func NewAdmin(client *kgo.Client) *Admin {
+	return &Admin{client: kadm.NewClient(client), raw: client}
 }
 
 // BrokerMetadata returns broker metadata (used for health checks)
@@ -240,13 +246,11 @@ func (a *Admin) GetTopicDetail(ctx context.Context, topicName string) (*domain.T
 
 	// Get topic configs
 	configs := make(map[string]string)
-	configRes, err := a.client.DescribeTopicConfigs(cctx, topicName)
+	entries, err := a.describeTopicConfigEntries(cctx, topicName)
 	if err == nil {
-		for _, res := range configRes {
-			for _, config := range res.Configs {
-				if config.Value != nil {
-					configs[config.Key] = *config.Value
-				}
+		for _, e := range entries {
+			if !e.Sensitive {
+				configs[e.Name] = e.Value
 			}
 		}
 	}
@@ -261,6 +265,7 @@ func (a *Admin) GetTopicDetail(ctx context.Context, topicName string) (*domain.T
 		Partitions:        len(topicInfo.Partitions),
 		ReplicationFactor: replicationFactor,
 		Configs:           configs,
+		ConfigEntries:     entries,
 		PartitionDetails:  partitionDetails,
 	}, nil
 }
@@ -314,8 +319,12 @@ func (a *Admin) UpdateTopicConfig(ctx context.Context, topicName string, req dom
 	configs := make([]kadm.AlterConfig, 0, len(req.Configs))
 
 	for key, value := range req.Configs {
+		op := kadm.SetConfig
+		if value == nil {
+			op = kadm.DeleteConfig
+		}
 		configs = append(configs, kadm.AlterConfig{
-			Op:    kadm.SetConfig,
+			Op:    op,
 			Name:  key,
 			Value: value,
 		})
@@ -355,3 +364,119 @@ func (a *Admin) IncreasePartitions(ctx context.Context, topicName string, req do
 
 	return nil
 }
+
+// PreviewDeleteRecords resolves a delete records request into per-partition target offsets without deleting anything
+func (a *Admin) PreviewDeleteRecords(ctx context.Context, topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	return a.resolveDeleteRecords(cctx, topicName, req)
+}
+
+// DeleteRecords deletes records of a topic up to the offsets resolved from the request
+func (a *Admin) DeleteRecords(ctx context.Context, topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	results, err := a.resolveDeleteRecords(cctx, topicName, req)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make(kadm.Offsets)
+	for _, r := range results {
+		if r.Records > 0 {
+			offsets.AddOffset(topicName, r.Partition, r.TargetOffset, -1)
+		}
+	}
+	if len(offsets) == 0 {
+		return results, nil
+	}
+
+	resp, err := a.client.DeleteRecords(cctx, offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for errors in the response
+	for _, partitions := range resp {
+		for _, r := range partitions {
+			if r.Err != nil {
+				return nil, r.Err
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// resolveDeleteRecords computes, for every affected partition, the offset records will be deleted up to
+func (a *Admin) resolveDeleteRecords(ctx context.Context, topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	starts, err := a.client.ListStartOffsets(ctx, topicName)
+	if err != nil {
+		return nil, err
+	}
+	if err := starts.Error(); err != nil {
+		return nil, err
+	}
+	ends, err := a.client.ListEndOffsets(ctx, topicName)
+	if err != nil {
+		return nil, err
+	}
+	if err := ends.Error(); err != nil {
+		return nil, err
+	}
+
+	var targets kadm.ListedOffsets
+	if req.BeforeTimestamp > 0 {
+		targets, err = a.client.ListOffsetsAfterMilli(ctx, req.BeforeTimestamp, topicName)
+		if err != nil {
+			return nil, err
+		}
+		if err := targets.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	for p := range req.Offsets {
+		if _, ok := ends.Lookup(topicName, p); !ok {
+			return nil, fmt.Errorf("partition %d does not exist in topic %s", p, topicName)
+		}
+	}
+
+	results := make([]domain.DeleteRecordsResult, 0, len(ends[topicName]))
+	for p, end := range ends[topicName] {
+		start, _ := starts.Lookup(topicName, p)
+
+		var target int64
+		switch {
+		case req.All:
+			target = end.Offset
+		case req.BeforeTimestamp > 0:
+			t, ok := targets.Lookup(topicName, p)
+			if !ok || t.Offset < 0 {
+				target = end.Offset
+			} else {
+				target = t.Offset
+			}
+		default:
+			o, ok := req.Offsets[p]
+			if !ok {
+				continue
+			}
+			target = o
+		}
+
+		target = max(start.Offset, min(target, end.Offset))
+		results = append(results, domain.DeleteRecordsResult{
+			Partition:    p,
+			StartOffset:  start.Offset,
+			EndOffset:    end.Offset,
+			TargetOffset: target,
+			Records:      target - start.Offset,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Partition < results[j].Partition })
+	return results, nil
+}
@@ -27,42 +27,16 @@ type Client struct {
 
 // NewClient creates a new Kafka client from configuration.
 func NewClient(cfg config.ClusterConfig) (*Client, error) {
-	var opts []kgo.Opt
-
-	if cfg.ClientID != "" {
-		opts = append(opts, kgo.ClientID(cfg.ClientID))
-	}
-
-	if len(cfg.Brokers) > 0 {
-		opts = append(opts, kgo.SeedBrokers(cfg.Brokers...))
-	}
-	if cfg.TLS != nil && cfg.TLS.Enabled {
-		tlsCfg, err := buildTLSConfig(cfg.TLS)
-		if err != nil {
-			return nil, err
-		}
-		opts = append(opts, kgo.DialTLSConfig(tlsCfg))
-	}
-	if cfg.SASL != nil && cfg.SASL.Mechanism != "" {
-		mech := buildSASLMechanism(cfg.SASL)
-		if mech != nil {
-			opts = append(opts, kgo.SASL(mech))
-		}
-	}
-	if cfg.AWS != nil && cfg.AWS.IAM {
-		awsMech := buildAWSMechanism(cfg.AWS)
-		if awsMech != nil {
-			opts = append(opts, kgo.SASL(awsMech))
-		}
+	opts, err := clientOptions(cfg)
+	if err != nil {
+		return nil, err
 	}
-
 	client, err := kgo.NewClient(opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	kadmClient := kadm.NewClient(client)
-	admin := NewAdmin(kadmClient)
+	admin := NewAdmin(client)
 
 	return &Client{
 		client: client,
@@ -171,6 +145,142 @@ func (c *Client) IncreasePartitions(topicName string, req domain.IncreasePartiti
 	return c.admin.IncreasePartitions(context.Background(), topicName, req)
 }
 
+// PreviewDeleteRecords returns how many records a delete records request would remove per partition
+func (c *Client) PreviewDeleteRecords(topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.PreviewDeleteRecords(context.Background(), topicName, req)
+}
+
+// DeleteRecords deletes records from a topic
+func (c *Client) DeleteRecords(topicName string, req domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.DeleteRecords(context.Background(), topicName, req)
+}
+
+// DescribeACLs returns the ACLs matching the given filter
+func (c *Client) DescribeACLs(filter domain.ACLFilter) ([]domain.ACL, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.DescribeACLs(context.Background(), filter)
+}
+
+// CreateACL creates a single ACL entry
+func (c *Client) CreateACL(acl domain.ACL) error {
+	if c == nil || c.admin == nil {
+		return nil
+	}
+	return c.admin.CreateACL(context.Background(), acl)
+}
+
+// DeleteACLs deletes the ACLs matching the given filter
+func (c *Client) DeleteACLs(filter domain.ACLFilter) ([]domain.ACL, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.DeleteACLs(context.Background(), filter)
+}
+
+// ListSCRAMUsers returns the users that have SCRAM credentials
+func (c *Client) ListSCRAMUsers() ([]domain.SCRAMUser, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.ListSCRAMUsers(context.Background())
+}
+
+// UpsertSCRAMUser creates or updates a SCRAM credential
+func (c *Client) UpsertSCRAMUser(req domain.UpsertSCRAMUserRequest) error {
+	if c == nil || c.admin == nil {
+		return nil
+	}
+	return c.admin.UpsertSCRAMUser(context.Background(), req)
+}
+
+// DeleteSCRAMUser deletes SCRAM credentials of a user
+func (c *Client) DeleteSCRAMUser(name, mechanism string) error {
+	if c == nil || c.admin == nil {
+		return nil
+	}
+	return c.admin.DeleteSCRAMUser(context.Background(), name, mechanism)
+}
+
+// DescribeClientQuotas returns the client quotas configured in the cluster
+func (c *Client) DescribeClientQuotas() ([]domain.ClientQuota, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.DescribeClientQuotas(context.Background())
+}
+
+// AlterClientQuotas sets and removes quotas of an entity
+func (c *Client) AlterClientQuotas(req domain.AlterClientQuotaRequest) error {
+	if c == nil || c.admin == nil {
+		return nil
+	}
+	return c.admin.AlterClientQuotas(context.Background(), req)
+}
+
+// GetClusterInternals returns the metadata quorum, features and broker API versions
+func (c *Client) GetClusterInternals() (*domain.ClusterInternals, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.GetClusterInternals(context.Background())
+}
+
+// ExportTopics returns the topics of the cluster with their non-default configs
+func (c *Client) ExportTopics() ([]domain.DesiredTopic, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.ExportTopics(context.Background())
+}
+
+// ListConsumerGroupOffsets returns the committed offsets of every consumer group
+func (c *Client) ListConsumerGroupOffsets() ([]domain.ConsumerGroupOffsets, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.ListConsumerGroupOffsets(context.Background())
+}
+
+// ListTransactions returns the transactional IDs known by the cluster
+func (c *Client) ListTransactions() ([]domain.Transaction, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.ListTransactions(context.Background())
+}
+
+// DescribeProducers returns the active producers of a topic
+func (c *Client) DescribeProducers(topicName string) ([]domain.ActiveProducer, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.DescribeProducers(context.Background(), topicName)
+}
+
+// CountRecordsSince returns how many records were appended to a topic after the given time
+func (c *Client) CountRecordsSince(topicName string, since time.Time) (int64, error) {
+	if c == nil || c.admin == nil {
+		return 0, nil
+	}
+	return c.admin.CountRecordsSince(context.Background(), topicName, since)
+}
+
+// AbortTransaction aborts the open transaction of a producer on a partition
+func (c *Client) AbortTransaction(topicName string, req domain.AbortTransactionRequest) error {
+	if c == nil || c.admin == nil {
+		return nil
+	}
+	return c.admin.AbortTransaction(context.Background(), topicName, req)
+}
+
 // Close releases resources
 func (c *Client) Close() {
 	if c != nil && c.client != nil {
@@ -190,12 +300,61 @@ func (c *Client) StreamMessages(ctx context.Context, topic string, out chan<- do
 	}
 	c.client.AddConsumeTopics(topic)
 	defer c.client.PurgeTopicsFromConsuming(topic)
+	pollMessages(ctx, c.client, out)
+}
 
+// ConsumeMessages reads a topic from the requested position into out until the context is canceled.
+// It reads through a client of its own, so the position does not move the streams of StreamMessages.
+func (c *Client) ConsumeMessages(ctx context.Context, topic string, req domain.ConsumeRequest, out chan<- domain.Message) error {
+	if c == nil || c.client == nil {
+		return nil
+	}
+	opts, err := clientOptions(c.config)
+	if err != nil {
+		return err
+	}
+	start := consumeOffset(req.From)
+	if req.Partition >= 0 {
+		opts = append(opts, kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{topic: {req.Partition: start}}))
+	} else {
+		opts = append(opts, kgo.ConsumeTopics(topic), kgo.ConsumeResetOffset(start))
+	}
+	consumer, err := kgo.NewClient(opts...)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+	pollMessages(ctx, consumer, out)
+	return nil
+}
+
+// ResetConsumerGroupOffsets moves the committed offsets of an empty consumer group
+func (c *Client) ResetConsumerGroupOffsets(ctx context.Context, group string, req domain.ResetOffsetsRequest) ([]domain.OffsetReset, error) {
+	if c == nil || c.admin == nil {
+		return nil, nil
+	}
+	return c.admin.ResetGroupOffsets(ctx, group, req)
+}
+
+func consumeOffset(o domain.OffsetSpec) kgo.Offset {
+	switch {
+	case o.Position == domain.OffsetEarliest:
+		return kgo.NewOffset().AtStart()
+	case o.Position == domain.OffsetLatest:
+		return kgo.NewOffset().AtEnd()
+	case !o.Time.IsZero():
+		return kgo.NewOffset().AfterMilli(o.Time.UnixMilli())
+	}
+	return kgo.NewOffset().At(o.Offset)
+}
+
+// pollMessages sends the records the client fetches into out until the context is canceled or the client closed.
+func pollMessages(ctx context.Context, client *kgo.Client, out chan<- domain.Message) {
 	for {
 		if ctx.Err() != nil {
 			return
 		}
-		fetches := c.client.PollFetches(ctx)
+		fetches := client.PollFetches(ctx)
 		if fetches.IsClientClosed() || ctx.Err() != nil {
 			return
 		}
@@ -218,19 +377,52 @@ func (c *Client) StreamMessages(ctx context.Context, topic string, out chan<- do
 	}
 }
 
-// WriteMessage writes a message to the specified Kafka topic asynchronously and logs errors if the operation fails.
-func (c *Client) WriteMessage(ctx context.Context, topic string, msg domain.Message) {
+// WriteMessage writes a message to the specified Kafka topic and waits for the brokers to acknowledge it.
+func (c *Client) WriteMessage(ctx context.Context, topic string, msg domain.Message) error {
 	r := kgo.Record{
 		Key:       msg.Key,
 		Value:     msg.Value,
 		Timestamp: time.Now(),
 		Topic:     topic,
 	}
-	c.client.Produce(ctx, &r, func(_ *kgo.Record, err error) {
+	if err := c.client.ProduceSync(ctx, &r).FirstErr(); err != nil {
+		utils.Logger.Errorf("Error producing message to topic %s: %v", topic, err)
+		return err
+	}
+	return nil
+}
+
+// clientOptions returns the options connecting to the cluster: its brokers, TLS and authentication.
+func clientOptions(cfg config.ClusterConfig) ([]kgo.Opt, error) {
+	var opts []kgo.Opt
+
+	if cfg.ClientID != "" {
+		opts = append(opts, kgo.ClientID(cfg.ClientID))
+	}
+
+	if len(cfg.Brokers) > 0 {
+		opts = append(opts, kgo.SeedBrokers(cfg.Brokers...))
+	}
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		tlsCfg, err := buildTLSConfig(cfg.TLS)
 		if err != nil {
-			utils.Logger.Errorf("Error producing message to topic %s: %v", topic, err)
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsCfg))
+	}
+	if cfg.SASL != nil && cfg.SASL.Mechanism != "" {
+		mech := buildSASLMechanism(cfg.SASL)
+		if mech != nil {
+			opts = append(opts, kgo.SASL(mech))
+		}
+	}
+	if cfg.AWS != nil && cfg.AWS.IAM {
+		awsMech := buildAWSMechanism(cfg.AWS)
+		if awsMech != nil {
+			opts = append(opts, kgo.SASL(awsMech))
 		}
-	})
+	}
+	return opts, nil
 }
 
 // buildTLSConfig reads cert files and builds a tls.Config
@@ -1,19 +1,24 @@
 package kafka
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"fmt"
 	"math/big"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func TestNewClient(t *testing.T) {
@@ -437,30 +442,30 @@ func TestClientMethods(t *testing.T) {
 	})
 
 	t.Run("ListConsumerGroups", func(t *testing.T) {
-		groups, err := client.ListConsumerGroups()
-		_ = groups
-		_ = err
+		if _, err := client.ListConsumerGroups(); err != nil {
+			t.Errorf("ListConsumerGroups() error = %v", err)
+		}
 	})
 
-	t.Run("GetTopicDetail", func(t *testing.T) {
-		detail, err := client.GetTopicDetail("test-topic")
-		_ = detail
-		_ = err
-	})
+	const topic = "test-topic"
 
 	t.Run("CreateTopic", func(t *testing.T) {
 		req := domain.CreateTopicRequest{
-			Name:              "test-topic",
+			Name:              topic,
 			NumPartitions:     3,
 			ReplicationFactor: 1,
 		}
-		err := client.CreateTopic(req)
-		_ = err
+		if err := client.CreateTopic(req); err != nil {
+			t.Fatalf("CreateTopic() error = %v", err)
+		}
 	})
 
-	t.Run("DeleteTopic", func(t *testing.T) {
-		err := client.DeleteTopic("test-topic")
-		_ = err
+	t.Run("GetTopicDetail", func(t *testing.T) {
+		detail := topicDetail(t, client, topic)
+		if detail.Name != topic || detail.Partitions != 3 || detail.ReplicationFactor != 1 {
+			t.Errorf("got %s with %d partitions and replication factor %d, want %s with 3 and 1",
+				detail.Name, detail.Partitions, detail.ReplicationFactor, topic)
+		}
 	})
 
 	t.Run("UpdateTopicConfig", func(t *testing.T) {
@@ -470,17 +475,339 @@ func TestClientMethods(t *testing.T) {
 				"retention.ms": &val,
 			},
 		}
-		err := client.UpdateTopicConfig("test-topic", req)
-		_ = err
+		if err := client.UpdateTopicConfig(topic, req); err != nil {
+			t.Fatalf("UpdateTopicConfig() error = %v", err)
+		}
+		entry := configEntry(t, topicDetail(t, client, topic), "retention.ms")
+		if entry.Value != "1000" || entry.Source != domain.ConfigSourceTopic {
+			t.Errorf("retention.ms = %s from %s, want 1000 from %s", entry.Value, entry.Source, domain.ConfigSourceTopic)
+		}
+	})
+
+	t.Run("ResetTopicConfig", func(t *testing.T) {
+		req := domain.UpdateTopicConfigRequest{
+			Configs: map[string]*string{
+				"retention.ms": nil,
+			},
+		}
+		if err := client.UpdateTopicConfig(topic, req); err != nil {
+			t.Fatalf("UpdateTopicConfig() error = %v", err)
+		}
+		entry := configEntry(t, topicDetail(t, client, topic), "retention.ms")
+		if entry.Value == "1000" || entry.Source == domain.ConfigSourceTopic {
+			t.Errorf("retention.ms = %s from %s, want the default", entry.Value, entry.Source)
+		}
 	})
 
 	t.Run("IncreasePartitions", func(t *testing.T) {
 		req := domain.IncreasePartitionsRequest{
 			TotalPartitions: 5,
 		}
-		err := client.IncreasePartitions("test-topic", req)
-		_ = err
+		if err := client.IncreasePartitions(topic, req); err != nil {
+			t.Fatalf("IncreasePartitions() error = %v", err)
+		}
+		if detail := topicDetail(t, client, topic); detail.Partitions != 5 {
+			t.Errorf("expected 5 partitions, got %d", detail.Partitions)
+		}
 	})
+
+	const written = 10
+
+	t.Run("WriteMessage", func(t *testing.T) {
+		for i := range written {
+			msg := domain.Message{Key: []byte(fmt.Sprint(i)), Value: []byte("value")}
+			if err := client.WriteMessage(context.Background(), topic, msg); err != nil {
+				t.Fatalf("WriteMessage() error = %v", err)
+			}
+		}
+	})
+
+	t.Run("CountRecordsSince", func(t *testing.T) {
+		count, err := client.CountRecordsSince(topic, time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("CountRecordsSince() error = %v", err)
+		}
+		if count != written {
+			t.Errorf("expected %d records, got %d", written, count)
+		}
+	})
+
+	t.Run("DeleteRecords", func(t *testing.T) {
+		req := domain.DeleteRecordsRequest{All: true}
+		preview, err := client.PreviewDeleteRecords(topic, req)
+		if err != nil {
+			t.Fatalf("PreviewDeleteRecords() error = %v", err)
+		}
+		if n := countRecords(preview); n != written {
+			t.Errorf("preview: expected %d records to delete, got %d", written, n)
+		}
+
+		results, err := client.DeleteRecords(topic, req)
+		if err != nil {
+			t.Fatalf("DeleteRecords() error = %v", err)
+		}
+		if n := countRecords(results); n != written {
+			t.Errorf("expected %d records deleted, got %d", written, n)
+		}
+
+		// the start offsets moved to the end, so nothing is left to delete
+		after, err := client.PreviewDeleteRecords(topic, req)
+		if err != nil {
+			t.Fatalf("PreviewDeleteRecords() error = %v", err)
+		}
+		for _, r := range after {
+			if r.StartOffset != r.EndOffset || r.Records != 0 {
+				t.Errorf("partition %d: start offset %d, end offset %d after delete", r.Partition, r.StartOffset, r.EndOffset)
+			}
+		}
+	})
+
+	t.Run("ACLs", func(t *testing.T) {
+		acl := domain.ACL{
+			Principal:    "User:alice",
+			Host:         "*",
+			ResourceType: "TOPIC",
+			ResourceName: topic,
+			PatternType:  "LITERAL",
+			Operation:    "READ",
+			Permission:   "ALLOW",
+		}
+		if err := client.CreateACL(acl); err != nil {
+			t.Fatalf("CreateACL() error = %v", err)
+		}
+		filter := domain.ACLFilter{ResourceType: "TOPIC", ResourceName: topic, PatternType: "MATCH"}
+		acls, err := client.DescribeACLs(filter)
+		if err != nil {
+			t.Fatalf("DescribeACLs() error = %v", err)
+		}
+		if len(acls) != 1 || acls[0] != acl {
+			t.Errorf("expected [%+v], got %+v", acl, acls)
+		}
+
+		deleted, err := client.DeleteACLs(domain.ACLFilter{Principal: "User:alice"})
+		if err != nil {
+			t.Fatalf("DeleteACLs() error = %v", err)
+		}
+		if len(deleted) != 1 {
+			t.Errorf("expected 1 deleted ACL, got %d", len(deleted))
+		}
+		acls, err = client.DescribeACLs(filter)
+		if err != nil {
+			t.Fatalf("DescribeACLs() error = %v", err)
+		}
+		if len(acls) != 0 {
+			t.Errorf("expected no ACLs after delete, got %+v", acls)
+		}
+	})
+
+	t.Run("SCRAMUsers", func(t *testing.T) {
+		err := client.UpsertSCRAMUser(domain.UpsertSCRAMUserRequest{
+			Name:       "alice",
+			Mechanism:  "SCRAM-SHA-256",
+			Password:   "secret",
+			Iterations: 4096,
+		})
+		if err != nil {
+			t.Fatalf("UpsertSCRAMUser() error = %v", err)
+		}
+		users, err := client.ListSCRAMUsers()
+		if err != nil {
+			t.Fatalf("ListSCRAMUsers() error = %v", err)
+		}
+		want := []domain.SCRAMUser{{Name: "alice", Credentials: []domain.SCRAMCredential{{Mechanism: "SCRAM-SHA-256", Iterations: 4096}}}}
+		if !reflect.DeepEqual(users, want) {
+			t.Errorf("expected %+v, got %+v", want, users)
+		}
+
+		if err := client.DeleteSCRAMUser("alice", ""); err != nil {
+			t.Fatalf("DeleteSCRAMUser() error = %v", err)
+		}
+		users, err = client.ListSCRAMUsers()
+		if err != nil {
+			t.Fatalf("ListSCRAMUsers() error = %v", err)
+		}
+		if len(users) != 0 {
+			t.Errorf("expected no SCRAM users after delete, got %+v", users)
+		}
+	})
+
+	t.Run("ClientQuotas", func(t *testing.T) {
+		entity := []domain.QuotaEntityComponent{{Type: "client-id", Name: "test-client"}}
+		err := client.AlterClientQuotas(domain.AlterClientQuotaRequest{
+			Entity: entity,
+			Set:    map[string]float64{"producer_byte_rate": 1024},
+		})
+		if err != nil {
+			t.Fatalf("AlterClientQuotas() error = %v", err)
+		}
+		quotas, err := client.DescribeClientQuotas()
+		if err != nil {
+			t.Fatalf("DescribeClientQuotas() error = %v", err)
+		}
+		want := []domain.ClientQuota{{Entity: entity, Values: map[string]float64{"producer_byte_rate": 1024}}}
+		if !reflect.DeepEqual(quotas, want) {
+			t.Errorf("expected %+v, got %+v", want, quotas)
+		}
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		topics, err := client.ExportTopics()
+		if err != nil {
+			t.Fatalf("ExportTopics() error = %v", err)
+		}
+		i := slices.IndexFunc(topics, func(d domain.DesiredTopic) bool { return d.Name == topic })
+		if i < 0 {
+			t.Fatalf("expected %s in the export, got %+v", topic, topics)
+		}
+		if topics[i].Partitions != 5 || topics[i].ReplicationFactor != 1 || len(topics[i].Configs) != 0 {
+			t.Errorf("unexpected export of %s: %+v", topic, topics[i])
+		}
+		if _, err := client.ListConsumerGroupOffsets(); err != nil {
+			t.Errorf("ListConsumerGroupOffsets() error = %v", err)
+		}
+	})
+
+	t.Run("ClusterInternals", func(t *testing.T) {
+		internals, err := client.GetClusterInternals()
+		if err != nil {
+			t.Fatalf("GetClusterInternals() error = %v", err)
+		}
+		if internals.Mode != domain.ClusterModeKRaft {
+			t.Errorf("expected mode %s, got %s", domain.ClusterModeKRaft, internals.Mode)
+		}
+		// the container runs a single combined broker and controller
+		if internals.Quorum == nil || len(internals.Quorum.Voters) != 1 {
+			t.Errorf("expected a quorum of one voter, got %+v (%s)", internals.Quorum, internals.QuorumError)
+		}
+		if len(internals.Brokers) != 1 || len(internals.Brokers[0].APIs) == 0 {
+			t.Errorf("expected the API versions of one broker, got %+v", internals.Brokers)
+		}
+	})
+
+	t.Run("Transactions", func(t *testing.T) {
+		txns, err := client.ListTransactions()
+		if err != nil {
+			t.Fatalf("ListTransactions() error = %v", err)
+		}
+		if len(txns) != 0 {
+			t.Errorf("expected no transactions, got %+v", txns)
+		}
+		// the records were written by an idempotent producer
+		producers, err := client.DescribeProducers(topic)
+		if err != nil {
+			t.Fatalf("DescribeProducers() error = %v", err)
+		}
+		if len(producers) == 0 {
+			t.Error("expected the producer of the written records")
+		}
+	})
+
+	t.Run("AbortTransaction", func(t *testing.T) {
+		// leave a transaction open on partition 0, as a producer that died before committing would
+		producer, err := kgo.NewClient(
+			kgo.SeedBrokers(brokers...),
+			kgo.TransactionalID("hanging"),
+			kgo.RecordPartitioner(kgo.ManualPartitioner()),
+		)
+		if err != nil {
+			t.Fatalf("kgo.NewClient() error = %v", err)
+		}
+		defer producer.Close()
+		if err := producer.BeginTransaction(); err != nil {
+			t.Fatalf("BeginTransaction() error = %v", err)
+		}
+		record := &kgo.Record{Topic: topic, Partition: 0, Value: []byte("pending")}
+		if err := producer.ProduceSync(context.Background(), record).FirstErr(); err != nil {
+			t.Fatalf("ProduceSync() error = %v", err)
+		}
+
+		txns, err := client.ListTransactions()
+		if err != nil {
+			t.Fatalf("ListTransactions() error = %v", err)
+		}
+		if len(txns) != 1 || txns[0].TransactionalID != "hanging" || txns[0].State != "Ongoing" {
+			t.Errorf("expected the ongoing transaction hanging, got %+v", txns)
+		}
+
+		open := openTransactions(t, client, topic)
+		if len(open) != 1 || open[0].Partition != 0 {
+			t.Fatalf("expected one open transaction on partition 0, got %+v", open)
+		}
+
+		err = client.AbortTransaction(topic, domain.AbortTransactionRequest{Partition: 1, ProducerID: open[0].ProducerID})
+		if err == nil {
+			t.Error("expected an error aborting on a partition without an open transaction")
+		}
+
+		req := domain.AbortTransactionRequest{Partition: 0, ProducerID: open[0].ProducerID}
+		if err := client.AbortTransaction(topic, req); err != nil {
+			t.Fatalf("AbortTransaction() error = %v", err)
+		}
+		if open := openTransactions(t, client, topic); len(open) != 0 {
+			t.Errorf("expected no open transactions after the abort, got %+v", open)
+		}
+	})
+
+	t.Run("DeleteTopic", func(t *testing.T) {
+		if err := client.DeleteTopic(topic); err != nil {
+			t.Fatalf("DeleteTopic() error = %v", err)
+		}
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			topics, err := client.ListTopics(false)
+			if err != nil {
+				t.Fatalf("ListTopics() error = %v", err)
+			}
+			if _, ok := topics[topic]; !ok {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("%s still listed after delete", topic)
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	})
+}
+
+func topicDetail(t *testing.T, client *Client, topic string) *domain.TopicDetail {
+	t.Helper()
+	detail, err := client.GetTopicDetail(topic)
+	if err != nil {
+		t.Fatalf("GetTopicDetail() error = %v", err)
+	}
+	return detail
+}
+
+func configEntry(t *testing.T, detail *domain.TopicDetail, name string) domain.TopicConfigEntry {
+	t.Helper()
+	i := slices.IndexFunc(detail.ConfigEntries, func(e domain.TopicConfigEntry) bool { return e.Name == name })
+	if i < 0 {
+		t.Fatalf("config %s not found", name)
+	}
+	return detail.ConfigEntries[i]
+}
+
+func openTransactions(t *testing.T, client *Client, topic string) []domain.ActiveProducer {
+	t.Helper()
+	producers, err := client.DescribeProducers(topic)
+	if err != nil {
+		t.Fatalf("DescribeProducers() error = %v", err)
+	}
+	var open []domain.ActiveProducer
+	for _, p := range producers {
+		if p.InTransaction() {
+			open = append(open, p)
+		}
+	}
+	return open
+}
+
+func countRecords(results []domain.DeleteRecordsResult) int64 {
+	var n int64
+	for _, r := range results {
+		n += r.Records
+	}
+	return n
 }
 
 func TestClientNilSafety(t *testing.T) {
@@ -0,0 +1,96 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// ExportTopics returns every non-internal topic as a desired topic, keeping only the configs set on the topic itself
+func (a *Admin) ExportTopics(ctx context.Context) ([]domain.DesiredTopic, error) {
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	topics, err := a.client.ListTopics(cctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := topics.Error(); err != nil {
+		return nil, err
+	}
+
+	names := topics.Names()
+	configs, err := a.client.DescribeTopicConfigs(cctx, names...)
+	if err != nil {
+		return nil, err
+	}
+	overrides := make(map[string]map[string]string, len(configs))
+	for _, rc := range configs {
+		if rc.Err != nil {
+			return nil, fmt.Errorf("topic %s: %w: %s", rc.Name, rc.Err, rc.ErrMessage)
+		}
+		overrides[rc.Name] = topicOverrides(rc.Configs)
+	}
+
+	out := make([]domain.DesiredTopic, 0, len(names))
+	for _, t := range topics.Sorted() {
+		out = append(out, domain.DesiredTopic{
+			Name:              t.Topic,
+			Partitions:        int32(len(t.Partitions)),
+			ReplicationFactor: int16(t.Partitions.NumReplicas()),
+			Configs:           overrides[t.Topic],
+		})
+	}
+	return out, nil
+}
+
+// ListConsumerGroupOffsets returns the committed offsets of every consumer group
+func (a *Admin) ListConsumerGroupOffsets(ctx context.Context) ([]domain.ConsumerGroupOffsets, error) {
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	groups, err := a.client.ListGroups(cctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched := a.client.FetchManyOffsets(cctx, groups.Groups()...)
+	out := make([]domain.ConsumerGroupOffsets, 0, len(fetched))
+	for _, group := range groups.Groups() {
+		r, ok := fetched[group]
+		if !ok {
+			continue
+		}
+		if r.Err != nil {
+			return nil, fmt.Errorf("group %s: %w", group, r.Err)
+		}
+		g := domain.ConsumerGroupOffsets{Group: group}
+		for _, o := range r.Fetched.Sorted() {
+			if o.Err != nil || o.At < 0 {
+				continue
+			}
+			g.Offsets = append(g.Offsets, domain.PartitionOffset{Topic: o.Topic, Partition: o.Partition, Offset: o.At})
+		}
+		out = append(out, g)
+	}
+	return out, nil
+}
+
+// topicOverrides keeps the configs explicitly set on a topic, dropping broker and static defaults
+func topicOverrides(configs []kadm.Config) map[string]string {
+	var out map[string]string
+	for _, c := range configs {
+		if c.Source != kmsg.ConfigSourceDynamicTopicConfig || c.Value == nil {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string)
+		}
+		out[c.Key] = *c.Value
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestTopicOverrides(t *testing.T) {
+	str := func(s string) *string { return &s }
+	configs := []kadm.Config{
+		{Key: "retention.ms", Value: str("1000"), Source: kmsg.ConfigSourceDynamicTopicConfig},
+		{Key: "cleanup.policy", Value: str("delete"), Source: kmsg.ConfigSourceDefaultConfig},
+		{Key: "segment.bytes", Value: str("1024"), Source: kmsg.ConfigSourceStaticBrokerConfig},
+		{Key: "sasl.secret", Sensitive: true, Source: kmsg.ConfigSourceDynamicTopicConfig},
+	}
+
+	got := topicOverrides(configs)
+	if len(got) != 1 || got["retention.ms"] != "1000" {
+		t.Errorf("unexpected overrides %v", got)
+	}
+	if topicOverrides(nil) != nil {
+		t.Error("expected nil overrides without configs")
+	}
+}
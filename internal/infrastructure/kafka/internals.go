@@ -0,0 +1,167 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// metadataTopic is the internal topic holding the KRaft metadata log
+const metadataTopic = "__cluster_metadata"
+
+// GetClusterInternals returns the metadata quorum, features and API versions of the cluster.
+// A cluster that cannot describe its quorum is reported as running on ZooKeeper.
+func (a *Admin) GetClusterInternals(ctx context.Context) (*domain.ClusterInternals, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	versions, err := a.client.ApiVersions(cctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &domain.ClusterInternals{Mode: domain.ClusterModeKRaft}
+	var responses []*kmsg.ApiVersionsResponse
+	for _, v := range versions.Sorted() {
+		b := domain.BrokerAPIVersions{NodeID: v.NodeID}
+		if v.Err != nil {
+			b.Error = v.Err.Error()
+			out.Brokers = append(out.Brokers, b)
+			continue
+		}
+		b.VersionGuess = v.VersionGuess()
+		v.EachKeySorted(func(key, minV, maxV int16) {
+			b.APIs = append(b.APIs, domain.APIVersion{Key: key, Name: kmsg.NameForKey(key), Min: minV, Max: maxV})
+		})
+		out.Brokers = append(out.Brokers, b)
+
+		raw := v.Raw()
+		responses = append(responses, raw)
+		out.ZkMigrationReady = out.ZkMigrationReady || raw.ZkMigrationReady
+	}
+	out.FinalizedFeaturesEpoch, out.Features = mergeFeatures(responses)
+
+	quorum, err := a.describeMetadataQuorum(cctx)
+	if err != nil {
+		out.Mode = domain.ClusterModeZooKeeper
+		out.QuorumError = err.Error()
+	} else {
+		out.Quorum = quorum
+	}
+	return out, nil
+}
+
+func (a *Admin) describeMetadataQuorum(ctx context.Context) (*domain.MetadataQuorum, error) {
+	req := kmsg.NewPtrDescribeQuorumRequest()
+	rt := kmsg.NewDescribeQuorumRequestTopic()
+	rt.Topic = metadataTopic
+	rp := kmsg.NewDescribeQuorumRequestTopicPartition()
+	rp.Partition = 0
+	rt.Partitions = append(rt.Partitions, rp)
+	req.Topics = append(req.Topics, rt)
+
+	resp, err := req.RequestWith(ctx, a.raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := kerr.ErrorForCode(resp.ErrorCode); err != nil {
+		return nil, err
+	}
+	for _, t := range resp.Topics {
+		for _, p := range t.Partitions {
+			if err := kerr.ErrorForCode(p.ErrorCode); err != nil {
+				return nil, err
+			}
+			return toDomainQuorum(p), nil
+		}
+	}
+	return nil, fmt.Errorf("no quorum information returned for %s", metadataTopic)
+}
+
+func toDomainQuorum(p kmsg.DescribeQuorumResponseTopicPartition) *domain.MetadataQuorum {
+	q := &domain.MetadataQuorum{
+		LeaderID:      p.LeaderID,
+		LeaderEpoch:   p.LeaderEpoch,
+		HighWatermark: p.HighWatermark,
+	}
+
+	var leaderEnd int64
+	for _, v := range p.CurrentVoters {
+		if v.ReplicaID == p.LeaderID {
+			leaderEnd = v.LogEndOffset
+		}
+	}
+	convert := func(states []kmsg.DescribeQuorumResponseTopicPartitionReplicaState) []domain.QuorumReplica {
+		out := make([]domain.QuorumReplica, 0, len(states))
+		for _, s := range states {
+			lag := leaderEnd - s.LogEndOffset
+			if lag < 0 {
+				lag = 0
+			}
+			out = append(out, domain.QuorumReplica{
+				ReplicaID:             s.ReplicaID,
+				LogEndOffset:          s.LogEndOffset,
+				Lag:                   lag,
+				LastFetchTimestamp:    s.LastFetchTimestamp,
+				LastCaughtUpTimestamp: s.LastCaughtUpTimestamp,
+			})
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].ReplicaID < out[j].ReplicaID })
+		return out
+	}
+	q.Voters = convert(p.CurrentVoters)
+	q.Observers = convert(p.Observers)
+	return q
+}
+
+// mergeFeatures combines the features reported by every broker. The supported range is the one every broker
+// agrees on, and the finalized levels come from the response with the highest finalized epoch.
+func mergeFeatures(responses []*kmsg.ApiVersionsResponse) (int64, []domain.Feature) {
+	byName := make(map[string]*domain.Feature)
+	get := func(name string) *domain.Feature {
+		f, ok := byName[name]
+		if !ok {
+			f = &domain.Feature{Name: name, SupportedMin: -1, SupportedMax: -1}
+			byName[name] = f
+		}
+		return f
+	}
+
+	var epoch int64 = -1
+	var latest *kmsg.ApiVersionsResponse
+	for _, r := range responses {
+		for _, s := range r.SupportedFeatures {
+			f := get(s.Name)
+			if f.SupportedMin == -1 || s.MinVersion > f.SupportedMin {
+				f.SupportedMin = s.MinVersion
+			}
+			if f.SupportedMax == -1 || s.MaxVersion < f.SupportedMax {
+				f.SupportedMax = s.MaxVersion
+			}
+		}
+		if r.FinalizedFeaturesEpoch > epoch {
+			epoch = r.FinalizedFeaturesEpoch
+			latest = r
+		}
+	}
+	if latest != nil {
+		for _, fin := range latest.FinalizedFeatures {
+			f := get(fin.Name)
+			f.Finalized = true
+			f.FinalizedMin = fin.MinVersionLevel
+			f.FinalizedMax = fin.MaxVersionLevel
+		}
+	}
+
+	out := make([]domain.Feature, 0, len(byName))
+	for _, f := range byName {
+		out = append(out, *f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return epoch, out
+}
@@ -0,0 +1,61 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestMergeFeatures(t *testing.T) {
+	b1 := kmsg.NewPtrApiVersionsResponse()
+	b1.SupportedFeatures = []kmsg.ApiVersionsResponseSupportedFeature{{Name: "metadata.version", MinVersion: 1, MaxVersion: 20}}
+	b1.FinalizedFeaturesEpoch = 5
+	b1.FinalizedFeatures = []kmsg.ApiVersionsResponseFinalizedFeature{{Name: "metadata.version", MinVersionLevel: 14, MaxVersionLevel: 14}}
+
+	b2 := kmsg.NewPtrApiVersionsResponse()
+	b2.SupportedFeatures = []kmsg.ApiVersionsResponseSupportedFeature{
+		{Name: "metadata.version", MinVersion: 3, MaxVersion: 18},
+		{Name: "kraft.version", MinVersion: 0, MaxVersion: 1},
+	}
+	b2.FinalizedFeaturesEpoch = 7
+	b2.FinalizedFeatures = []kmsg.ApiVersionsResponseFinalizedFeature{{Name: "metadata.version", MinVersionLevel: 18, MaxVersionLevel: 18}}
+
+	epoch, features := mergeFeatures([]*kmsg.ApiVersionsResponse{b1, b2})
+	if epoch != 7 {
+		t.Errorf("expected epoch 7, got %d", epoch)
+	}
+	if len(features) != 2 || features[0].Name != "kraft.version" {
+		t.Fatalf("unexpected features %+v", features)
+	}
+	want := domain.Feature{Name: "metadata.version", SupportedMin: 3, SupportedMax: 18, Finalized: true, FinalizedMin: 18, FinalizedMax: 18}
+	if features[1] != want {
+		t.Errorf("expected %+v, got %+v", want, features[1])
+	}
+	if features[0].Finalized {
+		t.Error("kraft.version should not be finalized")
+	}
+}
+
+func TestToDomainQuorum(t *testing.T) {
+	p := kmsg.NewDescribeQuorumResponseTopicPartition()
+	p.LeaderID = 1
+	p.LeaderEpoch = 3
+	p.HighWatermark = 100
+	p.CurrentVoters = []kmsg.DescribeQuorumResponseTopicPartitionReplicaState{
+		{ReplicaID: 2, LogEndOffset: 90},
+		{ReplicaID: 1, LogEndOffset: 100},
+	}
+	p.Observers = []kmsg.DescribeQuorumResponseTopicPartitionReplicaState{{ReplicaID: 4, LogEndOffset: 40}}
+
+	q := toDomainQuorum(p)
+	if q.LeaderID != 1 || q.LeaderEpoch != 3 || q.HighWatermark != 100 {
+		t.Errorf("unexpected quorum %+v", q)
+	}
+	if len(q.Voters) != 2 || q.Voters[0].ReplicaID != 1 || q.Voters[0].Lag != 0 || q.Voters[1].Lag != 10 {
+		t.Errorf("unexpected voters %+v", q.Voters)
+	}
+	if len(q.Observers) != 1 || q.Observers[0].Lag != 60 {
+		t.Errorf("unexpected observers %+v", q.Observers)
+	}
+}
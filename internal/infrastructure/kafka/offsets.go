@@ -0,0 +1,129 @@
+package kafka
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// ResetGroupOffsets moves the committed offsets of a consumer group. Kafka only accepts commits
+// from outside a group while it has no members, so active groups are refused.
+func (a *Admin) ResetGroupOffsets(ctx context.Context, group string, req domain.ResetOffsetsRequest) ([]domain.OffsetReset, error) {
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	described, err := a.client.DescribeGroups(cctx, group)
+	if err != nil {
+		return nil, err
+	}
+	g, err := described.On(group, nil)
+	if err != nil {
+		return nil, err
+	}
+	if g.Err != nil {
+		return nil, g.Err
+	}
+	if g.State != "Empty" && g.State != "Dead" {
+		return nil, fmt.Errorf("consumer group %s is %s: stop its members before resetting its offsets", group, strings.ToLower(g.State))
+	}
+
+	committed, err := a.client.FetchOffsets(cctx, group)
+	if err != nil {
+		return nil, err
+	}
+	if err := committed.Error(); err != nil {
+		return nil, err
+	}
+	topics := []string{req.Topic}
+	if req.Topic == "" {
+		topics = committed.Offsets().TopicsSet().Topics()
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("consumer group %s has no committed offsets", group)
+	}
+
+	starts, err := a.client.ListStartOffsets(cctx, topics...)
+	if err == nil {
+		err = starts.Error()
+	}
+	if err != nil {
+		return nil, err
+	}
+	ends, err := a.client.ListEndOffsets(cctx, topics...)
+	if err == nil {
+		err = ends.Error()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var target kadm.ListedOffsets
+	switch {
+	case req.To.Position == domain.OffsetEarliest:
+		target = starts
+	case req.To.Position == domain.OffsetLatest:
+		target = ends
+	case !req.To.Time.IsZero():
+		target, err = a.client.ListOffsetsAfterMilli(cctx, req.To.Time.UnixMilli(), topics...)
+		if err == nil {
+			err = target.Error()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resets := planOffsetResets(committed, starts, ends, target, req.To.Offset)
+	if req.DryRun || len(resets) == 0 {
+		return resets, nil
+	}
+	var offsets kadm.Offsets
+	for _, r := range resets {
+		offsets.AddOffset(r.Topic, r.Partition, r.To, -1)
+	}
+	resp, err := a.client.CommitOffsets(cctx, group, offsets)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Error(); err != nil {
+		return nil, err
+	}
+	return resets, nil
+}
+
+// planOffsetResets returns, per partition, the committed offset and the offset it moves to: the
+// target offset when one is listed, otherwise offset. Either is kept between the start and end offsets.
+func planOffsetResets(committed kadm.OffsetResponses, starts, ends, target kadm.ListedOffsets, offset int64) []domain.OffsetReset {
+	var out []domain.OffsetReset
+	ends.Each(func(end kadm.ListedOffset) {
+		start, ok := starts.Lookup(end.Topic, end.Partition)
+		if !ok {
+			return
+		}
+		at := offset
+		if target != nil {
+			t, ok := target.Lookup(end.Topic, end.Partition)
+			if !ok {
+				return
+			}
+			at = t.Offset
+			if at < 0 {
+				at = end.Offset
+			}
+		}
+		reset := domain.OffsetReset{Topic: end.Topic, Partition: end.Partition, From: -1, To: min(max(at, start.Offset), end.Offset)}
+		if c, ok := committed.Lookup(end.Topic, end.Partition); ok {
+			reset.From = c.At
+		}
+		out = append(out, reset)
+	})
+	slices.SortFunc(out, func(a, b domain.OffsetReset) int {
+		return cmp.Or(strings.Compare(a.Topic, b.Topic), cmp.Compare(a.Partition, b.Partition))
+	})
+	return out
+}
@@ -0,0 +1,47 @@
+package kafka
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+func TestPlanOffsetResets(t *testing.T) {
+	committed := kadm.OffsetResponses{"orders": {
+		0: {Offset: kadm.Offset{Topic: "orders", Partition: 0, At: 70}},
+	}}
+	starts := kadm.ListedOffsets{"orders": {
+		0: {Topic: "orders", Partition: 0, Offset: 10},
+		1: {Topic: "orders", Partition: 1, Offset: 0},
+	}}
+	ends := kadm.ListedOffsets{"orders": {
+		0: {Topic: "orders", Partition: 0, Offset: 100},
+		1: {Topic: "orders", Partition: 1, Offset: 30},
+	}}
+
+	// exact offsets are kept within each partition
+	got := planOffsetResets(committed, starts, ends, nil, 50)
+	want := []domain.OffsetReset{
+		{Topic: "orders", Partition: 0, From: 70, To: 50},
+		{Topic: "orders", Partition: 1, From: -1, To: 30},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	// partitions without a record after the time move to their end
+	after := kadm.ListedOffsets{"orders": {
+		0: {Topic: "orders", Partition: 0, Offset: 42},
+		1: {Topic: "orders", Partition: 1, Offset: -1},
+	}}
+	got = planOffsetResets(committed, starts, ends, after, 0)
+	want = []domain.OffsetReset{
+		{Topic: "orders", Partition: 0, From: 70, To: 42},
+		{Topic: "orders", Partition: 1, From: -1, To: 30},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,89 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// DescribeClientQuotas returns every client quota configured in the cluster
+func (a *Admin) DescribeClientQuotas(ctx context.Context) ([]domain.ClientQuota, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	described, err := a.client.DescribeClientQuotas(cctx, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas := make([]domain.ClientQuota, 0, len(described))
+	for _, d := range described {
+		q := domain.ClientQuota{Values: make(map[string]float64, len(d.Values))}
+		for _, c := range d.Entity {
+			component := domain.QuotaEntityComponent{Type: c.Type, Default: c.Name == nil}
+			if c.Name != nil {
+				component.Name = *c.Name
+			}
+			q.Entity = append(q.Entity, component)
+		}
+		sort.Slice(q.Entity, func(i, j int) bool { return q.Entity[i].Type > q.Entity[j].Type })
+		for _, v := range d.Values {
+			q.Values[v.Key] = v.Value
+		}
+		quotas = append(quotas, q)
+	}
+
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotaEntityString(quotas[i].Entity) < quotaEntityString(quotas[j].Entity)
+	})
+	return quotas, nil
+}
+
+// AlterClientQuotas sets and removes quotas of a single entity
+func (a *Admin) AlterClientQuotas(ctx context.Context, req domain.AlterClientQuotaRequest) error {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	entry := kadm.AlterClientQuotaEntry{}
+	for _, c := range req.Entity {
+		component := kadm.ClientQuotaEntityComponent{Type: c.Type}
+		if !c.Default {
+			name := c.Name
+			component.Name = &name
+		}
+		entry.Entity = append(entry.Entity, component)
+	}
+	for key, value := range req.Set {
+		entry.Ops = append(entry.Ops, kadm.AlterClientQuotaOp{Key: key, Value: value})
+	}
+	for _, key := range req.Remove {
+		entry.Ops = append(entry.Ops, kadm.AlterClientQuotaOp{Key: key, Remove: true})
+	}
+
+	altered, err := a.client.AlterClientQuotas(cctx, []kadm.AlterClientQuotaEntry{entry})
+	if err != nil {
+		return err
+	}
+	for _, r := range altered {
+		if r.Err != nil {
+			return fmt.Errorf("%s: %w: %s", r.Entity, r.Err, r.ErrMessage)
+		}
+	}
+	return nil
+}
+
+func quotaEntityString(entity []domain.QuotaEntityComponent) string {
+	s := ""
+	for _, c := range entity {
+		name := c.Name
+		if c.Default {
+			name = "<default>"
+		}
+		s += c.Type + "=" + name + ","
+	}
+	return s
+}
@@ -0,0 +1,115 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// ListSCRAMUsers returns every user that has SCRAM credentials configured
+func (a *Admin) ListSCRAMUsers(ctx context.Context) ([]domain.SCRAMUser, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	described, err := a.client.DescribeUserSCRAMs(cctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := described.Error(); err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.SCRAMUser, 0, len(described))
+	for _, d := range described.Sorted() {
+		u := domain.SCRAMUser{Name: d.User}
+		for _, c := range d.CredInfos {
+			u.Credentials = append(u.Credentials, domain.SCRAMCredential{
+				Mechanism:  c.Mechanism.String(),
+				Iterations: c.Iterations,
+			})
+		}
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// UpsertSCRAMUser creates or updates the SCRAM credential of a user
+func (a *Admin) UpsertSCRAMUser(ctx context.Context, req domain.UpsertSCRAMUserRequest) error {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	mechanism, err := parseSCRAMMechanism(req.Mechanism)
+	if err != nil {
+		return err
+	}
+
+	altered, err := a.client.AlterUserSCRAMs(cctx, nil, []kadm.UpsertSCRAM{{
+		User:       req.Name,
+		Mechanism:  mechanism,
+		Iterations: req.Iterations,
+		Password:   req.Password,
+	}})
+	if err != nil {
+		return err
+	}
+	return alteredSCRAMError(altered)
+}
+
+// DeleteSCRAMUser deletes the SCRAM credential of a user for the given mechanism.
+// An empty mechanism deletes every credential the user has.
+func (a *Admin) DeleteSCRAMUser(ctx context.Context, name, mechanism string) error {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var deletions []kadm.DeleteSCRAM
+	if mechanism != "" {
+		m, err := parseSCRAMMechanism(mechanism)
+		if err != nil {
+			return err
+		}
+		deletions = append(deletions, kadm.DeleteSCRAM{User: name, Mechanism: m})
+	} else {
+		described, err := a.client.DescribeUserSCRAMs(cctx, name)
+		if err != nil {
+			return err
+		}
+		if err := described.Error(); err != nil {
+			return err
+		}
+		for _, c := range described[name].CredInfos {
+			deletions = append(deletions, kadm.DeleteSCRAM{User: name, Mechanism: c.Mechanism})
+		}
+		if len(deletions) == 0 {
+			return nil
+		}
+	}
+
+	altered, err := a.client.AlterUserSCRAMs(cctx, deletions, nil)
+	if err != nil {
+		return err
+	}
+	return alteredSCRAMError(altered)
+}
+
+func parseSCRAMMechanism(mechanism string) (kadm.ScramMechanism, error) {
+	switch mechanism {
+	case kadm.ScramSha256.String():
+		return kadm.ScramSha256, nil
+	case kadm.ScramSha512.String():
+		return kadm.ScramSha512, nil
+	default:
+		return 0, fmt.Errorf("unknown scram mechanism %q", mechanism)
+	}
+}
+
+func alteredSCRAMError(altered kadm.AlteredUserSCRAMs) error {
+	for _, a := range altered.Sorted() {
+		if a.Err != nil {
+			return fmt.Errorf("user %s: %w: %s", a.User, a.Err, a.ErrMessage)
+		}
+	}
+	return nil
+}
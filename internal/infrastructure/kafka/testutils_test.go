@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/modules/kafka"
 )
 
@@ -14,9 +15,15 @@ type kafkaContainer struct {
 }
 
 func setupKafka(ctx context.Context) (*kafkaContainer, error) {
+	// SCRAM credentials need KRaft from Kafka 3.5. The authorizer lets the ACL tests run; the
+	// anonymous clients of the plaintext listeners are super users so nothing else is refused.
 	container, err := kafka.Run(ctx,
-		"confluentinc/cp-kafka:7.4.0",
+		"confluentinc/cp-kafka:7.5.0",
 		kafka.WithClusterID("test-cluster-id"),
+		testcontainers.WithEnv(map[string]string{
+			"KAFKA_AUTHORIZER_CLASS_NAME": "org.apache.kafka.metadata.authorizer.StandardAuthorizer",
+			"KAFKA_SUPER_USERS":           "User:ANONYMOUS",
+		}),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start container: %w", err)
@@ -0,0 +1,44 @@
+package kafka
+
+import (
+	"context"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// CountRecordsSince returns how many records were appended to a topic after the given time,
+// measured as the delta between the end offsets and the first offsets written after since
+func (a *Admin) CountRecordsSince(ctx context.Context, topicName string, since time.Time) (int64, error) {
+	cctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ends, err := a.client.ListEndOffsets(cctx, topicName)
+	if err != nil {
+		return 0, err
+	}
+	if err := ends.Error(); err != nil {
+		return 0, err
+	}
+	after, err := a.client.ListOffsetsAfterMilli(cctx, since.UnixMilli(), topicName)
+	if err != nil {
+		return 0, err
+	}
+	if err := after.Error(); err != nil {
+		return 0, err
+	}
+	return recordsBetween(after, ends), nil
+}
+
+// recordsBetween sums, per partition, the records between the from and to offsets
+func recordsBetween(from, to kadm.ListedOffsets) int64 {
+	var total int64
+	to.Each(func(end kadm.ListedOffset) {
+		start, ok := from.Lookup(end.Topic, end.Partition)
+		if !ok || start.Offset < 0 || end.Offset <= start.Offset {
+			return
+		}
+		total += end.Offset - start.Offset
+	})
+	return total
+}
@@ -0,0 +1,28 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+func TestRecordsBetween(t *testing.T) {
+	from := kadm.ListedOffsets{"orders": {
+		0: {Topic: "orders", Partition: 0, Offset: 90},
+		1: {Topic: "orders", Partition: 1, Offset: 40},
+		2: {Topic: "orders", Partition: 2, Offset: -1},
+	}}
+	to := kadm.ListedOffsets{"orders": {
+		0: {Topic: "orders", Partition: 0, Offset: 100},
+		1: {Topic: "orders", Partition: 1, Offset: 40},
+		2: {Topic: "orders", Partition: 2, Offset: 7},
+		3: {Topic: "orders", Partition: 3, Offset: 5},
+	}}
+
+	if got := recordsBetween(from, to); got != 10 {
+		t.Fatalf("expected 10 records, got %d", got)
+	}
+	if got := recordsBetween(kadm.ListedOffsets{}, to); got != 0 {
+		t.Fatalf("expected 0 records without start offsets, got %d", got)
+	}
+}
@@ -0,0 +1,71 @@
+package kafka
+
+import (
+	"context"
+	"sort"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// describeTopicConfigEntries describes every config of a topic with its source, default, and documentation.
+// kadm does not request documentation nor expose the read-only flag, so the request is issued directly.
+func (a *Admin) describeTopicConfigEntries(ctx context.Context, topicName string) ([]domain.TopicConfigEntry, error) {
+	req := kmsg.NewPtrDescribeConfigsRequest()
+	req.IncludeSynonyms = true
+	req.IncludeDocumentation = true
+	rr := kmsg.NewDescribeConfigsRequestResource()
+	rr.ResourceType = kmsg.ConfigResourceTypeTopic
+	rr.ResourceName = topicName
+	req.Resources = append(req.Resources, rr)
+
+	resp, err := req.RequestWith(ctx, a.raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []domain.TopicConfigEntry
+	for _, r := range resp.Resources {
+		if err := kerr.ErrorForCode(r.ErrorCode); err != nil {
+			return nil, err
+		}
+		for _, c := range r.Configs {
+			entries = append(entries, toTopicConfigEntry(c))
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+func toTopicConfigEntry(c kmsg.DescribeConfigsResponseResourceConfig) domain.TopicConfigEntry {
+	e := domain.TopicConfigEntry{
+		Name:      c.Name,
+		Source:    c.Source.String(),
+		Type:      c.ConfigType.String(),
+		Sensitive: c.IsSensitive,
+		ReadOnly:  c.ReadOnly,
+	}
+	if c.Value != nil {
+		e.Value = *c.Value
+	}
+	if c.Documentation != nil {
+		e.Documentation = *c.Documentation
+	}
+
+	// Synonyms are ordered by precedence, the first one that is not the topic
+	// override is what the config falls back to.
+	if c.Source != kmsg.ConfigSourceDynamicTopicConfig {
+		e.Default = e.Value
+	}
+	for _, syn := range c.ConfigSynonyms {
+		if syn.Source == kmsg.ConfigSourceDynamicTopicConfig {
+			continue
+		}
+		if syn.Value != nil {
+			e.Default = *syn.Value
+		}
+		break
+	}
+	return e
+}
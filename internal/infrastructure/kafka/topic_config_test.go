@@ -0,0 +1,49 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func TestToTopicConfigEntry(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	overridden := kmsg.NewDescribeConfigsResponseResourceConfig()
+	overridden.Name = "retention.ms"
+	overridden.Value = str("1000")
+	overridden.Source = kmsg.ConfigSourceDynamicTopicConfig
+	overridden.ConfigType = kmsg.ConfigTypeLong
+	overridden.Documentation = str("How long to retain a log")
+	overridden.ConfigSynonyms = []kmsg.DescribeConfigsResponseResourceConfigConfigSynonym{
+		{Name: "retention.ms", Value: str("1000"), Source: kmsg.ConfigSourceDynamicTopicConfig},
+		{Name: "log.retention.ms", Value: str("86400000"), Source: kmsg.ConfigSourceStaticBrokerConfig},
+		{Name: "log.retention.hours", Value: str("168"), Source: kmsg.ConfigSourceDefaultConfig},
+	}
+
+	e := toTopicConfigEntry(overridden)
+	if !e.IsOverridden() || e.Value != "1000" || e.Default != "86400000" || e.Type != "LONG" || e.Documentation == "" {
+		t.Errorf("unexpected overridden entry %+v", e)
+	}
+
+	inherited := kmsg.NewDescribeConfigsResponseResourceConfig()
+	inherited.Name = "cleanup.policy"
+	inherited.Value = str("delete")
+	inherited.Source = kmsg.ConfigSourceDefaultConfig
+	inherited.ReadOnly = true
+
+	e = toTopicConfigEntry(inherited)
+	if e.IsOverridden() || e.Default != "delete" || !e.ReadOnly {
+		t.Errorf("unexpected inherited entry %+v", e)
+	}
+
+	sensitive := kmsg.NewDescribeConfigsResponseResourceConfig()
+	sensitive.Name = "secret"
+	sensitive.IsSensitive = true
+	sensitive.Source = kmsg.ConfigSourceDynamicTopicConfig
+
+	e = toTopicConfigEntry(sensitive)
+	if !e.Sensitive || e.Value != "" || e.Default != "" {
+		t.Errorf("unexpected sensitive entry %+v", e)
+	}
+}
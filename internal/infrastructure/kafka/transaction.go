@@ -0,0 +1,141 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/OliveiraNt/maned-scout/internal/domain"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// ListTransactions lists every transactional ID in the cluster along with its described state
+func (a *Admin) ListTransactions(ctx context.Context) ([]domain.Transaction, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	listed, err := a.client.ListTransactions(cctx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(listed) == 0 {
+		return nil, nil
+	}
+
+	described, err := a.client.DescribeTransactions(cctx, listed.TransactionalIDs()...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]domain.Transaction, 0, len(described))
+	for _, d := range described.Sorted() {
+		if d.Err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", d.TxnID, d.Err)
+		}
+		t := domain.Transaction{
+			TransactionalID: d.TxnID,
+			Coordinator:     d.Coordinator,
+			State:           d.State,
+			ProducerID:      d.ProducerID,
+			ProducerEpoch:   d.ProducerEpoch,
+			TimeoutMs:       d.TimeoutMillis,
+			StartTimestamp:  d.StartTimestamp,
+		}
+		for _, tp := range d.Topics.Sorted() {
+			for _, p := range tp.Partitions {
+				t.Partitions = append(t.Partitions, domain.TopicPartition{Topic: tp.Topic, Partition: p})
+			}
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// DescribeProducers returns the active producers of every partition of a topic
+func (a *Admin) DescribeProducers(ctx context.Context, topic string) ([]domain.ActiveProducer, error) {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var s kadm.TopicsSet
+	s.Add(topic)
+	return a.describeProducers(cctx, s)
+}
+
+// AbortTransaction writes an abort marker for the open transaction a producer holds on a partition.
+// This is meant to recover hanging transactions whose producer will never complete them.
+func (a *Admin) AbortTransaction(ctx context.Context, topic string, req domain.AbortTransactionRequest) error {
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var s kadm.TopicsSet
+	s.Add(topic, req.Partition)
+	producers, err := a.describeProducers(cctx, s)
+	if err != nil {
+		return err
+	}
+
+	var target *domain.ActiveProducer
+	for i := range producers {
+		if producers[i].Partition == req.Partition && producers[i].ProducerID == req.ProducerID {
+			target = &producers[i]
+			break
+		}
+	}
+	if target == nil || !target.InTransaction() {
+		return fmt.Errorf("producer %d has no open transaction on %s/%d", req.ProducerID, topic, req.Partition)
+	}
+
+	responses, err := a.client.WriteTxnMarkers(cctx, kadm.TxnMarkers{
+		ProducerID:       target.ProducerID,
+		ProducerEpoch:    target.ProducerEpoch,
+		Commit:           false,
+		CoordinatorEpoch: target.CoordinatorEpoch,
+		Topics:           s,
+	})
+	if err != nil {
+		return err
+	}
+
+	var markerErr error
+	responses.EachPartition(func(r kadm.TxnMarkersPartitionResponse) {
+		if r.Err != nil && markerErr == nil {
+			markerErr = fmt.Errorf("%s/%d: %w", r.Topic, r.Partition, r.Err)
+		}
+	})
+	return markerErr
+}
+
+func (a *Admin) describeProducers(ctx context.Context, s kadm.TopicsSet) ([]domain.ActiveProducer, error) {
+	described, err := a.client.DescribeProducers(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []domain.ActiveProducer
+	for _, p := range described.SortedPartitions() {
+		if p.Err != nil {
+			return nil, fmt.Errorf("%s/%d: %w: %s", p.Topic, p.Partition, p.Err, p.ErrMessage)
+		}
+		for _, ap := range p.ActiveProducers {
+			out = append(out, domain.ActiveProducer{
+				Topic:                 ap.Topic,
+				Partition:             ap.Partition,
+				Leader:                ap.Leader,
+				ProducerID:            ap.ProducerID,
+				ProducerEpoch:         ap.ProducerEpoch,
+				LastSequence:          ap.LastSequence,
+				LastTimestamp:         ap.LastTimestamp,
+				CoordinatorEpoch:      ap.CoordinatorEpoch,
+				CurrentTxnStartOffset: ap.CurrentTxnStartOffset,
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Partition != out[j].Partition {
+			return out[i].Partition < out[j].Partition
+		}
+		return out[i].ProducerID < out[j].ProducerID
+	})
+	return out, nil
+}
@@ -124,6 +124,108 @@ func (r *ClusterRepository) FindAll() []config.ClusterConfig {
 	return out
 }
 
+// IsReadOnly reports whether the configuration file enables the global read-only mode
+func (r *ClusterRepository) IsReadOnly() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.configData.ReadOnly
+}
+
+// FindRBAC retrieves the role-based access rules, nil when every signed-in user may do everything
+func (r *ClusterRepository) FindRBAC() *config.RBACConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.configData.RBAC
+}
+
+// FindAuthConfig retrieves the authentication settings, nil when authentication is not configured
+func (r *ClusterRepository) FindAuthConfig() *config.AuthConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.configData.Auth == nil {
+		return nil
+	}
+	auth := *r.configData.Auth
+	return &auth
+}
+
+// FindAuditConfig retrieves the audit log settings, nil when no audit log is configured
+func (r *ClusterRepository) FindAuditConfig() *config.AuditConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.configData.Audit == nil {
+		return nil
+	}
+	audit := *r.configData.Audit
+	return &audit
+}
+
+// FindServerConfig retrieves the HTTP server settings, nil when the defaults apply
+func (r *ClusterRepository) FindServerConfig() *config.ServerConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.configData.Server == nil {
+		return nil
+	}
+	server := *r.configData.Server
+	return &server
+}
+
+// FindTopicTemplates retrieves all topic templates
+func (r *ClusterRepository) FindTopicTemplates() []config.TopicTemplate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]config.TopicTemplate, len(r.configData.TopicTemplates))
+	copy(out, r.configData.TopicTemplates)
+	return out
+}
+
+// FindQuarantinedTopics retrieves all quarantined topics
+func (r *ClusterRepository) FindQuarantinedTopics() []config.QuarantinedTopic {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	out := make([]config.QuarantinedTopic, len(r.configData.QuarantinedTopics))
+	copy(out, r.configData.QuarantinedTopics)
+	return out
+}
+
+// SaveQuarantinedTopic persists a quarantined topic, replacing any previous record of the same topic
+func (r *ClusterRepository) SaveQuarantinedTopic(q config.QuarantinedTopic) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.configData.QuarantinedTopics {
+		if r.configData.QuarantinedTopics[i].Cluster == q.Cluster && r.configData.QuarantinedTopics[i].Topic == q.Topic {
+			r.configData.QuarantinedTopics[i] = q
+			return r.writeToFile()
+		}
+	}
+	r.configData.QuarantinedTopics = append(r.configData.QuarantinedTopics, q)
+	return r.writeToFile()
+}
+
+// DeleteQuarantinedTopic removes the quarantine record of a topic
+func (r *ClusterRepository) DeleteQuarantinedTopic(cluster, topic string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.configData.QuarantinedTopics[:0]
+	for _, q := range r.configData.QuarantinedTopics {
+		if q.Cluster != cluster || q.Topic != topic {
+			kept = append(kept, q)
+		}
+	}
+	r.configData.QuarantinedTopics = kept
+	return r.writeToFile()
+}
+
 // GetClient returns a Kafka client for the given cluster name
 func (r *ClusterRepository) GetClient(name string) (domain.KafkaClient, bool) {
 	r.mu.RLock()
@@ -230,13 +332,18 @@ func (r *ClusterRepository) writeToFile() error {
 // Close releases all resources held by the ClusterRepository, including watcher and Kafka clients.
 func (r *ClusterRepository) Close() {
 	utils.Logger.Info("Closing repository")
-	err := r.watcher.Close()
-	if err != nil {
-		return
+	if r.watcher != nil {
+		if err := r.watcher.Close(); err != nil {
+			utils.Logger.Warn("close config watcher failed", "err", err)
+		}
 	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	for k, client := range r.clients {
 		utils.Logger.Info("Closing client", "cluster", k)
 		client.Close()
+		delete(r.clients, k)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/infrastructure/repository"
@@ -51,3 +52,28 @@ func TestClusterRepository_LoadFromFile(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "c1", got.Name)
 }
+
+func TestClusterRepository_QuarantinedTopics(t *testing.T) {
+	t.Parallel()
+	tmpDir := t.TempDir()
+	cfgPath := filepath.Join(tmpDir, "config.yml")
+	require.NoError(t, os.WriteFile(cfgPath, []byte("clusters: []\n"), 0644))
+
+	r := repository.NewClusterRepository(cfgPath, &testutil.FakeFactory{Client: testutil.NewFakeKafkaClient()})
+
+	deleteAfter := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, r.SaveQuarantinedTopic(config.QuarantinedTopic{Cluster: "c1", Topic: "orders", DeleteAfter: deleteAfter}))
+	require.NoError(t, r.SaveQuarantinedTopic(config.QuarantinedTopic{Cluster: "c1", Topic: "orders", DeleteAfter: deleteAfter, RetentionMs: "1000"}))
+	require.Len(t, r.FindQuarantinedTopics(), 1)
+
+	// the quarantine survives a reload from the file
+	reloaded := repository.NewClusterRepository(cfgPath, &testutil.FakeFactory{Client: testutil.NewFakeKafkaClient()})
+	require.NoError(t, reloaded.LoadFromFile())
+	quarantined := reloaded.FindQuarantinedTopics()
+	require.Len(t, quarantined, 1)
+	require.Equal(t, "1000", quarantined[0].RetentionMs)
+	require.True(t, deleteAfter.Equal(quarantined[0].DeleteAfter))
+
+	require.NoError(t, r.DeleteQuarantinedTopic("c1", "orders"))
+	require.Empty(t, r.FindQuarantinedTopics())
+}
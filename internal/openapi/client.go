@@ -0,0 +1,274 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// initialisms are the words written in upper case in Go names.
+var initialisms = map[string]string{
+	"acl": "ACL", "acls": "ACLs", "api": "API", "apis": "APIs", "aws": "AWS", "ca": "CA", "iam": "IAM",
+	"id": "ID", "ip": "IP", "isr": "ISR", "sasl": "SASL", "tls": "TLS", "url": "URL",
+}
+
+// GenerateClient writes the Go source of a client for the document: a type per component schema and
+// a method per operation, calling the do method and basePath constant the package must declare.
+func GenerateClient(doc *Document, pkg string) ([]byte, error) {
+	g := &clientGen{schemas: doc.Components.Schemas}
+	base := ""
+	if len(doc.Servers) > 0 {
+		base = doc.Servers[0].URL
+	}
+	g.printf("// basePath is where the API is served, relative to the server URL.\nconst basePath = %q\n\n", base)
+	for _, name := range doc.SchemaNames() {
+		if err := g.schemaType(name, doc.Components.Schemas[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range doc.Endpoints() {
+		if err := g.operation(e); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by clientgen from the OpenAPI document; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\nimport (\n", pkg)
+	for _, imp := range []string{"context", "encoding/json", "net/url", "strconv", "time"} {
+		if bytes.Contains(g.buf.Bytes(), []byte(imp[strings.LastIndex(imp, "/")+1:]+".")) {
+			fmt.Fprintf(&src, "%q\n", imp)
+		}
+	}
+	src.WriteString(")\n\n")
+	src.Write(g.buf.Bytes())
+
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated client: %w", err)
+	}
+	return out, nil
+}
+
+type clientGen struct {
+	schemas map[string]*Schema
+	buf     bytes.Buffer
+}
+
+func (g *clientGen) printf(f string, args ...any) {
+	fmt.Fprintf(&g.buf, f, args...)
+}
+
+func (g *clientGen) schemaType(name string, s *Schema) error {
+	if len(s.Enum) > 0 {
+		g.printf("// %s is one of the values of the %s enum.\ntype %s string\n\n", name, name, name)
+		g.printf("// %s values.\nconst (\n", name)
+		for _, v := range s.Enum {
+			g.printf("%s%s %s = %q\n", name, GoName(v), name, v)
+		}
+		g.printf(")\n\n")
+		return nil
+	}
+	if s.Type != "object" {
+		return fmt.Errorf("schema %s: only objects and string enums are supported", name)
+	}
+
+	g.printf("// %s mirrors the %s schema.\ntype %s struct {\n", name, name, name)
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	slices.Sort(props)
+	for _, prop := range props {
+		typ, err := goType(s.Properties[prop])
+		if err != nil {
+			return fmt.Errorf("schema %s, property %s: %w", name, prop, err)
+		}
+		tag := prop
+		if !s.IsRequired(prop) {
+			tag += ",omitempty"
+			typ = g.optional(typ)
+		}
+		g.printf("%s %s `json:%q`\n", GoName(prop), typ, tag)
+	}
+	g.printf("}\n\n")
+	return nil
+}
+
+// optional returns the type of an optional property: a pointer for structs and times, which
+// omitempty would otherwise still encode.
+func (g *clientGen) optional(typ string) string {
+	if typ == "time.Time" {
+		return "*" + typ
+	}
+	if s, ok := g.schemas[typ]; ok && s.Type == "object" {
+		return "*" + typ
+	}
+	return typ
+}
+
+func (g *clientGen) operation(e Endpoint) error {
+	name := GoName(e.OperationID)
+	var query []Parameter
+	for _, p := range e.Parameters {
+		if p.In == "query" {
+			query = append(query, p)
+		}
+	}
+
+	if len(query) > 0 {
+		g.printf("// %sParams holds the query parameters of %s.\ntype %sParams struct {\n", name, name, name)
+		for _, p := range query {
+			typ, err := goType(p.Schema)
+			if err != nil {
+				return fmt.Errorf("operation %s, parameter %s: %w", e.OperationID, p.Name, err)
+			}
+			if p.Description != "" {
+				g.printf("// %s\n", p.Description)
+			}
+			g.printf("%s %s\n", GoName(p.Name), typ)
+		}
+		g.printf("}\n\n")
+	}
+
+	args := []string{"ctx context.Context"}
+	path := []string{}
+	for _, part := range strings.Split(e.Path, "/")[1:] {
+		if strings.HasPrefix(part, "{") {
+			param := strings.Trim(part, "{}")
+			args = append(args, param+" string")
+			path = append(path, `"/"+url.PathEscape(`+param+`)`)
+		} else {
+			path = append(path, fmt.Sprintf("%q", "/"+part))
+		}
+	}
+	if len(query) > 0 {
+		args = append(args, "params "+name+"Params")
+	}
+	body := "nil"
+	if e.RequestBody != nil {
+		typ, err := goType(JSONSchema(e.RequestBody.Content))
+		if err != nil {
+			return fmt.Errorf("operation %s, request body: %w", e.OperationID, err)
+		}
+		args = append(args, "body "+typ)
+		body = "body"
+	}
+
+	result := ""
+	if _, resp := e.SuccessStatus(); resp != nil {
+		if schema := JSONSchema(resp.Content); schema != nil {
+			typ, err := goType(schema)
+			if err != nil {
+				return fmt.Errorf("operation %s, response: %w", e.OperationID, err)
+			}
+			result = typ
+		}
+	}
+
+	g.printf("// %s calls %s %s: %s.\n", name, e.Method, e.Path, lowerFirst(e.Summary))
+	if result != "" {
+		g.printf("func (c *Client) %s(%s) (%s, error) {\n", name, strings.Join(args, ", "), result)
+	} else {
+		g.printf("func (c *Client) %s(%s) error {\n", name, strings.Join(args, ", "))
+	}
+	g.printf("q := url.Values{}\n")
+	for _, p := range query {
+		field := "params." + GoName(p.Name)
+		switch p.Schema.Type {
+		case "boolean":
+			g.printf("if %s {\nq.Set(%q, \"true\")\n}\n", field, p.Name)
+		case "integer":
+			g.printf("if %s != 0 {\nq.Set(%q, strconv.FormatInt(int64(%s), 10))\n}\n", field, p.Name, field)
+		default:
+			g.printf("if %s != \"\" {\nq.Set(%q, %s)\n}\n", field, p.Name, field)
+		}
+	}
+	pathExpr := strings.Join(path, "+")
+	if result != "" {
+		g.printf("var out %s\nerr := c.do(ctx, %q, %s, q, %s, &out)\nreturn out, err\n}\n\n", result, e.Method, pathExpr, body)
+	} else {
+		g.printf("return c.do(ctx, %q, %s, q, %s, nil)\n}\n\n", e.Method, pathExpr, body)
+	}
+	return nil
+}
+
+// goType returns the Go type values of the schema decode into.
+func goType(s *Schema) (string, error) {
+	if s == nil {
+		return "", fmt.Errorf("missing schema")
+	}
+	if name, ok := s.RefName(); ok {
+		if s.Nullable {
+			return "*" + name, nil
+		}
+		return name, nil
+	}
+
+	var typ string
+	switch s.Type {
+	case "":
+		return "json.RawMessage", nil
+	case "array":
+		items, err := goType(s.Items)
+		return "[]" + items, err
+	case "object":
+		if s.AdditionalProperties == nil {
+			return "json.RawMessage", nil
+		}
+		values, err := goType(s.AdditionalProperties)
+		return "map[string]" + values, err
+	case "string":
+		switch s.Format {
+		case "byte":
+			return "[]byte", nil
+		case "date-time":
+			typ = "time.Time"
+		default:
+			typ = "string"
+		}
+	case "integer":
+		typ = "int64"
+		if s.Format == "int32" {
+			typ = "int32"
+		}
+	case "number":
+		typ = "float64"
+		if s.Format == "float" {
+			typ = "float32"
+		}
+	case "boolean":
+		typ = "bool"
+	default:
+		return "", fmt.Errorf("unsupported schema type %q", s.Type)
+	}
+	if s.Nullable {
+		return "*" + typ, nil
+	}
+	return typ, nil
+}
+
+// GoName returns the exported Go name of a JSON property, operation ID or enum value,
+// such as ResourceType for resource_type or TopicAdmin for topic-admin.
+func GoName(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+	var b strings.Builder
+	for _, w := range words {
+		if up, ok := initialisms[strings.ToLower(w)]; ok && strings.ToLower(w) == w {
+			b.WriteString(up)
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,28 @@
+// Command clientgen writes the Go client of the JSON API from the OpenAPI document the server publishes.
+//
+//	go run ./internal/openapi/clientgen -o client/client_gen.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	httpserver "github.com/OliveiraNt/maned-scout/internal/adapters/http"
+	"github.com/OliveiraNt/maned-scout/internal/openapi"
+)
+
+func main() {
+	out := flag.String("o", "client_gen.go", "file to write the client to")
+	pkg := flag.String("package", "client", "package of the client")
+	flag.Parse()
+
+	src, err := openapi.GenerateClient(httpserver.OpenAPI(), *pkg)
+	if err == nil {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "clientgen:", err)
+		os.Exit(1)
+	}
+}
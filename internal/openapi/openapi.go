@@ -0,0 +1,244 @@
+// Package openapi models OpenAPI 3 documents. It derives JSON schemas from Go types and generates
+// a typed Go client from a document, so the published specification and the client both follow
+// the types the HTTP handlers encode.
+package openapi
+
+import (
+	"slices"
+	"strings"
+)
+
+// Version is the OpenAPI version documents are written in.
+const Version = "3.0.3"
+
+// Document is an OpenAPI document. Paths are relative to the URL of the first server.
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Servers    []Server              `json:"servers,omitempty"`
+	Tags       []Tag                 `json:"tags,omitempty"`
+	Security   []map[string][]string `json:"security,omitempty"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Server is a base URL the API is served at.
+type Server struct {
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// Tag groups operations.
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds the operations of a path, keyed by lower case HTTP method.
+type PathItem map[string]*Operation
+
+// Operation is a single API operation.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path, query or header parameter.
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request, keyed by media type.
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+// Response describes a response. Content is empty when there is no body.
+type Response struct {
+	Ref         string               `json:"$ref,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Headers     map[string]Header    `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Header describes a response header.
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType holds the schema of a body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable parts of a document.
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas,omitempty"`
+	Responses       map[string]*Response      `json:"responses,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is a way to authenticate.
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Schema is the subset of JSON Schema used by OpenAPI 3.0 that Go types map to.
+// A nullable reference is written as allOf with the single referenced schema.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// RefPrefix starts the references to component schemas.
+const RefPrefix = "#/components/schemas/"
+
+// RefName returns the name of the component schema s refers to, directly or as a nullable reference.
+func (s *Schema) RefName() (string, bool) {
+	if len(s.AllOf) == 1 {
+		s = s.AllOf[0]
+	}
+	if s.Ref == "" {
+		return "", false
+	}
+	return strings.TrimPrefix(s.Ref, RefPrefix), true
+}
+
+// IsRequired reports whether the object schema requires the property.
+func (s *Schema) IsRequired(property string) bool {
+	return slices.Contains(s.Required, property)
+}
+
+// Endpoint is an operation along with where it is served.
+type Endpoint struct {
+	Method string
+	Path   string
+	*Operation
+}
+
+// Endpoints returns every operation of the document, sorted by path and method.
+func (d *Document) Endpoints() []Endpoint {
+	var out []Endpoint
+	for path, item := range d.Paths {
+		for method, op := range item {
+			out = append(out, Endpoint{Method: strings.ToUpper(method), Path: path, Operation: op})
+		}
+	}
+	slices.SortFunc(out, func(a, b Endpoint) int {
+		if c := strings.Compare(a.Path, b.Path); c != 0 {
+			return c
+		}
+		return methodOrder(a.Method) - methodOrder(b.Method)
+	})
+	return out
+}
+
+// TaggedEndpoints returns the endpoints of each tag, in the order the tags are declared.
+func (d *Document) TaggedEndpoints() map[string][]Endpoint {
+	out := make(map[string][]Endpoint, len(d.Tags))
+	for _, e := range d.Endpoints() {
+		for _, tag := range e.Tags {
+			out[tag] = append(out[tag], e)
+		}
+	}
+	return out
+}
+
+// SchemaNames returns the names of the component schemas, sorted.
+func (d *Document) SchemaNames() []string {
+	names := make([]string, 0, len(d.Components.Schemas))
+	for name := range d.Components.Schemas {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Find returns the operation serving method on path, a path of the document with its parameters filled in.
+func (d *Document) Find(method, path string) (Endpoint, bool) {
+	for _, e := range d.Endpoints() {
+		if e.Method == strings.ToUpper(method) && matchPath(e.Path, path) {
+			return e, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+func matchPath(pattern, path string) bool {
+	want, got := strings.Split(pattern, "/"), strings.Split(path, "/")
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		if strings.HasPrefix(want[i], "{") && got[i] != "" {
+			continue
+		}
+		if want[i] != got[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func methodOrder(method string) int {
+	return slices.Index([]string{"GET", "POST", "PUT", "PATCH", "DELETE"}, method)
+}
+
+// SuccessStatus returns the 2xx status the operation answers and its response.
+func (o *Operation) SuccessStatus() (string, *Response) {
+	for _, status := range []string{"200", "201", "204"} {
+		if resp, ok := o.Responses[status]; ok {
+			return status, resp
+		}
+	}
+	return "", nil
+}
+
+// JSONSchema returns the schema of the JSON content, if there is one.
+func JSONSchema(content map[string]MediaType) *Schema {
+	if mt, ok := content["application/json"]; ok {
+		return mt.Schema
+	}
+	return nil
+}
+
+// PathParameters returns the names of the parameters in a path, in order.
+func PathParameters(path string) []string {
+	var names []string
+	for _, part := range strings.Split(path, "/") {
+		if strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}") {
+			names = append(names, part[1:len(part)-1])
+		}
+	}
+	return names
+}
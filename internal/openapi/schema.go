@@ -0,0 +1,186 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+)
+
+// Registry derives schemas from Go types the way encoding/json encodes them. Named structs and enums
+// become component schemas, referenced by their type name unless renamed, which must therefore be unique.
+type Registry struct {
+	schemas map[string]*Schema
+	types   map[string]reflect.Type
+	names   map[reflect.Type]string
+	enums   map[reflect.Type][]string
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		schemas: map[string]*Schema{},
+		types:   map[string]reflect.Type{},
+		names:   map[reflect.Type]string{},
+		enums:   map[reflect.Type][]string{},
+	}
+}
+
+// Enum declares the values of a named string type.
+func (r *Registry) Enum(t reflect.Type, values ...string) {
+	r.enums[t] = values
+}
+
+// Name names the component schema of t, in place of its type name.
+func (r *Registry) Name(t reflect.Type, name string) {
+	r.names[t] = name
+}
+
+// Schemas returns the component schemas registered so far.
+func (r *Registry) Schemas() map[string]*Schema {
+	return r.schemas
+}
+
+// Schema returns the schema of values of type t. It panics on types JSON cannot encode, such as
+// channels and functions, and when two types share a name.
+func (r *Registry) Schema(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullable(r.Schema(t.Elem()))
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		if values, ok := r.enums[t]; ok {
+			return r.component(t, func() *Schema { return &Schema{Type: "string", Enum: values} })
+		}
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.Schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.Schema(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return r.component(t, func() *Schema { return r.structSchema(t) })
+	}
+	panic(fmt.Sprintf("openapi: %s cannot be described", t))
+}
+
+// component registers the schema of a named type and returns a reference to it.
+func (r *Registry) component(t reflect.Type, build func() *Schema) *Schema {
+	name, ok := r.names[t]
+	if !ok {
+		name = t.Name()
+	}
+	if prev, ok := r.types[name]; ok {
+		if prev != t {
+			panic(fmt.Sprintf("openapi: schema %s names both %s and %s", name, prev, t))
+		}
+	} else {
+		r.types[name] = t
+		r.schemas[name] = build()
+	}
+	return &Schema{Ref: RefPrefix + name}
+}
+
+func (r *Registry) structSchema(t reflect.Type) *Schema {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	r.addFields(s, t)
+	return s
+}
+
+// addFields adds the fields of t to the object schema s, inlining the fields of embedded structs.
+// As in encoding/json, a field hides the fields of the same name in the structs it embeds.
+func (r *Registry) addFields(s *Schema, t reflect.Type) {
+	var embedded []reflect.Type
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		ft := f.Type
+		if f.Anonymous && name == "" {
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		omitEmpty := strings.Contains(","+opts+",", ",omitempty,")
+		var prop *Schema
+		switch {
+		case omitEmpty && ft.Kind() == reflect.Pointer:
+			// nil pointers are left out rather than encoded as null
+			prop = r.Schema(ft.Elem())
+		case !omitEmpty && (ft.Kind() == reflect.Slice || ft.Kind() == reflect.Map):
+			prop = nullable(r.Schema(ft))
+		default:
+			prop = r.Schema(ft)
+		}
+		s.Properties[name] = prop
+		if !omitEmpty {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	for _, et := range embedded {
+		inner := r.structSchema(et)
+		for _, name := range slices.Sorted(maps.Keys(inner.Properties)) {
+			if _, hidden := s.Properties[name]; hidden {
+				continue
+			}
+			s.Properties[name] = inner.Properties[name]
+			if inner.IsRequired(name) {
+				s.Required = append(s.Required, name)
+			}
+		}
+	}
+}
+
+func nullable(s *Schema) *Schema {
+	if s.Ref != "" {
+		return &Schema{AllOf: []*Schema{s}, Nullable: true}
+	}
+	out := *s
+	out.Nullable = true
+	return &out
+}
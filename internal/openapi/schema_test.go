@@ -0,0 +1,94 @@
+package openapi
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testColor string
+
+type testBase struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type testItem struct {
+	testBase
+	Name     int               `json:"name"`
+	Color    testColor         `json:"color"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Parent   *testBase         `json:"parent"`
+	Expires  *time.Time        `json:"expires,omitempty"`
+	Payload  []byte            `json:"payload,omitempty"`
+	internal string
+	Skipped  string `json:"-"`
+}
+
+func TestRegistry_Schema(t *testing.T) {
+	r := NewRegistry()
+	r.Enum(reflect.TypeFor[testColor](), "red", "blue")
+	r.Name(reflect.TypeFor[testItem](), "Item")
+
+	s := r.Schema(reflect.TypeFor[[]testItem]())
+	require.Equal(t, "array", s.Type)
+	require.Equal(t, RefPrefix+"Item", s.Items.Ref)
+
+	item := r.Schemas()["Item"]
+	require.ElementsMatch(t, []string{"color", "id", "name", "parent", "tags"}, item.Required)
+	require.Len(t, item.Properties, 8)
+	// the outer field hides the embedded one
+	require.Equal(t, "integer", item.Properties["name"].Type)
+	require.Equal(t, &Schema{Ref: RefPrefix + "testColor"}, item.Properties["color"])
+	require.True(t, item.Properties["tags"].Nullable)
+	require.False(t, item.Properties["labels"].Nullable)
+	require.Equal(t, "string", item.Properties["labels"].AdditionalProperties.Type)
+	name, ok := item.Properties["parent"].RefName()
+	require.True(t, ok)
+	require.Equal(t, "testBase", name)
+	require.True(t, item.Properties["parent"].Nullable)
+	require.Equal(t, &Schema{Type: "string", Format: "date-time"}, item.Properties["expires"])
+	require.Equal(t, "byte", item.Properties["payload"].Format)
+
+	require.Equal(t, []string{"red", "blue"}, r.Schemas()["testColor"].Enum)
+	require.Panics(t, func() { r.Schema(reflect.TypeFor[chan int]()) })
+}
+
+func TestDocument_Validate(t *testing.T) {
+	r := NewRegistry()
+	r.Enum(reflect.TypeFor[testColor](), "red", "blue")
+	s := r.Schema(reflect.TypeFor[testItem]())
+	doc := &Document{Components: Components{Schemas: r.Schemas()}}
+
+	valid := `{"id":"1","name":2,"color":"red","tags":null,"parent":{"id":"p","name":"n"},"expires":"2026-01-01T00:00:00Z"}`
+	require.NoError(t, doc.Validate(s, []byte(valid)))
+
+	for body, msg := range map[string]string{
+		`{"id":"1","name":2,"color":"red","tags":[]}`:                         "missing property parent",
+		`{"id":"1","name":"x","color":"red","tags":[],"parent":null}`:         "want an integer",
+		`{"id":"1","name":2,"color":"green","tags":[],"parent":null}`:         "is not one of",
+		`{"id":"1","name":2,"color":"red","tags":[],"parent":null,"extra":1}`: "unexpected property extra",
+		`{"id":"1","name":2,"color":"red","tags":[1],"parent":null}`:          "$.tags[0]: want a string",
+		`{"id":"1","name":2,"color":"red","tags":[],"parent":{"id":"p"}}`:     "$.parent: missing property name",
+	} {
+		require.ErrorContains(t, doc.Validate(s, []byte(body)), msg, body)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for in, want := range map[string]string{
+		"resource_type":      "ResourceType",
+		"topic-admin":        "TopicAdmin",
+		"listTopicACLs":      "ListTopicACLs",
+		"id":                 "ID",
+		"sasl_mechanism":     "SASLMechanism",
+		"SCRAM-SHA-256":      "SCRAMSHA256",
+		"client_id":          "ClientID",
+		"zk_migration_ready": "ZkMigrationReady",
+	} {
+		require.Equal(t, want, GoName(in), in)
+	}
+}
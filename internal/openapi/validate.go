@@ -0,0 +1,127 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Validate checks that the JSON document data conforms to the schema. Objects may not have properties
+// the schema does not declare, so a body encoding a different type than documented is caught.
+func (d *Document) Validate(s *Schema, data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	return d.validate(s, v, "$")
+}
+
+func (d *Document) validate(s *Schema, v any, at string) error {
+	if v == nil {
+		if s.Nullable || s.Type == "" && s.Ref == "" && len(s.AllOf) == 0 {
+			return nil
+		}
+		return fmt.Errorf("%s: null is not allowed", at)
+	}
+	if len(s.AllOf) > 0 {
+		for _, sub := range s.AllOf {
+			if err := d.validate(sub, v, at); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, RefPrefix)
+		ref, ok := d.Components.Schemas[name]
+		if !ok {
+			return fmt.Errorf("%s: unknown schema %s", at, name)
+		}
+		return d.validate(ref, v, at)
+	}
+
+	switch s.Type {
+	case "":
+		return nil
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%s: want a string, got %T", at, v)
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return fmt.Errorf("%s: %q is not one of %v", at, str, s.Enum)
+		}
+		switch s.Format {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+				return fmt.Errorf("%s: %w", at, err)
+			}
+		case "byte":
+			if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+				return fmt.Errorf("%s: %w", at, err)
+			}
+		}
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: want an integer, got %T", at, v)
+		}
+		if _, err := n.Int64(); err != nil {
+			return fmt.Errorf("%s: %s is not an integer", at, n)
+		}
+	case "number":
+		if _, ok := v.(json.Number); !ok {
+			return fmt.Errorf("%s: want a number, got %T", at, v)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s: want a boolean, got %T", at, v)
+		}
+	case "array":
+		items, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("%s: want an array, got %T", at, v)
+		}
+		for i, item := range items {
+			if err := d.validate(s.Items, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: want an object, got %T", at, v)
+		}
+		return d.validateObject(s, obj, at)
+	default:
+		return fmt.Errorf("%s: unsupported schema type %q", at, s.Type)
+	}
+	return nil
+}
+
+func (d *Document) validateObject(s *Schema, obj map[string]any, at string) error {
+	for _, name := range s.Required {
+		if _, ok := obj[name]; !ok {
+			return fmt.Errorf("%s: missing property %s", at, name)
+		}
+	}
+	for name, value := range obj {
+		prop, ok := s.Properties[name]
+		if !ok {
+			prop = s.AdditionalProperties
+		}
+		if prop == nil {
+			return fmt.Errorf("%s: unexpected property %s", at, name)
+		}
+		if err := d.validate(prop, value, at+"."+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
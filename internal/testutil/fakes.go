@@ -4,6 +4,7 @@ package testutil
 
 import (
 	"context"
+	"time"
 
 	"github.com/OliveiraNt/maned-scout/internal/config"
 	"github.com/OliveiraNt/maned-scout/internal/domain"
@@ -17,8 +18,24 @@ type FakeKafkaClient struct {
 	Cluster        *domain.Cluster
 	Stats          *domain.ClusterStats
 	Brokers        []domain.BrokerDetail
+	Internals      *domain.ClusterInternals
 	ConsumerGroups []domain.ConsumerGroupSummary
 	Lags           kadm.DescribedGroupLags
+	DeletedRecords []domain.DeleteRecordsResult
+	ACLs           []domain.ACL
+	ACLsErr        error
+	SCRAMUsers     []domain.SCRAMUser
+	Quotas         []domain.ClientQuota
+	Exported       []domain.DesiredTopic
+	GroupOffsets   []domain.ConsumerGroupOffsets
+	Transactions   []domain.Transaction
+	Producers      []domain.ActiveProducer
+	RecentRecords  int64
+	Messages       []domain.Message
+	Written        []domain.Message
+	OffsetResets   []domain.OffsetReset
+	ResetRequest   *domain.ResetOffsetsRequest
+	Follow         bool
 	Healthy        bool
 	Err            error
 }
@@ -32,6 +49,9 @@ func (f *FakeKafkaClient) ListTopics(_ bool) (map[string]int, error)        { re
 func (f *FakeKafkaClient) GetClusterInfo() (*domain.Cluster, error)         { return f.Cluster, f.Err }
 func (f *FakeKafkaClient) GetClusterStats() (*domain.ClusterStats, error)   { return f.Stats, f.Err }
 func (f *FakeKafkaClient) GetBrokerDetails() ([]domain.BrokerDetail, error) { return f.Brokers, f.Err }
+func (f *FakeKafkaClient) GetClusterInternals() (*domain.ClusterInternals, error) {
+	return f.Internals, f.Err
+}
 func (f *FakeKafkaClient) ListConsumerGroups() ([]domain.ConsumerGroupSummary, error) {
 	return f.ConsumerGroups, f.Err
 }
@@ -49,14 +69,118 @@ func (f *FakeKafkaClient) UpdateTopicConfig(_ string, _ domain.UpdateTopicConfig
 func (f *FakeKafkaClient) IncreasePartitions(_ string, _ domain.IncreasePartitionsRequest) error {
 	return f.Err
 }
-func (f *FakeKafkaClient) StreamMessages(_ context.Context, _ string, _ chan<- domain.Message) {}
-func (f *FakeKafkaClient) WriteMessage(_ context.Context, _ string, _ domain.Message)          {}
-func (f *FakeKafkaClient) Close()                                                              {}
+func (f *FakeKafkaClient) PreviewDeleteRecords(_ string, _ domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	return f.DeletedRecords, f.Err
+}
+func (f *FakeKafkaClient) DeleteRecords(_ string, _ domain.DeleteRecordsRequest) ([]domain.DeleteRecordsResult, error) {
+	return f.DeletedRecords, f.Err
+}
+func (f *FakeKafkaClient) DescribeACLs(_ domain.ACLFilter) ([]domain.ACL, error) {
+	if f.ACLsErr != nil {
+		return nil, f.ACLsErr
+	}
+	return f.ACLs, f.Err
+}
+func (f *FakeKafkaClient) CreateACL(acl domain.ACL) error {
+	if f.ACLsErr != nil {
+		return f.ACLsErr
+	}
+	if f.Err != nil {
+		return f.Err
+	}
+	f.ACLs = append(f.ACLs, acl)
+	return nil
+}
+func (f *FakeKafkaClient) DeleteACLs(_ domain.ACLFilter) ([]domain.ACL, error) {
+	if f.ACLsErr != nil {
+		return nil, f.ACLsErr
+	}
+	return f.ACLs, f.Err
+}
+func (f *FakeKafkaClient) ListSCRAMUsers() ([]domain.SCRAMUser, error) { return f.SCRAMUsers, f.Err }
+func (f *FakeKafkaClient) UpsertSCRAMUser(req domain.UpsertSCRAMUserRequest) error {
+	if f.Err != nil {
+		return f.Err
+	}
+	f.SCRAMUsers = append(f.SCRAMUsers, domain.SCRAMUser{
+		Name:        req.Name,
+		Credentials: []domain.SCRAMCredential{{Mechanism: req.Mechanism, Iterations: req.Iterations}},
+	})
+	return nil
+}
+func (f *FakeKafkaClient) DeleteSCRAMUser(_, _ string) error { return f.Err }
+func (f *FakeKafkaClient) DescribeClientQuotas() ([]domain.ClientQuota, error) {
+	return f.Quotas, f.Err
+}
+func (f *FakeKafkaClient) AlterClientQuotas(_ domain.AlterClientQuotaRequest) error { return f.Err }
+func (f *FakeKafkaClient) ExportTopics() ([]domain.DesiredTopic, error)             { return f.Exported, f.Err }
+func (f *FakeKafkaClient) ListConsumerGroupOffsets() ([]domain.ConsumerGroupOffsets, error) {
+	return f.GroupOffsets, f.Err
+}
+func (f *FakeKafkaClient) ListTransactions() ([]domain.Transaction, error) {
+	return f.Transactions, f.Err
+}
+func (f *FakeKafkaClient) DescribeProducers(_ string) ([]domain.ActiveProducer, error) {
+	return f.Producers, f.Err
+}
+func (f *FakeKafkaClient) AbortTransaction(_ string, _ domain.AbortTransactionRequest) error {
+	return f.Err
+}
+func (f *FakeKafkaClient) CountRecordsSince(_ string, _ time.Time) (int64, error) {
+	return f.RecentRecords, f.Err
+}
+
+// StreamMessages returns at once, unless Follow is set: it then sends Messages and waits for the stream to be
+// canceled like a topic without new messages.
+func (f *FakeKafkaClient) StreamMessages(ctx context.Context, _ string, out chan<- domain.Message) {
+	if !f.Follow {
+		return
+	}
+	for _, m := range f.Messages {
+		select {
+		case out <- m:
+		case <-ctx.Done():
+			return
+		}
+	}
+	<-ctx.Done()
+}
+func (f *FakeKafkaClient) WriteMessage(_ context.Context, _ string, msg domain.Message) error {
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Written = append(f.Written, msg)
+	return nil
+}
+func (f *FakeKafkaClient) Close() {}
+
+// ConsumeMessages sends Messages from the requested partition, skipping those before an exact offset.
+func (f *FakeKafkaClient) ConsumeMessages(ctx context.Context, _ string, req domain.ConsumeRequest, out chan<- domain.Message) error {
+	for _, m := range f.Messages {
+		if (req.Partition >= 0 && m.Partition != req.Partition) || m.Offset < req.From.Offset {
+			continue
+		}
+		select {
+		case out <- m:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return f.Err
+}
+func (f *FakeKafkaClient) ResetConsumerGroupOffsets(_ context.Context, _ string, req domain.ResetOffsetsRequest) ([]domain.OffsetReset, error) {
+	f.ResetRequest = &req
+	return f.OffsetResets, f.Err
+}
 
 // FakeClusterRepository is a simple in-memory repository for tests.
 type FakeClusterRepository struct {
-	Cfgs    []config.ClusterConfig
-	Clients map[string]domain.KafkaClient
+	Cfgs        []config.ClusterConfig
+	Templates   []config.TopicTemplate
+	Quarantined []config.QuarantinedTopic
+	Clients     map[string]domain.KafkaClient
+	ReadOnly    bool
+	RBAC        *config.RBACConfig
 }
 
 func NewFakeClusterRepository() *FakeClusterRepository {
@@ -79,6 +203,29 @@ func (r *FakeClusterRepository) FindByName(name string) (config.ClusterConfig, b
 func (r *FakeClusterRepository) FindAll() []config.ClusterConfig {
 	return append([]config.ClusterConfig(nil), r.Cfgs...)
 }
+func (r *FakeClusterRepository) IsReadOnly() bool             { return r.ReadOnly }
+func (r *FakeClusterRepository) FindRBAC() *config.RBACConfig { return r.RBAC }
+func (r *FakeClusterRepository) FindTopicTemplates() []config.TopicTemplate {
+	return append([]config.TopicTemplate(nil), r.Templates...)
+}
+func (r *FakeClusterRepository) FindQuarantinedTopics() []config.QuarantinedTopic {
+	return append([]config.QuarantinedTopic(nil), r.Quarantined...)
+}
+func (r *FakeClusterRepository) SaveQuarantinedTopic(q config.QuarantinedTopic) error {
+	_ = r.DeleteQuarantinedTopic(q.Cluster, q.Topic)
+	r.Quarantined = append(r.Quarantined, q)
+	return nil
+}
+func (r *FakeClusterRepository) DeleteQuarantinedTopic(cluster, topic string) error {
+	kept := r.Quarantined[:0]
+	for _, q := range r.Quarantined {
+		if q.Cluster != cluster || q.Topic != topic {
+			kept = append(kept, q)
+		}
+	}
+	r.Quarantined = kept
+	return nil
+}
 func (r *FakeClusterRepository) Watch() error { return nil }
 func (r *FakeClusterRepository) GetClient(name string) (domain.KafkaClient, bool) {
 	c, ok := r.Clients[name]
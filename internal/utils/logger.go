@@ -4,6 +4,7 @@
 package utils
 
 import (
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -72,3 +73,14 @@ func SetLogLevel(level string) {
 		Logger.SetLevel(chlog.ErrorLevel)
 	}
 }
+
+// SetLogOutput sends the logs to w instead of the standard output.
+func SetLogOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if Logger == nil {
+		initLogger()
+	}
+	Logger.SetOutput(w)
+}
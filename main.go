@@ -13,6 +13,7 @@ import (
 	"github.com/OliveiraNt/maned-scout/cmd"
 	"github.com/OliveiraNt/maned-scout/internal/application"
 	"github.com/OliveiraNt/maned-scout/internal/config"
+	"github.com/OliveiraNt/maned-scout/internal/infrastructure/audit"
 	"github.com/OliveiraNt/maned-scout/internal/infrastructure/kafka"
 	"github.com/OliveiraNt/maned-scout/internal/infrastructure/repository"
 	"github.com/OliveiraNt/maned-scout/internal/utils"
@@ -84,6 +85,17 @@ func findConfigPath() string {
 
 func main() {
 	utils.InitLogger()
+	args := os.Args[1:]
+	serve := len(args) == 0 || args[0] == "serve"
+	if !serve {
+		// Commands print their results on the standard output, so the logs go to the standard error
+		// and only warnings are shown unless a level was asked for.
+		utils.SetLogOutput(os.Stderr)
+		if os.Getenv("MANED_SCOUT_LOG_LEVEL") == "" {
+			utils.SetLogLevel("warn")
+		}
+	}
+
 	err := godotenv.Load()
 	if err != nil {
 		utils.Logger.Warn("failed to load .env file", "err", err)
@@ -94,6 +106,11 @@ func main() {
 		configPath = findConfigPath()
 	}
 
+	os.Exit(run(configPath, args, serve))
+}
+
+// run starts the web interface, or runs the command given by args, and returns the exit code.
+func run(configPath string, args []string, serve bool) int {
 	factory := kafka.NewFactory()
 	repo := repository.NewClusterRepository(configPath, factory)
 	defer repo.Close()
@@ -105,15 +122,37 @@ func main() {
 	} else {
 		utils.Logger.Info("configuration loaded")
 	}
+
+	clusterService := application.NewClusterService(repo)
+	if auditCfg := repo.FindAuditConfig(); auditCfg != nil {
+		auditLog, err := audit.OpenFileLog(*auditCfg)
+		if err != nil {
+			utils.Logger.Error("failed to open audit log", "err", err)
+			return 1
+		}
+		defer auditLog.Close()
+		utils.Logger.Info("audit log enabled", "file", auditCfg.File)
+		clusterService = clusterService.WithAuditLog(auditLog)
+	}
+
+	if !serve {
+		cli := &cmd.CLI{Clusters: clusterService.From(cmd.LocalRequest()), ConfigPath: configPath, Stdin: os.Stdin, Stdout: os.Stdout, Stderr: os.Stderr}
+		return cli.Run(args)
+	}
+
 	if err := repo.Watch(); err != nil {
 		utils.Logger.Error("failed to start config watcher", "err", err)
 		panic(err)
 	}
 
-	clusterService := application.NewClusterService(repo)
 	utils.Logger.Info("application layer initialized")
 
 	config.InitI18n()
 
-	cmd.StartWeb(clusterService)
+	if err := cmd.StartWeb(clusterService, repo.FindAuthConfig(), repo.FindServerConfig()); err != nil {
+		utils.Logger.Error("server stopped", "err", err)
+		return 1
+	}
+	utils.Logger.Info("shutdown complete")
+	return 0
 }